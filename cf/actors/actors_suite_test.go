@@ -1,6 +1,10 @@
 package actors_test
 
 import (
+	"github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/i18n/detection"
+	"github.com/cloudfoundry/cli/testhelpers/configuration"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -8,6 +12,9 @@ import (
 )
 
 func TestActors(t *testing.T) {
+	config := configuration.NewRepositoryWithDefaults()
+	i18n.T = i18n.Init(config, &detection.JibberJabberDetector{})
+
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Actors Suite")
 }
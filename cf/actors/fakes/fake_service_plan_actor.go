@@ -3,6 +3,7 @@ package fakes
 
 import (
 	"github.com/cloudfoundry/cli/cf/actors"
+	"github.com/cloudfoundry/cli/cf/models"
 	"sync"
 )
 
@@ -61,6 +62,26 @@ type FakeServicePlanActor struct {
 		result1 actors.PlanAccess
 		result2 error
 	}
+	UpdatePlanVisibilityStub        func(string, models.ServicePlanFields, bool) error
+	updatePlanVisibilityMutex       sync.RWMutex
+	updatePlanVisibilityArgsForCall []struct {
+		arg1 string
+		arg2 models.ServicePlanFields
+		arg3 bool
+	}
+	updatePlanVisibilityReturns struct {
+		result1 error
+	}
+	UpdatePlanOrgVisibilityStub        func(string, string, bool) error
+	updatePlanOrgVisibilityMutex       sync.RWMutex
+	updatePlanOrgVisibilityArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}
+	updatePlanOrgVisibilityReturns struct {
+		result1 error
+	}
 }
 
 func (fake *FakeServicePlanActor) FindServiceAccess(arg1 string, arg2 string) (actors.ServiceAccess, error) {
@@ -237,4 +258,72 @@ func (fake *FakeServicePlanActor) UpdatePlanAndOrgForServiceReturns(result1 acto
 	}{result1, result2}
 }
 
+func (fake *FakeServicePlanActor) UpdatePlanVisibility(arg1 string, arg2 models.ServicePlanFields, arg3 bool) error {
+	fake.updatePlanVisibilityMutex.Lock()
+	defer fake.updatePlanVisibilityMutex.Unlock()
+	fake.updatePlanVisibilityArgsForCall = append(fake.updatePlanVisibilityArgsForCall, struct {
+		arg1 string
+		arg2 models.ServicePlanFields
+		arg3 bool
+	}{arg1, arg2, arg3})
+	if fake.UpdatePlanVisibilityStub != nil {
+		return fake.UpdatePlanVisibilityStub(arg1, arg2, arg3)
+	} else {
+		return fake.updatePlanVisibilityReturns.result1
+	}
+}
+
+func (fake *FakeServicePlanActor) UpdatePlanVisibilityCallCount() int {
+	fake.updatePlanVisibilityMutex.RLock()
+	defer fake.updatePlanVisibilityMutex.RUnlock()
+	return len(fake.updatePlanVisibilityArgsForCall)
+}
+
+func (fake *FakeServicePlanActor) UpdatePlanVisibilityArgsForCall(i int) (string, models.ServicePlanFields, bool) {
+	fake.updatePlanVisibilityMutex.RLock()
+	defer fake.updatePlanVisibilityMutex.RUnlock()
+	return fake.updatePlanVisibilityArgsForCall[i].arg1, fake.updatePlanVisibilityArgsForCall[i].arg2, fake.updatePlanVisibilityArgsForCall[i].arg3
+}
+
+func (fake *FakeServicePlanActor) UpdatePlanVisibilityReturns(result1 error) {
+	fake.UpdatePlanVisibilityStub = nil
+	fake.updatePlanVisibilityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServicePlanActor) UpdatePlanOrgVisibility(arg1 string, arg2 string, arg3 bool) error {
+	fake.updatePlanOrgVisibilityMutex.Lock()
+	defer fake.updatePlanOrgVisibilityMutex.Unlock()
+	fake.updatePlanOrgVisibilityArgsForCall = append(fake.updatePlanOrgVisibilityArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	if fake.UpdatePlanOrgVisibilityStub != nil {
+		return fake.UpdatePlanOrgVisibilityStub(arg1, arg2, arg3)
+	} else {
+		return fake.updatePlanOrgVisibilityReturns.result1
+	}
+}
+
+func (fake *FakeServicePlanActor) UpdatePlanOrgVisibilityCallCount() int {
+	fake.updatePlanOrgVisibilityMutex.RLock()
+	defer fake.updatePlanOrgVisibilityMutex.RUnlock()
+	return len(fake.updatePlanOrgVisibilityArgsForCall)
+}
+
+func (fake *FakeServicePlanActor) UpdatePlanOrgVisibilityArgsForCall(i int) (string, string, bool) {
+	fake.updatePlanOrgVisibilityMutex.RLock()
+	defer fake.updatePlanOrgVisibilityMutex.RUnlock()
+	return fake.updatePlanOrgVisibilityArgsForCall[i].arg1, fake.updatePlanOrgVisibilityArgsForCall[i].arg2, fake.updatePlanOrgVisibilityArgsForCall[i].arg3
+}
+
+func (fake *FakeServicePlanActor) UpdatePlanOrgVisibilityReturns(result1 error) {
+	fake.UpdatePlanOrgVisibilityStub = nil
+	fake.updatePlanOrgVisibilityReturns = struct {
+		result1 error
+	}{result1}
+}
+
 var _ actors.ServicePlanActor = new(FakeServicePlanActor)
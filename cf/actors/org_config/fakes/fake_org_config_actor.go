@@ -0,0 +1,134 @@
+// This file was generated by counterfeiter
+package fakes
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+)
+
+type FakeOrgConfigActor struct {
+	PlanStub        func(config org_config.OrgConfig) ([]org_config.Change, error)
+	planMutex       sync.RWMutex
+	planArgsForCall []struct {
+		config org_config.OrgConfig
+	}
+	planReturns struct {
+		result1 []org_config.Change
+		result2 error
+	}
+	ApplyStub        func(change org_config.Change) error
+	applyMutex       sync.RWMutex
+	applyArgsForCall []struct {
+		change org_config.Change
+	}
+	applyReturns struct {
+		result1 error
+	}
+	ExportStub        func(orgName string) (org_config.OrgDefinition, error)
+	exportMutex       sync.RWMutex
+	exportArgsForCall []struct {
+		orgName string
+	}
+	exportReturns struct {
+		result1 org_config.OrgDefinition
+		result2 error
+	}
+}
+
+func (fake *FakeOrgConfigActor) Plan(config org_config.OrgConfig) ([]org_config.Change, error) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.planArgsForCall = append(fake.planArgsForCall, struct {
+		config org_config.OrgConfig
+	}{config})
+	if fake.PlanStub != nil {
+		return fake.PlanStub(config)
+	} else {
+		return fake.planReturns.result1, fake.planReturns.result2
+	}
+}
+
+func (fake *FakeOrgConfigActor) PlanCallCount() int {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return len(fake.planArgsForCall)
+}
+
+func (fake *FakeOrgConfigActor) PlanArgsForCall(i int) org_config.OrgConfig {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return fake.planArgsForCall[i].config
+}
+
+func (fake *FakeOrgConfigActor) PlanReturns(result1 []org_config.Change, result2 error) {
+	fake.planReturns = struct {
+		result1 []org_config.Change
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeOrgConfigActor) Apply(change org_config.Change) error {
+	fake.applyMutex.Lock()
+	defer fake.applyMutex.Unlock()
+	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		change org_config.Change
+	}{change})
+	if fake.ApplyStub != nil {
+		return fake.ApplyStub(change)
+	} else {
+		return fake.applyReturns.result1
+	}
+}
+
+func (fake *FakeOrgConfigActor) ApplyCallCount() int {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return len(fake.applyArgsForCall)
+}
+
+func (fake *FakeOrgConfigActor) ApplyArgsForCall(i int) org_config.Change {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return fake.applyArgsForCall[i].change
+}
+
+func (fake *FakeOrgConfigActor) ApplyReturns(result1 error) {
+	fake.applyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeOrgConfigActor) Export(orgName string) (org_config.OrgDefinition, error) {
+	fake.exportMutex.Lock()
+	defer fake.exportMutex.Unlock()
+	fake.exportArgsForCall = append(fake.exportArgsForCall, struct {
+		orgName string
+	}{orgName})
+	if fake.ExportStub != nil {
+		return fake.ExportStub(orgName)
+	} else {
+		return fake.exportReturns.result1, fake.exportReturns.result2
+	}
+}
+
+func (fake *FakeOrgConfigActor) ExportCallCount() int {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return len(fake.exportArgsForCall)
+}
+
+func (fake *FakeOrgConfigActor) ExportArgsForCall(i int) string {
+	fake.exportMutex.RLock()
+	defer fake.exportMutex.RUnlock()
+	return fake.exportArgsForCall[i].orgName
+}
+
+func (fake *FakeOrgConfigActor) ExportReturns(result1 org_config.OrgDefinition, result2 error) {
+	fake.exportReturns = struct {
+		result1 org_config.OrgDefinition
+		result2 error
+	}{result1, result2}
+}
+
+var _ org_config.OrgConfigActor = new(FakeOrgConfigActor)
@@ -0,0 +1,489 @@
+package org_config
+
+import (
+	"io"
+	"sort"
+
+	"github.com/cloudfoundry-incubator/candiedyaml"
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/organizations"
+	"github.com/cloudfoundry/cli/cf/api/quotas"
+	"github.com/cloudfoundry/cli/cf/api/security_groups"
+	sgbinder "github.com/cloudfoundry/cli/cf/api/security_groups/spaces"
+	"github.com/cloudfoundry/cli/cf/api/spaces"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+)
+
+// OrgConfig describes orgs and their spaces: the quota of each org, who
+// holds each org and space role, and which security groups are bound to each
+// space. A role or security group list that is left out is not managed, while
+// an empty list removes everyone from it. Orgs and spaces the file does not
+// mention are left alone.
+type OrgConfig struct {
+	Orgs []OrgDefinition `yaml:"orgs"`
+}
+
+type OrgDefinition struct {
+	Name            string            `yaml:"name"`
+	Quota           string            `yaml:"quota,omitempty"`
+	Managers        []string          `yaml:"managers,omitempty"`
+	BillingManagers []string          `yaml:"billing_managers,omitempty"`
+	Auditors        []string          `yaml:"auditors,omitempty"`
+	Spaces          []SpaceDefinition `yaml:"spaces,omitempty"`
+}
+
+type SpaceDefinition struct {
+	Name           string   `yaml:"name"`
+	Managers       []string `yaml:"managers,omitempty"`
+	Developers     []string `yaml:"developers,omitempty"`
+	Auditors       []string `yaml:"auditors,omitempty"`
+	SecurityGroups []string `yaml:"security_groups,omitempty"`
+}
+
+type ChangeType int
+
+const (
+	CreateOrg ChangeType = iota
+	AssignQuota
+	CreateSpace
+	SetOrgRole
+	UnsetOrgRole
+	SetSpaceRole
+	UnsetSpaceRole
+	BindSecurityGroup
+	UnbindSecurityGroup
+)
+
+type Change struct {
+	Type              ChangeType
+	OrgName           string
+	SpaceName         string
+	QuotaName         string
+	Username          string
+	Role              string
+	SecurityGroupName string
+}
+
+func ParseOrgConfig(reader io.Reader) (config OrgConfig, err error) {
+	err = candiedyaml.NewDecoder(reader).Decode(&config)
+	if err != nil {
+		err = errors.NewWithError(T("Error reading org configuration"), err)
+		return
+	}
+
+	for _, org := range config.Orgs {
+		if org.Name == "" {
+			err = errors.New(T("Every org in the configuration needs a name"))
+			return
+		}
+		for _, space := range org.Spaces {
+			if space.Name == "" {
+				err = errors.New(T("Every space of org {{.OrgName}} needs a name", map[string]interface{}{"OrgName": org.Name}))
+				return
+			}
+		}
+	}
+	return
+}
+
+type OrgConfigActor interface {
+	Plan(config OrgConfig) ([]Change, error)
+	Apply(change Change) error
+	Export(orgName string) (OrgDefinition, error)
+}
+
+type Actor struct {
+	orgRepo           organizations.OrganizationRepository
+	spaceRepo         spaces.SpaceRepository
+	userRepo          api.UserRepository
+	quotaRepo         quotas.QuotaRepository
+	securityGroupRepo security_groups.SecurityGroupRepo
+	spaceBinder       sgbinder.SecurityGroupSpaceBinder
+}
+
+func NewActor(orgRepo organizations.OrganizationRepository, spaceRepo spaces.SpaceRepository, userRepo api.UserRepository, quotaRepo quotas.QuotaRepository, securityGroupRepo security_groups.SecurityGroupRepo, spaceBinder sgbinder.SecurityGroupSpaceBinder) Actor {
+	return Actor{
+		orgRepo:           orgRepo,
+		spaceRepo:         spaceRepo,
+		userRepo:          userRepo,
+		quotaRepo:         quotaRepo,
+		securityGroupRepo: securityGroupRepo,
+		spaceBinder:       spaceBinder,
+	}
+}
+
+type roleMembers struct {
+	role      string
+	usernames []string
+}
+
+// Plan returns, in the order they have to be made, the changes needed to
+// bring the live orgs and spaces in line with the configuration. Quotas,
+// users and security groups it refers to must already exist.
+func (actor Actor) Plan(config OrgConfig) ([]Change, error) {
+	changes := []Change{}
+
+	for _, orgDef := range config.Orgs {
+		org, orgExists, err := actor.findOrg(orgDef.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if orgDef.Quota != "" {
+			_, err = actor.quotaRepo.FindByName(orgDef.Quota)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if !orgExists {
+			changes = append(changes, Change{Type: CreateOrg, OrgName: orgDef.Name, QuotaName: orgDef.Quota})
+		} else if orgDef.Quota != "" && org.QuotaDefinition.Name != orgDef.Quota {
+			changes = append(changes, Change{Type: AssignQuota, OrgName: orgDef.Name, QuotaName: orgDef.Quota})
+		}
+
+		orgRoles := []roleMembers{
+			{models.ORG_MANAGER, orgDef.Managers},
+			{models.BILLING_MANAGER, orgDef.BillingManagers},
+			{models.ORG_AUDITOR, orgDef.Auditors},
+		}
+		for _, members := range orgRoles {
+			if members.usernames == nil {
+				continue
+			}
+
+			current := []string{}
+			if orgExists {
+				users, err := actor.userRepo.ListUsersInOrgForRole(org.Guid, members.role)
+				if err != nil {
+					return nil, err
+				}
+				current = usernames(users)
+			}
+
+			added, removed, err := actor.diffUsers(current, members.usernames)
+			if err != nil {
+				return nil, err
+			}
+			for _, username := range added {
+				changes = append(changes, Change{Type: SetOrgRole, OrgName: orgDef.Name, Username: username, Role: members.role})
+			}
+			for _, username := range removed {
+				changes = append(changes, Change{Type: UnsetOrgRole, OrgName: orgDef.Name, Username: username, Role: members.role})
+			}
+		}
+
+		for _, spaceDef := range orgDef.Spaces {
+			spaceChanges, err := actor.planSpace(org, orgExists, orgDef.Name, spaceDef)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, spaceChanges...)
+		}
+	}
+
+	return changes, nil
+}
+
+func (actor Actor) planSpace(org models.Organization, orgExists bool, orgName string, spaceDef SpaceDefinition) ([]Change, error) {
+	changes := []Change{}
+
+	space := models.Space{}
+	spaceExists := false
+	if orgExists {
+		var err error
+		space, spaceExists, err = actor.findSpace(org, spaceDef.Name)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !spaceExists {
+		changes = append(changes, Change{Type: CreateSpace, OrgName: orgName, SpaceName: spaceDef.Name})
+	}
+
+	spaceRoles := []roleMembers{
+		{models.SPACE_MANAGER, spaceDef.Managers},
+		{models.SPACE_DEVELOPER, spaceDef.Developers},
+		{models.SPACE_AUDITOR, spaceDef.Auditors},
+	}
+	for _, members := range spaceRoles {
+		if members.usernames == nil {
+			continue
+		}
+
+		current := []string{}
+		if spaceExists {
+			users, err := actor.userRepo.ListUsersInSpaceForRole(space.Guid, members.role)
+			if err != nil {
+				return nil, err
+			}
+			current = usernames(users)
+		}
+
+		added, removed, err := actor.diffUsers(current, members.usernames)
+		if err != nil {
+			return nil, err
+		}
+		for _, username := range added {
+			changes = append(changes, Change{Type: SetSpaceRole, OrgName: orgName, SpaceName: spaceDef.Name, Username: username, Role: members.role})
+		}
+		for _, username := range removed {
+			changes = append(changes, Change{Type: UnsetSpaceRole, OrgName: orgName, SpaceName: spaceDef.Name, Username: username, Role: members.role})
+		}
+	}
+
+	if spaceDef.SecurityGroups != nil {
+		current := []string{}
+		if spaceExists {
+			var err error
+			current, err = actor.securityGroupNames(space.Guid)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for _, name := range missingFrom(current, spaceDef.SecurityGroups) {
+			_, err := actor.securityGroupRepo.Read(name)
+			if err != nil {
+				return nil, err
+			}
+			changes = append(changes, Change{Type: BindSecurityGroup, OrgName: orgName, SpaceName: spaceDef.Name, SecurityGroupName: name})
+		}
+		for _, name := range missingFrom(spaceDef.SecurityGroups, current) {
+			changes = append(changes, Change{Type: UnbindSecurityGroup, OrgName: orgName, SpaceName: spaceDef.Name, SecurityGroupName: name})
+		}
+	}
+
+	return changes, nil
+}
+
+// diffUsers returns the users to add and to remove so that current matches
+// wanted. Users to add are checked to exist.
+func (actor Actor) diffUsers(current, wanted []string) (added, removed []string, err error) {
+	added = missingFrom(current, wanted)
+	for _, username := range added {
+		_, err = actor.userRepo.FindByUsername(username)
+		if err != nil {
+			return
+		}
+	}
+	removed = missingFrom(wanted, current)
+	return
+}
+
+// Apply makes a single change returned by Plan. Orgs, spaces and users are
+// looked up by name, so changes must be applied in the order Plan returned.
+func (actor Actor) Apply(change Change) error {
+	switch change.Type {
+	case CreateOrg:
+		org := models.Organization{}
+		org.Name = change.OrgName
+		if change.QuotaName != "" {
+			quota, err := actor.quotaRepo.FindByName(change.QuotaName)
+			if err != nil {
+				return err
+			}
+			org.QuotaDefinition = quota
+		}
+		return actor.orgRepo.Create(org)
+
+	case AssignQuota:
+		org, err := actor.orgRepo.FindByName(change.OrgName)
+		if err != nil {
+			return err
+		}
+		quota, err := actor.quotaRepo.FindByName(change.QuotaName)
+		if err != nil {
+			return err
+		}
+		return actor.quotaRepo.AssignQuotaToOrg(org.Guid, quota.Guid)
+
+	case CreateSpace:
+		org, err := actor.orgRepo.FindByName(change.OrgName)
+		if err != nil {
+			return err
+		}
+		_, err = actor.spaceRepo.Create(change.SpaceName, org.Guid, "")
+		return err
+
+	case SetOrgRole, UnsetOrgRole:
+		org, err := actor.orgRepo.FindByName(change.OrgName)
+		if err != nil {
+			return err
+		}
+		user, err := actor.userRepo.FindByUsername(change.Username)
+		if err != nil {
+			return err
+		}
+		if change.Type == SetOrgRole {
+			return actor.userRepo.SetOrgRole(user.Guid, org.Guid, change.Role)
+		}
+		return actor.userRepo.UnsetOrgRole(user.Guid, org.Guid, change.Role)
+
+	case SetSpaceRole, UnsetSpaceRole:
+		org, err := actor.orgRepo.FindByName(change.OrgName)
+		if err != nil {
+			return err
+		}
+		space, err := actor.spaceRepo.FindByNameInOrg(change.SpaceName, org.Guid)
+		if err != nil {
+			return err
+		}
+		user, err := actor.userRepo.FindByUsername(change.Username)
+		if err != nil {
+			return err
+		}
+		if change.Type == SetSpaceRole {
+			return actor.userRepo.SetSpaceRole(user.Guid, space.Guid, org.Guid, change.Role)
+		}
+		return actor.userRepo.UnsetSpaceRole(user.Guid, space.Guid, change.Role)
+
+	case BindSecurityGroup, UnbindSecurityGroup:
+		org, err := actor.orgRepo.FindByName(change.OrgName)
+		if err != nil {
+			return err
+		}
+		space, err := actor.spaceRepo.FindByNameInOrg(change.SpaceName, org.Guid)
+		if err != nil {
+			return err
+		}
+		group, err := actor.securityGroupRepo.Read(change.SecurityGroupName)
+		if err != nil {
+			return err
+		}
+		if change.Type == BindSecurityGroup {
+			return actor.spaceBinder.BindSpace(group.Guid, space.Guid)
+		}
+		return actor.spaceBinder.UnbindSpace(group.Guid, space.Guid)
+	}
+
+	return nil
+}
+
+// Export describes an existing org in the form Plan accepts, so that applying
+// it elsewhere recreates the org's quota, spaces, roles and security groups.
+func (actor Actor) Export(orgName string) (OrgDefinition, error) {
+	org, err := actor.orgRepo.FindByName(orgName)
+	if err != nil {
+		return OrgDefinition{}, err
+	}
+
+	orgDef := OrgDefinition{
+		Name:  org.Name,
+		Quota: org.QuotaDefinition.Name,
+	}
+
+	orgRoles := map[string]*[]string{
+		models.ORG_MANAGER:     &orgDef.Managers,
+		models.BILLING_MANAGER: &orgDef.BillingManagers,
+		models.ORG_AUDITOR:     &orgDef.Auditors,
+	}
+	for role, members := range orgRoles {
+		users, err := actor.userRepo.ListUsersInOrgForRole(org.Guid, role)
+		if err != nil {
+			return OrgDefinition{}, err
+		}
+		*members = sortedUsernames(users)
+	}
+
+	orgSpaces := []models.Space{}
+	err = actor.spaceRepo.ListSpacesFromOrg(org.Guid, func(space models.Space) bool {
+		orgSpaces = append(orgSpaces, space)
+		return true
+	})
+	if err != nil {
+		return OrgDefinition{}, err
+	}
+
+	for _, space := range orgSpaces {
+		groups, err := actor.securityGroupNames(space.Guid)
+		if err != nil {
+			return OrgDefinition{}, err
+		}
+		sort.Strings(groups)
+
+		spaceDef := SpaceDefinition{Name: space.Name, SecurityGroups: groups}
+
+		spaceRoles := map[string]*[]string{
+			models.SPACE_MANAGER:   &spaceDef.Managers,
+			models.SPACE_DEVELOPER: &spaceDef.Developers,
+			models.SPACE_AUDITOR:   &spaceDef.Auditors,
+		}
+		for role, members := range spaceRoles {
+			users, err := actor.userRepo.ListUsersInSpaceForRole(space.Guid, role)
+			if err != nil {
+				return OrgDefinition{}, err
+			}
+			*members = sortedUsernames(users)
+		}
+
+		orgDef.Spaces = append(orgDef.Spaces, spaceDef)
+	}
+
+	return orgDef, nil
+}
+
+func (actor Actor) findOrg(name string) (models.Organization, bool, error) {
+	org, err := actor.orgRepo.FindByName(name)
+	if _, ok := err.(*errors.ModelNotFoundError); ok {
+		return org, false, nil
+	}
+	return org, err == nil, err
+}
+
+func (actor Actor) findSpace(org models.Organization, name string) (models.Space, bool, error) {
+	space, err := actor.spaceRepo.FindByNameInOrg(name, org.Guid)
+	if _, ok := err.(*errors.ModelNotFoundError); ok {
+		return space, false, nil
+	}
+	return space, err == nil, err
+}
+
+func (actor Actor) securityGroupNames(spaceGuid string) ([]string, error) {
+	groups, err := actor.spaceBinder.ListSecurityGroups(spaceGuid)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, group := range groups {
+		names = append(names, group.Name)
+	}
+	return names, nil
+}
+
+func usernames(users []models.UserFields) []string {
+	names := []string{}
+	for _, user := range users {
+		names = append(names, user.Username)
+	}
+	return names
+}
+
+func sortedUsernames(users []models.UserFields) []string {
+	names := usernames(users)
+	sort.Strings(names)
+	return names
+}
+
+// missingFrom returns, sorted, the names in wanted that are not in current.
+func missingFrom(current, wanted []string) []string {
+	missing := []string{}
+	for _, name := range wanted {
+		found := false
+		for _, c := range current {
+			if c == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
@@ -0,0 +1,20 @@
+package org_config_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/i18n/detection"
+	"github.com/cloudfoundry/cli/testhelpers/configuration"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestOrgConfig(t *testing.T) {
+	config := configuration.NewRepositoryWithDefaults()
+	i18n.T = i18n.Init(config, &detection.JibberJabberDetector{})
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "OrgConfig Suite")
+}
@@ -0,0 +1,305 @@
+package org_config_test
+
+import (
+	"strings"
+
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	orgfakes "github.com/cloudfoundry/cli/cf/api/organizations/fakes"
+	quotafakes "github.com/cloudfoundry/cli/cf/api/quotas/fakes"
+	sgfakes "github.com/cloudfoundry/cli/cf/api/security_groups/fakes"
+	binderfakes "github.com/cloudfoundry/cli/cf/api/security_groups/spaces/fakes"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/models"
+
+	. "github.com/cloudfoundry/cli/cf/actors/org_config"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OrgConfig", func() {
+	Describe("ParseOrgConfig", func() {
+		It("tells lists that were left out from empty ones", func() {
+			config, err := ParseOrgConfig(strings.NewReader(`
+orgs:
+- name: my-org
+  quota: default
+  managers: []
+  spaces:
+  - name: dev
+    developers:
+    - bob
+    security_groups:
+    - public_networks
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(config.Orgs)).To(Equal(1))
+
+			org := config.Orgs[0]
+			Expect(org.Name).To(Equal("my-org"))
+			Expect(org.Quota).To(Equal("default"))
+			Expect(org.Managers).NotTo(BeNil())
+			Expect(org.Managers).To(BeEmpty())
+			Expect(org.Auditors).To(BeNil())
+			Expect(org.Spaces[0].Developers).To(Equal([]string{"bob"}))
+			Expect(org.Spaces[0].Managers).To(BeNil())
+			Expect(org.Spaces[0].SecurityGroups).To(Equal([]string{"public_networks"}))
+		})
+
+		It("returns an error when a space has no name", func() {
+			_, err := ParseOrgConfig(strings.NewReader(`
+orgs:
+- name: my-org
+  spaces:
+  - developers: [bob]
+`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("my-org"))
+		})
+
+		It("returns an error for invalid YAML", func() {
+			_, err := ParseOrgConfig(strings.NewReader("orgs: [\n"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("Actor", func() {
+		var (
+			actor             Actor
+			orgRepo           *orgfakes.FakeOrganizationRepository
+			spaceRepo         *testapi.FakeSpaceRepository
+			userRepo          *testapi.FakeUserRepository
+			quotaRepo         *quotafakes.FakeQuotaRepository
+			securityGroupRepo *sgfakes.FakeSecurityGroupRepo
+			spaceBinder       *binderfakes.FakeSecurityGroupSpaceBinder
+		)
+
+		BeforeEach(func() {
+			orgRepo = &orgfakes.FakeOrganizationRepository{}
+			spaceRepo = &testapi.FakeSpaceRepository{}
+			userRepo = &testapi.FakeUserRepository{}
+			quotaRepo = &quotafakes.FakeQuotaRepository{}
+			securityGroupRepo = &sgfakes.FakeSecurityGroupRepo{}
+			spaceBinder = &binderfakes.FakeSecurityGroupSpaceBinder{}
+
+			quotaRepo.FindByNameReturns(models.QuotaFields{Name: "big", Guid: "big-guid"}, nil)
+			userRepo.FindByUsernameUserFields = models.UserFields{Username: "bob", Guid: "bob-guid"}
+			securityGroupRepo.ReadReturns(models.SecurityGroup{SecurityGroupFields: models.SecurityGroupFields{Name: "public", Guid: "public-guid"}}, nil)
+
+			actor = NewActor(orgRepo, spaceRepo, userRepo, quotaRepo, securityGroupRepo, spaceBinder)
+		})
+
+		Describe("Plan", func() {
+			config := OrgConfig{
+				Orgs: []OrgDefinition{{
+					Name:     "my-org",
+					Quota:    "big",
+					Managers: []string{"alice", "bob"},
+					Spaces: []SpaceDefinition{{
+						Name:           "dev",
+						Developers:     []string{"bob"},
+						SecurityGroups: []string{"public"},
+					}},
+				}},
+			}
+
+			Context("when the org does not exist", func() {
+				BeforeEach(func() {
+					orgRepo.FindByNameReturns(models.Organization{}, errors.NewModelNotFoundError("Org", "my-org"))
+				})
+
+				It("creates the org and space and adds everyone", func() {
+					changes, err := actor.Plan(config)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(changes).To(Equal([]Change{
+						{Type: CreateOrg, OrgName: "my-org", QuotaName: "big"},
+						{Type: SetOrgRole, OrgName: "my-org", Username: "alice", Role: models.ORG_MANAGER},
+						{Type: SetOrgRole, OrgName: "my-org", Username: "bob", Role: models.ORG_MANAGER},
+						{Type: CreateSpace, OrgName: "my-org", SpaceName: "dev"},
+						{Type: SetSpaceRole, OrgName: "my-org", SpaceName: "dev", Username: "bob", Role: models.SPACE_DEVELOPER},
+						{Type: BindSecurityGroup, OrgName: "my-org", SpaceName: "dev", SecurityGroupName: "public"},
+					}))
+				})
+			})
+
+			Context("when the org and space exist", func() {
+				BeforeEach(func() {
+					org := models.Organization{}
+					org.Name = "my-org"
+					org.Guid = "my-org-guid"
+					org.QuotaDefinition = models.QuotaFields{Name: "small"}
+					orgRepo.FindByNameReturns(org, nil)
+
+					space := models.Space{}
+					space.Name = "dev"
+					space.Guid = "dev-guid"
+					spaceRepo.FindByNameInOrgSpace = space
+					spaceBinder.ListSecurityGroupsReturns([]models.SecurityGroupFields{{Name: "old-group"}}, nil)
+
+					userRepo.ListUsersByRole = map[string][]models.UserFields{
+						models.ORG_MANAGER:     {{Username: "alice"}, {Username: "carol"}},
+						models.SPACE_DEVELOPER: {{Username: "bob"}},
+					}
+				})
+
+				It("only plans the differences", func() {
+					changes, err := actor.Plan(config)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(spaceRepo.FindByNameInOrgOrgGuid).To(Equal("my-org-guid"))
+					Expect(spaceBinder.ListSecurityGroupsArgsForCall(0)).To(Equal("dev-guid"))
+					Expect(changes).To(Equal([]Change{
+						{Type: AssignQuota, OrgName: "my-org", QuotaName: "big"},
+						{Type: SetOrgRole, OrgName: "my-org", Username: "bob", Role: models.ORG_MANAGER},
+						{Type: UnsetOrgRole, OrgName: "my-org", Username: "carol", Role: models.ORG_MANAGER},
+						{Type: BindSecurityGroup, OrgName: "my-org", SpaceName: "dev", SecurityGroupName: "public"},
+						{Type: UnbindSecurityGroup, OrgName: "my-org", SpaceName: "dev", SecurityGroupName: "old-group"},
+					}))
+				})
+			})
+
+			It("returns an error when a quota does not exist", func() {
+				quotaRepo.FindByNameReturns(models.QuotaFields{}, errors.NewModelNotFoundError("Quota", "big"))
+
+				_, err := actor.Plan(config)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("returns an error when a user does not exist", func() {
+				orgRepo.FindByNameReturns(models.Organization{}, errors.NewModelNotFoundError("Org", "my-org"))
+				userRepo.FindByUsernameNotFound = true
+
+				_, err := actor.Plan(config)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Describe("Apply", func() {
+			BeforeEach(func() {
+				org := models.Organization{}
+				org.Name = "my-org"
+				org.Guid = "my-org-guid"
+				orgRepo.FindByNameReturns(org, nil)
+
+				space := models.Space{}
+				space.Name = "dev"
+				space.Guid = "dev-guid"
+				spaceRepo.FindByNameInOrgSpace = space
+			})
+
+			It("creates an org with its quota", func() {
+				err := actor.Apply(Change{Type: CreateOrg, OrgName: "new-org", QuotaName: "big"})
+				Expect(err).NotTo(HaveOccurred())
+
+				org := orgRepo.CreateArgsForCall(0)
+				Expect(org.Name).To(Equal("new-org"))
+				Expect(org.QuotaDefinition.Guid).To(Equal("big-guid"))
+			})
+
+			It("assigns a quota", func() {
+				err := actor.Apply(Change{Type: AssignQuota, OrgName: "my-org", QuotaName: "big"})
+				Expect(err).NotTo(HaveOccurred())
+
+				orgGuid, quotaGuid := quotaRepo.AssignQuotaToOrgArgsForCall(0)
+				Expect(orgGuid).To(Equal("my-org-guid"))
+				Expect(quotaGuid).To(Equal("big-guid"))
+			})
+
+			It("creates a space", func() {
+				err := actor.Apply(Change{Type: CreateSpace, OrgName: "my-org", SpaceName: "dev"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(spaceRepo.CreateSpaceName).To(Equal("dev"))
+				Expect(spaceRepo.CreateSpaceOrgGuid).To(Equal("my-org-guid"))
+			})
+
+			It("sets and unsets org roles", func() {
+				err := actor.Apply(Change{Type: SetOrgRole, OrgName: "my-org", Username: "bob", Role: models.ORG_AUDITOR})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(userRepo.SetOrgRoleUserGuid).To(Equal("bob-guid"))
+				Expect(userRepo.SetOrgRoleOrganizationGuid).To(Equal("my-org-guid"))
+				Expect(userRepo.SetOrgRoleRole).To(Equal(models.ORG_AUDITOR))
+
+				err = actor.Apply(Change{Type: UnsetOrgRole, OrgName: "my-org", Username: "bob", Role: models.ORG_AUDITOR})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(userRepo.UnsetOrgRoleUserGuid).To(Equal("bob-guid"))
+			})
+
+			It("sets and unsets space roles", func() {
+				err := actor.Apply(Change{Type: SetSpaceRole, OrgName: "my-org", SpaceName: "dev", Username: "bob", Role: models.SPACE_DEVELOPER})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(userRepo.SetSpaceRoleUserGuid).To(Equal("bob-guid"))
+				Expect(userRepo.SetSpaceRoleSpaceGuid).To(Equal("dev-guid"))
+				Expect(userRepo.SetSpaceRoleOrgGuid).To(Equal("my-org-guid"))
+				Expect(userRepo.SetSpaceRoleRole).To(Equal(models.SPACE_DEVELOPER))
+
+				err = actor.Apply(Change{Type: UnsetSpaceRole, OrgName: "my-org", SpaceName: "dev", Username: "bob", Role: models.SPACE_DEVELOPER})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(userRepo.UnsetSpaceRoleSpaceGuid).To(Equal("dev-guid"))
+			})
+
+			It("binds and unbinds security groups", func() {
+				err := actor.Apply(Change{Type: BindSecurityGroup, OrgName: "my-org", SpaceName: "dev", SecurityGroupName: "public"})
+				Expect(err).NotTo(HaveOccurred())
+				groupGuid, spaceGuid := spaceBinder.BindSpaceArgsForCall(0)
+				Expect(groupGuid).To(Equal("public-guid"))
+				Expect(spaceGuid).To(Equal("dev-guid"))
+
+				err = actor.Apply(Change{Type: UnbindSecurityGroup, OrgName: "my-org", SpaceName: "dev", SecurityGroupName: "public"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(spaceBinder.UnbindSpaceCallCount()).To(Equal(1))
+			})
+		})
+
+		Describe("Export", func() {
+			BeforeEach(func() {
+				org := models.Organization{}
+				org.Name = "my-org"
+				org.Guid = "my-org-guid"
+				org.QuotaDefinition = models.QuotaFields{Name: "big"}
+				orgRepo.FindByNameReturns(org, nil)
+
+				space := models.Space{}
+				space.Name = "dev"
+				space.Guid = "dev-guid"
+				spaceRepo.Spaces = []models.Space{space}
+				spaceBinder.ListSecurityGroupsReturns([]models.SecurityGroupFields{{Name: "public"}, {Name: "dns"}}, nil)
+
+				userRepo.ListUsersByRole = map[string][]models.UserFields{
+					models.ORG_MANAGER:     {{Username: "carol"}, {Username: "alice"}},
+					models.SPACE_DEVELOPER: {{Username: "bob"}},
+				}
+			})
+
+			It("describes the org, its spaces, roles and security groups", func() {
+				orgDef, err := actor.Export("my-org")
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(orgDef).To(Equal(OrgDefinition{
+					Name:            "my-org",
+					Quota:           "big",
+					Managers:        []string{"alice", "carol"},
+					BillingManagers: []string{},
+					Auditors:        []string{},
+					Spaces: []SpaceDefinition{{
+						Name:           "dev",
+						Managers:       []string{},
+						Developers:     []string{"bob"},
+						Auditors:       []string{},
+						SecurityGroups: []string{"dns", "public"},
+					}},
+				}))
+
+				Expect(spaceRepo.ListSpacesFromOrgGuid).To(Equal("my-org-guid"))
+				Expect(spaceBinder.ListSecurityGroupsArgsForCall(0)).To(Equal("dev-guid"))
+			})
+
+			It("returns an error when the org does not exist", func() {
+				orgRepo.FindByNameReturns(models.Organization{}, errors.NewModelNotFoundError("Org", "my-org"))
+
+				_, err := actor.Export("my-org")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
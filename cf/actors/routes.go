@@ -65,3 +65,15 @@ func (routeActor RouteActor) UnbindAll(app models.Application) {
 		routeActor.routeRepo.Unbind(route.Guid, app.Guid)
 	}
 }
+
+func (routeActor RouteActor) UnbindRoute(app models.Application, route models.RouteSummary) {
+	routeActor.ui.Say(T("Removing route {{.URL}}...", map[string]interface{}{"URL": terminal.EntityNameColor(route.URL())}))
+
+	apiErr := routeActor.routeRepo.Unbind(route.Guid, app.Guid)
+	if apiErr != nil {
+		routeActor.ui.Failed(apiErr.Error())
+	}
+
+	routeActor.ui.Ok()
+	routeActor.ui.Say("")
+}
@@ -0,0 +1,229 @@
+package actors
+
+import (
+	"io"
+	"sort"
+
+	"github.com/cloudfoundry-incubator/candiedyaml"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+)
+
+// ServiceAccessPolicy describes which orgs may see each plan in the
+// marketplace. Plans are either public or visible to a list of orgs; a plan
+// that is neither is private.
+type ServiceAccessPolicy struct {
+	Brokers []BrokerAccessPolicy `yaml:"brokers"`
+}
+
+type BrokerAccessPolicy struct {
+	Name     string                        `yaml:"name"`
+	Services []ServiceOfferingAccessPolicy `yaml:"services"`
+}
+
+type ServiceOfferingAccessPolicy struct {
+	Name  string             `yaml:"name"`
+	Plans []PlanAccessPolicy `yaml:"plans"`
+}
+
+type PlanAccessPolicy struct {
+	Name   string   `yaml:"name"`
+	Public bool     `yaml:"public,omitempty"`
+	Orgs   []string `yaml:"orgs,omitempty"`
+}
+
+type ServiceAccessChangeType int
+
+const (
+	MakePlanPublic ServiceAccessChangeType = iota
+	MakePlanPrivate
+	EnablePlanForOrg
+	DisablePlanForOrg
+)
+
+// ServiceAccessChange names the plan it changes for display, and carries the
+// guids of the plan and its service, as several brokers may offer services
+// and plans with the same names.
+type ServiceAccessChange struct {
+	Type        ServiceAccessChangeType
+	BrokerName  string
+	ServiceName string
+	ServiceGuid string
+	PlanName    string
+	Plan        models.ServicePlanFields
+	OrgName     string
+}
+
+func ParseServiceAccessPolicy(reader io.Reader) (policy ServiceAccessPolicy, err error) {
+	err = candiedyaml.NewDecoder(reader).Decode(&policy)
+	if err != nil {
+		err = errors.NewWithError(T("Error reading service access policy"), err)
+		return
+	}
+
+	for _, broker := range policy.Brokers {
+		for _, service := range broker.Services {
+			for _, plan := range service.Plans {
+				if plan.Public && len(plan.Orgs) > 0 {
+					err = errors.New(T("Plan {{.PlanName}} of service {{.ServiceName}} cannot be both public and limited to orgs",
+						map[string]interface{}{"PlanName": plan.Name, "ServiceName": service.Name}))
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+// NewServiceAccessPolicy builds the policy that describes the access
+// settings of the given brokers, as returned by ServiceActor.FilterBrokers.
+func NewServiceAccessPolicy(brokers []models.ServiceBroker) ServiceAccessPolicy {
+	policy := ServiceAccessPolicy{Brokers: []BrokerAccessPolicy{}}
+	for _, broker := range brokers {
+		brokerPolicy := BrokerAccessPolicy{Name: broker.Name, Services: []ServiceOfferingAccessPolicy{}}
+		for _, service := range broker.Services {
+			servicePolicy := ServiceOfferingAccessPolicy{Name: service.Label, Plans: []PlanAccessPolicy{}}
+			for _, plan := range service.Plans {
+				planPolicy := PlanAccessPolicy{Name: plan.Name, Public: plan.Public}
+				if !plan.Public && len(plan.OrgNames) > 0 {
+					planPolicy.Orgs = sortedCopy(plan.OrgNames)
+				}
+				servicePolicy.Plans = append(servicePolicy.Plans, planPolicy)
+			}
+			brokerPolicy.Services = append(brokerPolicy.Services, servicePolicy)
+		}
+		policy.Brokers = append(policy.Brokers, brokerPolicy)
+	}
+	return policy
+}
+
+func (policy ServiceAccessPolicy) Encode(writer io.Writer) error {
+	return candiedyaml.NewEncoder(writer).Encode(policy)
+}
+
+// Diff returns the changes needed to bring the given brokers in line with
+// the policy. Plans the policy does not mention are left alone.
+func (policy ServiceAccessPolicy) Diff(brokers []models.ServiceBroker) ([]ServiceAccessChange, error) {
+	changes := []ServiceAccessChange{}
+
+	for _, brokerPolicy := range policy.Brokers {
+		broker, found := findBroker(brokers, brokerPolicy.Name)
+		if !found {
+			return nil, errors.New(T("Service broker {{.BrokerName}} not found", map[string]interface{}{"BrokerName": brokerPolicy.Name}))
+		}
+
+		for _, servicePolicy := range brokerPolicy.Services {
+			service, found := findService(broker.Services, servicePolicy.Name)
+			if !found {
+				return nil, errors.New(T("Service {{.ServiceName}} not found for broker {{.BrokerName}}",
+					map[string]interface{}{"ServiceName": servicePolicy.Name, "BrokerName": broker.Name}))
+			}
+
+			for _, planPolicy := range servicePolicy.Plans {
+				plan, found := findPlan(service.Plans, planPolicy.Name)
+				if !found {
+					return nil, errors.New(T("Plan {{.PlanName}} not found for service {{.ServiceName}}",
+						map[string]interface{}{"PlanName": planPolicy.Name, "ServiceName": service.Label}))
+				}
+
+				newChange := func(changeType ServiceAccessChangeType, orgName string) ServiceAccessChange {
+					return ServiceAccessChange{
+						Type:        changeType,
+						BrokerName:  broker.Name,
+						ServiceName: service.Label,
+						ServiceGuid: service.Guid,
+						PlanName:    plan.Name,
+						Plan:        plan,
+						OrgName:     orgName,
+					}
+				}
+
+				if planPolicy.Public {
+					if !plan.Public {
+						changes = append(changes, newChange(MakePlanPublic, ""))
+					}
+					continue
+				}
+
+				currentOrgs := plan.OrgNames
+				if plan.Public {
+					// Making a plan private also removes its org visibilities.
+					changes = append(changes, newChange(MakePlanPrivate, ""))
+					currentOrgs = []string{}
+				}
+
+				for _, orgName := range sortedCopy(planPolicy.Orgs) {
+					if !contains(currentOrgs, orgName) {
+						changes = append(changes, newChange(EnablePlanForOrg, orgName))
+					}
+				}
+				for _, orgName := range sortedCopy(currentOrgs) {
+					if !contains(planPolicy.Orgs, orgName) {
+						changes = append(changes, newChange(DisablePlanForOrg, orgName))
+					}
+				}
+			}
+		}
+	}
+
+	return changes, nil
+}
+
+// ApplyServiceAccessChange makes a single change through the plan actor.
+func ApplyServiceAccessChange(actor ServicePlanActor, change ServiceAccessChange) (err error) {
+	switch change.Type {
+	case MakePlanPublic:
+		err = actor.UpdatePlanVisibility(change.ServiceGuid, change.Plan, true)
+	case MakePlanPrivate:
+		err = actor.UpdatePlanVisibility(change.ServiceGuid, change.Plan, false)
+	case EnablePlanForOrg:
+		err = actor.UpdatePlanOrgVisibility(change.Plan.Guid, change.OrgName, true)
+	case DisablePlanForOrg:
+		err = actor.UpdatePlanOrgVisibility(change.Plan.Guid, change.OrgName, false)
+	}
+	return
+}
+
+func findBroker(brokers []models.ServiceBroker, name string) (models.ServiceBroker, bool) {
+	for _, broker := range brokers {
+		if broker.Name == name {
+			return broker, true
+		}
+	}
+	return models.ServiceBroker{}, false
+}
+
+func findService(services []models.ServiceOffering, label string) (models.ServiceOffering, bool) {
+	for _, service := range services {
+		if service.Label == label {
+			return service, true
+		}
+	}
+	return models.ServiceOffering{}, false
+}
+
+func findPlan(plans []models.ServicePlanFields, name string) (models.ServicePlanFields, bool) {
+	for _, plan := range plans {
+		if plan.Name == name {
+			return plan, true
+		}
+	}
+	return models.ServicePlanFields{}, false
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedCopy(names []string) []string {
+	sorted := make([]string, len(names))
+	copy(sorted, names)
+	sort.Strings(sorted)
+	return sorted
+}
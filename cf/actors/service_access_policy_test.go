@@ -0,0 +1,245 @@
+package actors_test
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/actors"
+	"github.com/cloudfoundry/cli/cf/actors/fakes"
+	"github.com/cloudfoundry/cli/cf/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ServiceAccessPolicy", func() {
+	var brokers []models.ServiceBroker
+
+	BeforeEach(func() {
+		brokers = []models.ServiceBroker{
+			{
+				Name: "my-broker",
+				Services: []models.ServiceOffering{
+					{
+						ServiceOfferingFields: models.ServiceOfferingFields{Label: "mysql", Guid: "mysql-guid"},
+						Plans: []models.ServicePlanFields{
+							{Name: "small", Guid: "small-guid", Public: true},
+							{Name: "large", Guid: "large-guid", OrgNames: []string{"org-2", "org-1"}},
+							{Name: "beta", Guid: "beta-guid"},
+						},
+					},
+				},
+			},
+			{
+				Name: "second-broker",
+				Services: []models.ServiceOffering{
+					{
+						ServiceOfferingFields: models.ServiceOfferingFields{Label: "mysql", Guid: "second-mysql-guid"},
+						Plans: []models.ServicePlanFields{
+							{Name: "beta", Guid: "second-beta-guid"},
+						},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("ParseServiceAccessPolicy", func() {
+		It("reads brokers, services and plans", func() {
+			policy, err := actors.ParseServiceAccessPolicy(strings.NewReader(`
+brokers:
+- name: my-broker
+  services:
+  - name: mysql
+    plans:
+    - name: small
+      public: true
+    - name: large
+      orgs:
+      - org-1
+    - name: beta
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.Brokers).To(HaveLen(1))
+			Expect(policy.Brokers[0].Name).To(Equal("my-broker"))
+			plans := policy.Brokers[0].Services[0].Plans
+			Expect(plans).To(Equal([]actors.PlanAccessPolicy{
+				{Name: "small", Public: true},
+				{Name: "large", Orgs: []string{"org-1"}},
+				{Name: "beta"},
+			}))
+		})
+
+		It("rejects plans that are both public and limited to orgs", func() {
+			_, err := actors.ParseServiceAccessPolicy(strings.NewReader(`
+brokers:
+- name: my-broker
+  services:
+  - name: mysql
+    plans:
+    - name: small
+      public: true
+      orgs: [org-1]
+`))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("cannot be both public and limited to orgs"))
+		})
+
+		It("returns an error for invalid YAML", func() {
+			_, err := actors.ParseServiceAccessPolicy(strings.NewReader("brokers: [\n"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("NewServiceAccessPolicy", func() {
+		It("describes the current access of each plan", func() {
+			policy := actors.NewServiceAccessPolicy(brokers)
+
+			Expect(policy.Brokers[0].Services[0].Plans).To(Equal([]actors.PlanAccessPolicy{
+				{Name: "small", Public: true},
+				{Name: "large", Orgs: []string{"org-1", "org-2"}},
+				{Name: "beta"},
+			}))
+		})
+
+		It("round trips through YAML", func() {
+			policy := actors.NewServiceAccessPolicy(brokers)
+
+			buffer := new(bytes.Buffer)
+			Expect(policy.Encode(buffer)).NotTo(HaveOccurred())
+
+			parsed, err := actors.ParseServiceAccessPolicy(buffer)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed).To(Equal(policy))
+
+			changes, err := parsed.Diff(brokers)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+	})
+
+	Describe("Diff", func() {
+		policyFor := func(plans ...actors.PlanAccessPolicy) actors.ServiceAccessPolicy {
+			return actors.ServiceAccessPolicy{Brokers: []actors.BrokerAccessPolicy{{
+				Name:     "my-broker",
+				Services: []actors.ServiceOfferingAccessPolicy{{Name: "mysql", Plans: plans}},
+			}}}
+		}
+
+		change := func(changeType actors.ServiceAccessChangeType, planName, orgName string) actors.ServiceAccessChange {
+			var plan models.ServicePlanFields
+			for _, p := range brokers[0].Services[0].Plans {
+				if p.Name == planName {
+					plan = p
+				}
+			}
+
+			return actors.ServiceAccessChange{
+				Type:        changeType,
+				BrokerName:  "my-broker",
+				ServiceName: "mysql",
+				ServiceGuid: "mysql-guid",
+				PlanName:    planName,
+				Plan:        plan,
+				OrgName:     orgName,
+			}
+		}
+
+		It("makes private plans public", func() {
+			changes, err := policyFor(actors.PlanAccessPolicy{Name: "beta", Public: true}).Diff(brokers)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]actors.ServiceAccessChange{change(actors.MakePlanPublic, "beta", "")}))
+		})
+
+		It("adds and removes org visibilities", func() {
+			changes, err := policyFor(actors.PlanAccessPolicy{Name: "large", Orgs: []string{"org-3", "org-1"}}).Diff(brokers)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]actors.ServiceAccessChange{
+				change(actors.EnablePlanForOrg, "large", "org-3"),
+				change(actors.DisablePlanForOrg, "large", "org-2"),
+			}))
+		})
+
+		It("makes public plans private before limiting them to orgs", func() {
+			changes, err := policyFor(actors.PlanAccessPolicy{Name: "small", Orgs: []string{"org-1"}}).Diff(brokers)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(Equal([]actors.ServiceAccessChange{
+				change(actors.MakePlanPrivate, "small", ""),
+				change(actors.EnablePlanForOrg, "small", "org-1"),
+			}))
+		})
+
+		It("uses the plans of the named broker when another broker offers a service with the same name", func() {
+			policy := actors.ServiceAccessPolicy{Brokers: []actors.BrokerAccessPolicy{{
+				Name:     "second-broker",
+				Services: []actors.ServiceOfferingAccessPolicy{{Name: "mysql", Plans: []actors.PlanAccessPolicy{{Name: "beta", Public: true}}}},
+			}}}
+
+			changes, err := policy.Diff(brokers)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].ServiceGuid).To(Equal("second-mysql-guid"))
+			Expect(changes[0].Plan.Guid).To(Equal("second-beta-guid"))
+		})
+
+		It("leaves plans that are not in the policy alone", func() {
+			changes, err := policyFor(actors.PlanAccessPolicy{Name: "beta"}).Diff(brokers)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+		})
+
+		It("returns an error for unknown brokers, services and plans", func() {
+			_, err := actors.ServiceAccessPolicy{Brokers: []actors.BrokerAccessPolicy{{Name: "other-broker"}}}.Diff(brokers)
+			Expect(err.Error()).To(ContainSubstring("other-broker"))
+
+			_, err = actors.ServiceAccessPolicy{Brokers: []actors.BrokerAccessPolicy{{
+				Name:     "my-broker",
+				Services: []actors.ServiceOfferingAccessPolicy{{Name: "postgres"}},
+			}}}.Diff(brokers)
+			Expect(err.Error()).To(ContainSubstring("postgres"))
+
+			_, err = policyFor(actors.PlanAccessPolicy{Name: "huge"}).Diff(brokers)
+			Expect(err.Error()).To(ContainSubstring("huge"))
+		})
+	})
+
+	Describe("ApplyServiceAccessChange", func() {
+		var planActor *fakes.FakeServicePlanActor
+
+		BeforeEach(func() {
+			planActor = &fakes.FakeServicePlanActor{}
+		})
+
+		It("updates the plan by guid for public and private changes", func() {
+			plan := models.ServicePlanFields{Name: "small", Guid: "small-guid", Public: true}
+			err := actors.ApplyServiceAccessChange(planActor, actors.ServiceAccessChange{
+				Type: actors.MakePlanPrivate, ServiceName: "mysql", ServiceGuid: "mysql-guid", PlanName: "small", Plan: plan,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			serviceGuid, updatedPlan, public := planActor.UpdatePlanVisibilityArgsForCall(0)
+			Expect(serviceGuid).To(Equal("mysql-guid"))
+			Expect(updatedPlan).To(Equal(plan))
+			Expect(public).To(BeFalse())
+			Expect(planActor.UpdateSinglePlanForServiceCallCount()).To(Equal(0))
+		})
+
+		It("updates the org visibility of the plan by guid for org changes", func() {
+			err := actors.ApplyServiceAccessChange(planActor, actors.ServiceAccessChange{
+				Type: actors.EnablePlanForOrg, ServiceName: "mysql", PlanName: "large", Plan: models.ServicePlanFields{Guid: "large-guid"}, OrgName: "org-1",
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			planGuid, orgName, visible := planActor.UpdatePlanOrgVisibilityArgsForCall(0)
+			Expect(planGuid).To(Equal("large-guid"))
+			Expect(orgName).To(Equal("org-1"))
+			Expect(visible).To(BeTrue())
+			Expect(planActor.UpdatePlanAndOrgForServiceCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -18,6 +18,8 @@ type ServicePlanActor interface {
 	UpdateOrgForService(string, string, bool) (bool, error)
 	UpdateSinglePlanForService(string, string, bool) (PlanAccess, error)
 	UpdatePlanAndOrgForService(string, string, string, bool) (PlanAccess, error)
+	UpdatePlanVisibility(string, models.ServicePlanFields, bool) error
+	UpdatePlanOrgVisibility(string, string, bool) error
 }
 
 type PlanAccess int
@@ -191,6 +193,27 @@ func (actor ServicePlanHandler) updateSinglePlan(serviceOffering models.ServiceO
 	return access, nil
 }
 
+// UpdatePlanVisibility makes the plan public or private. Unlike
+// UpdateSinglePlanForService it takes the plan as already found, so plans of
+// services with the same label from different brokers are told apart.
+func (actor ServicePlanHandler) UpdatePlanVisibility(serviceGuid string, plan models.ServicePlanFields, setPlanVisibility bool) error {
+	return actor.updateServicePlanAvailability(serviceGuid, plan, setPlanVisibility)
+}
+
+// UpdatePlanOrgVisibility shows or hides the plan with the given guid in the
+// marketplace of an org.
+func (actor ServicePlanHandler) UpdatePlanOrgVisibility(planGuid string, orgName string, setPlanVisibility bool) error {
+	org, err := actor.orgRepo.FindByName(orgName)
+	if err != nil {
+		return err
+	}
+
+	if setPlanVisibility {
+		return actor.servicePlanVisibilityRepo.Create(planGuid, org.Guid)
+	}
+	return actor.deleteServicePlanVisibilities(map[string]string{"organization_guid": org.Guid, "service_plan_guid": planGuid})
+}
+
 func (actor ServicePlanHandler) deleteServicePlanVisibilities(queryParams map[string]string) error {
 	visibilities, err := actor.servicePlanVisibilityRepo.Search(queryParams)
 	if err != nil {
@@ -618,4 +618,62 @@ var _ = Describe("Service Plans", func() {
 			})
 		})
 	})
+
+	Describe(".UpdatePlanVisibility", func() {
+		It("updates the plan it is given without looking up the service by name", func() {
+			err := actor.UpdatePlanVisibility("my-mixed-service-guid", privateServicePlan, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			servicePlan, serviceGuid, public := servicePlanRepo.UpdateArgsForCall(0)
+			Expect(servicePlan.Guid).To(Equal("private-service-plan-guid"))
+			Expect(serviceGuid).To(Equal("my-mixed-service-guid"))
+			Expect(public).To(BeTrue())
+			Expect(serviceBuilder.GetServiceByNameWithPlansWithOrgNamesCallCount()).To(Equal(0))
+		})
+
+		It("removes the visibilities of the plan when making it private", func() {
+			servicePlanVisibilityRepo.SearchReturns(
+				[]models.ServicePlanVisibilityFields{publicServicePlanVisibilityFields}, nil)
+
+			err := actor.UpdatePlanVisibility("my-mixed-service-guid", publicServicePlan, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(servicePlanVisibilityRepo.SearchArgsForCall(0)).To(Equal(map[string]string{"service_plan_guid": "public-service-plan-guid"}))
+			Expect(servicePlanVisibilityRepo.DeleteArgsForCall(0)).To(Equal("public-service-plan-visibility-guid"))
+		})
+	})
+
+	Describe(".UpdatePlanOrgVisibility", func() {
+		It("creates a visibility for the plan guid and org", func() {
+			err := actor.UpdatePlanOrgVisibility("private-service-plan-guid", "org-1", true)
+			Expect(err).NotTo(HaveOccurred())
+
+			servicePlanGuid, orgGuid := servicePlanVisibilityRepo.CreateArgsForCall(0)
+			Expect(servicePlanGuid).To(Equal("private-service-plan-guid"))
+			Expect(orgGuid).To(Equal("org-1-guid"))
+			Expect(serviceBuilder.GetServiceByNameForOrgCallCount()).To(Equal(0))
+		})
+
+		It("deletes the visibilities of the plan guid in the org", func() {
+			servicePlanVisibilityRepo.SearchReturns(
+				[]models.ServicePlanVisibilityFields{limitedServicePlanVisibilityFields}, nil)
+
+			err := actor.UpdatePlanOrgVisibility("limited-service-plan-guid", "org-1", false)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(servicePlanVisibilityRepo.SearchArgsForCall(0)).To(Equal(map[string]string{
+				"organization_guid": "org-1-guid",
+				"service_plan_guid": "limited-service-plan-guid",
+			}))
+			Expect(servicePlanVisibilityRepo.DeleteArgsForCall(0)).To(Equal("limited-service-plan-visibility-guid"))
+		})
+
+		It("returns an error when the org cannot be found", func() {
+			orgRepo.FindByNameReturns(models.Organization{}, errors.New("org not found"))
+
+			err := actor.UpdatePlanOrgVisibility("limited-service-plan-guid", "org-3", true)
+			Expect(err).To(HaveOccurred())
+			Expect(servicePlanVisibilityRepo.CreateCallCount()).To(Equal(0))
+		})
+	})
 })
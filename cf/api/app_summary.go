@@ -22,20 +22,26 @@ func (resource ApplicationSummaries) ToModels() (apps []models.ApplicationFields
 }
 
 type ApplicationFromSummary struct {
-	Guid               string
-	Name               string
-	Routes             []RouteSummary
-	Services           []ServicePlanSummary
-	RunningInstances   int `json:"running_instances"`
-	Memory             int64
-	Instances          int
-	DiskQuota          int64 `json:"disk_quota"`
-	Urls               []string
-	EnvironmentVars    map[string]interface{} `json:"environment_json,omitempty"`
-	HealthCheckTimeout int                    `json:"health_check_timeout"`
-	State              string
-	SpaceGuid          string     `json:"space_guid"`
-	PackageUpdatedAt   *time.Time `json:"package_updated_at"`
+	Guid                string
+	Name                string
+	Routes              []RouteSummary
+	Services            []ServicePlanSummary
+	ServiceNames        []string `json:"service_names"`
+	RunningInstances    int      `json:"running_instances"`
+	Memory              int64
+	Instances           int
+	Buildpack           string
+	Command             string
+	StackGuid           string `json:"stack_guid"`
+	DiskQuota           int64  `json:"disk_quota"`
+	Urls                []string
+	EnvironmentVars     map[string]interface{} `json:"environment_json,omitempty"`
+	HealthCheckTimeout  int                    `json:"health_check_timeout"`
+	HealthCheckType     string                 `json:"health_check_type"`
+	HealthCheckEndpoint string                 `json:"health_check_http_endpoint"`
+	State               string
+	SpaceGuid           string     `json:"space_guid"`
+	PackageUpdatedAt    *time.Time `json:"package_updated_at"`
 }
 
 func (resource ApplicationFromSummary) ToFields() (app models.ApplicationFields) {
@@ -47,9 +53,13 @@ func (resource ApplicationFromSummary) ToFields() (app models.ApplicationFields)
 	app.DiskQuota = resource.DiskQuota
 	app.RunningInstances = resource.RunningInstances
 	app.Memory = resource.Memory
+	app.BuildpackUrl = resource.Buildpack
+	app.Command = resource.Command
 	app.SpaceGuid = resource.SpaceGuid
 	app.PackageUpdatedAt = resource.PackageUpdatedAt
 	app.HealthCheckTimeout = resource.HealthCheckTimeout
+	app.HealthCheckType = resource.HealthCheckType
+	app.HealthCheckEndpoint = resource.HealthCheckEndpoint
 
 	return
 }
@@ -67,11 +77,21 @@ func (resource ApplicationFromSummary) ToModel() (app models.Application) {
 	for _, service := range resource.Services {
 		services = append(services, service.ToModel())
 	}
+	// space summaries only carry the names of the bound service instances
+	if len(services) == 0 {
+		for _, name := range resource.ServiceNames {
+			services = append(services, models.ServicePlanSummary{Name: name})
+		}
+	}
 
 	app.EnvironmentVars = resource.EnvironmentVars
 	app.Routes = routes
 	app.Services = services
 
+	if resource.StackGuid != "" {
+		app.Stack = &models.Stack{Guid: resource.StackGuid}
+	}
+
 	return
 }
 
@@ -107,6 +127,7 @@ type DomainSummary struct {
 
 type AppSummaryRepository interface {
 	GetSummariesInCurrentSpace() (apps []models.Application, apiErr error)
+	GetSummariesInSpace(spaceGuid string) (apps []models.Application, apiErr error)
 	GetSummary(appGuid string) (summary models.Application, apiErr error)
 }
 
@@ -122,9 +143,13 @@ func NewCloudControllerAppSummaryRepository(config core_config.Reader, gateway n
 }
 
 func (repo CloudControllerAppSummaryRepository) GetSummariesInCurrentSpace() (apps []models.Application, apiErr error) {
+	return repo.GetSummariesInSpace(repo.config.SpaceFields().Guid)
+}
+
+func (repo CloudControllerAppSummaryRepository) GetSummariesInSpace(spaceGuid string) (apps []models.Application, apiErr error) {
 	resources := new(ApplicationSummaries)
 
-	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), repo.config.SpaceFields().Guid)
+	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), spaceGuid)
 	apiErr = repo.gateway.GetResource(path, resources)
 	if apiErr != nil {
 		return
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/net"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testnet "github.com/cloudfoundry/cli/testhelpers/net"
@@ -45,6 +46,32 @@ var _ = Describe("AppSummaryRepository", func() {
 		testServer.Close()
 	})
 
+	It("gets the app summaries of a space other than the targeted one", func() {
+		otherServer, otherHandler := testnet.NewServer([]testnet.TestRequest{
+			testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/spaces/other-space-guid/summary",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   getAppSummariesResponseBody,
+				},
+			}),
+		})
+		defer otherServer.Close()
+
+		configRepo := testconfig.NewRepositoryWithDefaults()
+		configRepo.SetApiEndpoint(otherServer.URL)
+		gateway := net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{})
+		otherRepo := NewCloudControllerAppSummaryRepository(configRepo, gateway)
+
+		apps, apiErr := otherRepo.GetSummariesInSpace("other-space-guid")
+		Expect(otherHandler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(len(apps)).To(Equal(3))
+		Expect(apps[0].Name).To(Equal("app1"))
+		Expect(apps[0].Services).To(Equal([]models.ServicePlanSummary{{Name: "my-service-instance"}}))
+	})
+
 	It("returns a slice of app summaries for each instance", func() {
 		apps, apiErr := repo.GetSummariesInCurrentSpace()
 		Expect(handler).To(HaveAllRequestsCalled())
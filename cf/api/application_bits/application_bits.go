@@ -25,6 +25,7 @@ const (
 type ApplicationBitsRepository interface {
 	GetApplicationFiles(appFilesRequest []resources.AppFileResource) ([]resources.AppFileResource, error)
 	UploadBits(appGuid string, zipFile *os.File, presentFiles []resources.AppFileResource) (apiErr error)
+	DownloadBits(appGuid string, zipFile *os.File) (apiErr error)
 }
 
 type CloudControllerApplicationBitsRepository struct {
@@ -82,6 +83,28 @@ func (repo CloudControllerApplicationBitsRepository) UploadBits(appGuid string,
 	return
 }
 
+// DownloadBits writes the app's last uploaded package to zipFile.
+func (repo CloudControllerApplicationBitsRepository) DownloadBits(appGuid string, zipFile *os.File) (apiErr error) {
+	apiUrl := fmt.Sprintf("%s/v2/apps/%s/download", repo.config.ApiEndpoint(), appGuid)
+
+	request, apiErr := repo.gateway.NewRequest("GET", apiUrl, repo.config.AccessToken(), nil)
+	if apiErr != nil {
+		return
+	}
+
+	response, apiErr := repo.gateway.PerformRequest(request)
+	if apiErr != nil {
+		return
+	}
+	defer response.Body.Close()
+
+	_, err := io.Copy(zipFile, response.Body)
+	if err != nil {
+		apiErr = errors.NewWithError(T("Error writing to tmp file: {{.Err}}", map[string]interface{}{"Err": err}), err)
+	}
+	return
+}
+
 func (repo CloudControllerApplicationBitsRepository) GetApplicationFiles(appFilesToCheck []resources.AppFileResource) ([]resources.AppFileResource, error) {
 	allAppFilesJson, err := json.Marshal(appFilesToCheck)
 	if err != nil {
@@ -3,6 +3,7 @@ package application_bits_test
 import (
 	"archive/zip"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
 	"net/http/httptest"
@@ -22,6 +23,7 @@ import (
 	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
 
 	. "github.com/cloudfoundry/cli/cf/api/application_bits"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -200,6 +202,33 @@ var _ = Describe("CloudControllerApplicationBitsRepository", func() {
 		})
 	})
 
+	Describe(".DownloadBits", func() {
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It("writes the app's package to the given file", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/apps/my-cool-app-guid/download",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: "zip contents"},
+			}))
+
+			zipFile, err := ioutil.TempFile("", "download")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Remove(zipFile.Name())
+			defer zipFile.Close()
+
+			apiErr := repo.DownloadBits("my-cool-app-guid", zipFile)
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(zipFile.Name())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring("zip contents"))
+		})
+	})
+
 	Describe(".GetApplicationFiles", func() {
 		It("accepts a slice of files and returns a slice of the files that it already has", func() {
 			setupTestServer(matchResourceRequest)
@@ -28,6 +28,15 @@ type FakeApplicationBitsRepository struct {
 	uploadBitsReturns struct {
 		result1 error
 	}
+	DownloadBitsStub        func(appGuid string, zipFile *os.File) (apiErr error)
+	downloadBitsMutex       sync.RWMutex
+	downloadBitsArgsForCall []struct {
+		arg1 string
+		arg2 *os.File
+	}
+	downloadBitsReturns struct {
+		result1 error
+	}
 }
 
 func (fake *FakeApplicationBitsRepository) GetApplicationFiles(arg1 []resources.AppFileResource) ([]resources.AppFileResource, error) {
@@ -95,4 +104,36 @@ func (fake *FakeApplicationBitsRepository) UploadBitsReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeApplicationBitsRepository) DownloadBits(arg1 string, arg2 *os.File) (apiErr error) {
+	fake.downloadBitsMutex.Lock()
+	defer fake.downloadBitsMutex.Unlock()
+	fake.downloadBitsArgsForCall = append(fake.downloadBitsArgsForCall, struct {
+		arg1 string
+		arg2 *os.File
+	}{arg1, arg2})
+	if fake.DownloadBitsStub != nil {
+		return fake.DownloadBitsStub(arg1, arg2)
+	} else {
+		return fake.downloadBitsReturns.result1
+	}
+}
+
+func (fake *FakeApplicationBitsRepository) DownloadBitsCallCount() int {
+	fake.downloadBitsMutex.RLock()
+	defer fake.downloadBitsMutex.RUnlock()
+	return len(fake.downloadBitsArgsForCall)
+}
+
+func (fake *FakeApplicationBitsRepository) DownloadBitsArgsForCall(i int) (string, *os.File) {
+	fake.downloadBitsMutex.RLock()
+	defer fake.downloadBitsMutex.RUnlock()
+	return fake.downloadBitsArgsForCall[i].arg1, fake.downloadBitsArgsForCall[i].arg2
+}
+
+func (fake *FakeApplicationBitsRepository) DownloadBitsReturns(result1 error) {
+	fake.downloadBitsReturns = struct {
+		result1 error
+	}{result1}
+}
+
 var _ ApplicationBitsRepository = new(FakeApplicationBitsRepository)
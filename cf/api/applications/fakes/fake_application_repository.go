@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/cloudfoundry/cli/cf/api/applications"
+	cferrors "github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/models"
 )
 
@@ -19,6 +20,9 @@ type FakeApplicationRepository struct {
 		App   models.Application
 		Error error
 	}
+	// ReadByName, when set, is used instead of ReadReturns; names that are
+	// missing from it are reported as not found.
+	ReadByName map[string]models.Application
 
 	CreateAppParams []models.AppParams
 
@@ -126,6 +130,14 @@ func (fake *FakeApplicationRepository) ReadEnvReturns(result1 *models.Environmen
 func (repo *FakeApplicationRepository) Read(name string) (app models.Application, apiErr error) {
 	repo.ReadCalls++
 	repo.ReadArgs.Name = name
+	if repo.ReadByName != nil {
+		var found bool
+		app, found = repo.ReadByName[name]
+		if !found {
+			apiErr = cferrors.NewModelNotFoundError("App", name)
+		}
+		return
+	}
 	return repo.ReadReturns.App, repo.ReadReturns.Error
 }
 
@@ -22,6 +22,8 @@ type DomainRepository interface {
 	CreateSharedDomain(domainName string) (apiErr error)
 	Delete(domainGuid string) (apiErr error)
 	DeleteSharedDomain(domainGuid string) (apiErr error)
+	SharePrivateDomain(domainGuid string, orgGuid string) (apiErr error)
+	UnsharePrivateDomain(domainGuid string, orgGuid string) (apiErr error)
 	FirstOrDefault(orgGuid string, name *string) (domain models.DomainFields, error error)
 }
 
@@ -151,6 +153,19 @@ func (repo CloudControllerDomainRepository) DeleteSharedDomain(domainGuid string
 		repo.strategy.DeleteSharedDomainURL(domainGuid))
 }
 
+func (repo CloudControllerDomainRepository) SharePrivateDomain(domainGuid string, orgGuid string) error {
+	return repo.gateway.UpdateResource(
+		repo.config.ApiEndpoint(),
+		repo.strategy.SharedPrivateDomainURL(orgGuid, domainGuid),
+		nil)
+}
+
+func (repo CloudControllerDomainRepository) UnsharePrivateDomain(domainGuid string, orgGuid string) error {
+	return repo.gateway.DeleteResource(
+		repo.config.ApiEndpoint(),
+		repo.strategy.SharedPrivateDomainURL(orgGuid, domainGuid))
+}
+
 func (repo CloudControllerDomainRepository) FirstOrDefault(orgGuid string, name *string) (domain models.DomainFields, error error) {
 	if name == nil {
 		domain, error = repo.defaultDomain(orgGuid)
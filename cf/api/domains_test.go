@@ -357,6 +357,55 @@ var _ = Describe("DomainRepository", func() {
 		})
 	})
 
+	Describe("sharing private domains", func() {
+		Context("when the private domains endpoint is available", func() {
+			BeforeEach(func() {
+				config.SetApiVersion("2.2.0")
+			})
+
+			It("associates the domain with the org", func() {
+				setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "PUT",
+					Path:     "/v2/organizations/other-org-guid/private_domains/my-domain-guid",
+					Response: testnet.TestResponse{Status: http.StatusCreated},
+				}))
+
+				apiErr := repo.SharePrivateDomain("my-domain-guid", "other-org-guid")
+
+				Expect(handler).To(HaveAllRequestsCalled())
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+
+			It("removes the association with the org", func() {
+				setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "DELETE",
+					Path:     "/v2/organizations/other-org-guid/private_domains/my-domain-guid",
+					Response: testnet.TestResponse{Status: http.StatusCreated},
+				}))
+
+				apiErr := repo.UnsharePrivateDomain("my-domain-guid", "other-org-guid")
+
+				Expect(handler).To(HaveAllRequestsCalled())
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the private domains endpoint is not available", func() {
+			It("uses the org domains endpoint", func() {
+				setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "PUT",
+					Path:     "/v2/organizations/other-org-guid/domains/my-domain-guid",
+					Response: testnet.TestResponse{Status: http.StatusCreated},
+				}))
+
+				apiErr := repo.SharePrivateDomain("my-domain-guid", "other-org-guid")
+
+				Expect(handler).To(HaveAllRequestsCalled())
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("deleting shared domains", func() {
 		Context("when the shared domains endpoint is available", func() {
 			BeforeEach(func() {
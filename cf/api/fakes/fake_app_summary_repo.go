@@ -8,9 +8,14 @@ import (
 type FakeAppSummaryRepo struct {
 	GetSummariesInCurrentSpaceApps []models.Application
 
+	GetSummariesInSpaceGuid string
+	GetSummariesInSpaceApps []models.Application
+	GetSummariesInSpaceErr  error
+
 	GetSummaryErrorCode string
 	GetSummaryAppGuid   string
 	GetSummarySummary   models.Application
+	GetSummaryByGuid    map[string]models.Application
 }
 
 func (repo *FakeAppSummaryRepo) GetSummariesInCurrentSpace() (apps []models.Application, apiErr error) {
@@ -18,9 +23,17 @@ func (repo *FakeAppSummaryRepo) GetSummariesInCurrentSpace() (apps []models.Appl
 	return
 }
 
+func (repo *FakeAppSummaryRepo) GetSummariesInSpace(spaceGuid string) (apps []models.Application, apiErr error) {
+	repo.GetSummariesInSpaceGuid = spaceGuid
+	return repo.GetSummariesInSpaceApps, repo.GetSummariesInSpaceErr
+}
+
 func (repo *FakeAppSummaryRepo) GetSummary(appGuid string) (summary models.Application, apiErr error) {
 	repo.GetSummaryAppGuid = appGuid
 	summary = repo.GetSummarySummary
+	if app, ok := repo.GetSummaryByGuid[appGuid]; ok {
+		summary = app
+	}
 
 	if repo.GetSummaryErrorCode != "" {
 		apiErr = errors.NewHttpError(400, repo.GetSummaryErrorCode, "Error")
@@ -30,6 +30,14 @@ type FakeDomainRepository struct {
 
 	DeleteSharedDomainGuid  string
 	DeleteSharedApiResponse error
+
+	SharePrivateDomainGuid    string
+	SharePrivateDomainOrgGuid string
+	SharePrivateDomainErr     error
+
+	UnsharePrivateDomainGuid    string
+	UnsharePrivateDomainOrgGuid string
+	UnsharePrivateDomainErr     error
 }
 
 func (repo *FakeDomainRepository) ListDomainsForOrg(orgGuid string, cb func(models.DomainFields) bool) error {
@@ -85,6 +93,18 @@ func (repo *FakeDomainRepository) DeleteSharedDomain(domainGuid string) (apiErr
 	return
 }
 
+func (repo *FakeDomainRepository) SharePrivateDomain(domainGuid string, orgGuid string) (apiErr error) {
+	repo.SharePrivateDomainGuid = domainGuid
+	repo.SharePrivateDomainOrgGuid = orgGuid
+	return repo.SharePrivateDomainErr
+}
+
+func (repo *FakeDomainRepository) UnsharePrivateDomain(domainGuid string, orgGuid string) (apiErr error) {
+	repo.UnsharePrivateDomainGuid = domainGuid
+	repo.UnsharePrivateDomainOrgGuid = orgGuid
+	return repo.UnsharePrivateDomainErr
+}
+
 func (repo *FakeDomainRepository) FirstOrDefault(orgGuid string, name *string) (domain models.DomainFields, error error) {
 	if name == nil {
 		domain, error = repo.defaultDomain(orgGuid)
@@ -25,20 +25,32 @@ type FakeRouteRepository struct {
 	CreateInSpaceSpaceGuid    string
 	CreateInSpaceCreatedRoute models.Route
 	CreateInSpaceErr          bool
+	CreateInSpaceSpaceGuids   []string
+
+	CreateInSpaceErrBySpaceGuid map[string]error
 
 	CheckIfExistsFound bool
 	CheckIfExistsError error
 
-	BindErr        error
-	BoundRouteGuid string
-	BoundAppGuid   string
+	BindErr            error
+	BindErrByRouteGuid map[string]error
+	BoundRouteGuid     string
+	BoundAppGuid       string
+	BoundRouteGuids    []string
+	BoundAppGuids      []string
 
-	UnboundRouteGuid string
-	UnboundAppGuid   string
+	UnbindErrByRouteGuid map[string]error
+	UnboundRouteGuid     string
+	UnboundAppGuid       string
+	UnboundRouteGuids    []string
+	UnboundAppGuids      []string
 
 	ListErr bool
 	Routes  []models.Route
 
+	ListRoutesInOrgGuid string
+	OrgRoutes           []models.Route
+
 	DeletedRouteGuids []string
 	DeleteErr         error
 }
@@ -56,6 +68,20 @@ func (repo *FakeRouteRepository) ListRoutes(cb func(models.Route) bool) (apiErr
 	return
 }
 
+func (repo *FakeRouteRepository) ListRoutesInOrg(orgGuid string, cb func(models.Route) bool) (apiErr error) {
+	repo.ListRoutesInOrgGuid = orgGuid
+	if repo.ListErr {
+		return errors.New("WHOOPSIE")
+	}
+
+	for _, route := range repo.OrgRoutes {
+		if !cb(route) {
+			break
+		}
+	}
+	return
+}
+
 func (repo *FakeRouteRepository) FindByHostAndDomain(host string, domain models.DomainFields) (route models.Route, apiErr error) {
 	repo.FindByHostAndDomainCalledWith.Host = host
 	repo.FindByHostAndDomainCalledWith.Domain = domain
@@ -95,8 +121,11 @@ func (repo *FakeRouteRepository) CreateInSpace(host, domainGuid, spaceGuid strin
 	repo.CreateInSpaceHost = host
 	repo.CreateInSpaceDomainGuid = domainGuid
 	repo.CreateInSpaceSpaceGuid = spaceGuid
+	repo.CreateInSpaceSpaceGuids = append(repo.CreateInSpaceSpaceGuids, spaceGuid)
 
-	if repo.CreateInSpaceErr {
+	if err, found := repo.CreateInSpaceErrBySpaceGuid[spaceGuid]; found {
+		apiErr = err
+	} else if repo.CreateInSpaceErr {
 		apiErr = errors.New("Error")
 	} else {
 		createdRoute = repo.CreateInSpaceCreatedRoute
@@ -108,13 +137,20 @@ func (repo *FakeRouteRepository) CreateInSpace(host, domainGuid, spaceGuid strin
 func (repo *FakeRouteRepository) Bind(routeGuid, appGuid string) (apiErr error) {
 	repo.BoundRouteGuid = routeGuid
 	repo.BoundAppGuid = appGuid
+	repo.BoundRouteGuids = append(repo.BoundRouteGuids, routeGuid)
+	repo.BoundAppGuids = append(repo.BoundAppGuids, appGuid)
+	if err, found := repo.BindErrByRouteGuid[routeGuid]; found {
+		return err
+	}
 	return repo.BindErr
 }
 
 func (repo *FakeRouteRepository) Unbind(routeGuid, appGuid string) (apiErr error) {
 	repo.UnboundRouteGuid = routeGuid
 	repo.UnboundAppGuid = appGuid
-	return
+	repo.UnboundRouteGuids = append(repo.UnboundRouteGuids, routeGuid)
+	repo.UnboundAppGuids = append(repo.UnboundAppGuids, appGuid)
+	return repo.UnbindErrByRouteGuid[routeGuid]
 }
 
 func (repo *FakeRouteRepository) Delete(routeGuid string) (apiErr error) {
@@ -8,16 +8,21 @@ import (
 type FakeServiceBindingRepo struct {
 	CreateServiceInstanceGuid string
 	CreateApplicationGuid     string
+	CreateParams              map[string]interface{}
 	CreateErrorCode           string
+	CreateApplicationGuids    []string
 
-	DeleteServiceInstance models.ServiceInstance
-	DeleteApplicationGuid string
-	DeleteBindingNotFound bool
+	DeleteServiceInstance  models.ServiceInstance
+	DeleteApplicationGuid  string
+	DeleteBindingNotFound  bool
+	DeleteApplicationGuids []string
 }
 
-func (repo *FakeServiceBindingRepo) Create(instanceGuid, appGuid string) (apiErr error) {
+func (repo *FakeServiceBindingRepo) Create(instanceGuid, appGuid string, params map[string]interface{}) (apiErr error) {
 	repo.CreateServiceInstanceGuid = instanceGuid
 	repo.CreateApplicationGuid = appGuid
+	repo.CreateParams = params
+	repo.CreateApplicationGuids = append(repo.CreateApplicationGuids, appGuid)
 
 	if repo.CreateErrorCode != "" {
 		apiErr = errors.NewHttpError(400, repo.CreateErrorCode, "Error binding service")
@@ -29,6 +34,7 @@ func (repo *FakeServiceBindingRepo) Create(instanceGuid, appGuid string) (apiErr
 func (repo *FakeServiceBindingRepo) Delete(instance models.ServiceInstance, appGuid string) (found bool, apiErr error) {
 	repo.DeleteServiceInstance = instance
 	repo.DeleteApplicationGuid = appGuid
+	repo.DeleteApplicationGuids = append(repo.DeleteApplicationGuids, appGuid)
 	found = !repo.DeleteBindingNotFound
 	return
 }
@@ -14,10 +14,11 @@ type FakeServiceBrokerRepo struct {
 	FindByGuidServiceBroker models.ServiceBroker
 	FindByGuidNotFound      bool
 
-	CreateName     string
-	CreateUrl      string
-	CreateUsername string
-	CreatePassword string
+	CreateName      string
+	CreateUrl       string
+	CreateUsername  string
+	CreatePassword  string
+	CreateSpaceGuid string
 
 	UpdatedServiceBroker     models.ServiceBroker
 	RenamedServiceBrokerGuid string
@@ -72,6 +73,11 @@ func (repo *FakeServiceBrokerRepo) Create(name, url, username, password string)
 	return
 }
 
+func (repo *FakeServiceBrokerRepo) CreateSpaceScoped(name, url, username, password, spaceGuid string) (apiErr error) {
+	repo.CreateSpaceGuid = spaceGuid
+	return repo.Create(name, url, username, password)
+}
+
 func (repo *FakeServiceBrokerRepo) Update(serviceBroker models.ServiceBroker) (apiErr error) {
 	repo.UpdatedServiceBroker = serviceBroker
 	return
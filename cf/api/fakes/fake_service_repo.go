@@ -39,14 +39,21 @@ type FakeServiceRepo struct {
 	CreateServiceInstanceArgs struct {
 		Name     string
 		PlanGuid string
+		Params   map[string]interface{}
+		Tags     []string
 	}
 	CreateServiceInstanceReturns struct {
 		Error error
 	}
 
+	CreateServiceInstanceInSpaceArgs []CreateServiceInstanceInSpaceArgs
+	CreateServiceInstanceInSpaceErr  error
+
 	UpdateServiceInstanceArgs struct {
 		InstanceGuid string
 		PlanGuid     string
+		Params       map[string]interface{}
+		Tags         []string
 	}
 
 	UpdateServiceInstanceReturnsErr bool
@@ -58,6 +65,11 @@ type FakeServiceRepo struct {
 
 	FindInstanceByNameMap generic.Map
 
+	// FindInstanceByNameSequence is returned one instance per call before
+	// falling back to the fields above, to simulate polling.
+	FindInstanceByNameSequence  []models.ServiceInstance
+	FindInstanceByNameCallCount int
+
 	DeleteServiceServiceInstance models.ServiceInstance
 
 	RenameServiceServiceInstance models.ServiceInstance
@@ -140,20 +152,35 @@ func (repo *FakeServiceRepo) FindServiceOfferingsByLabel(name string) (offerings
 	return
 }
 
-func (repo *FakeServiceRepo) CreateServiceInstance(name, planGuid string) (apiErr error) {
+func (repo *FakeServiceRepo) CreateServiceInstance(name, planGuid string, params map[string]interface{}, tags []string) (apiErr error) {
 	repo.CreateServiceInstanceArgs.Name = name
 	repo.CreateServiceInstanceArgs.PlanGuid = planGuid
+	repo.CreateServiceInstanceArgs.Params = params
+	repo.CreateServiceInstanceArgs.Tags = tags
 
 	return repo.CreateServiceInstanceReturns.Error
 }
 
-func (repo *FakeServiceRepo) UpdateServiceInstance(instanceGuid, planGuid string) (apiErr error) {
+func (repo *FakeServiceRepo) CreateServiceInstanceInSpace(spaceGuid, name, planGuid string, params map[string]interface{}, tags []string) (apiErr error) {
+	repo.CreateServiceInstanceInSpaceArgs = append(repo.CreateServiceInstanceInSpaceArgs, CreateServiceInstanceInSpaceArgs{
+		SpaceGuid: spaceGuid,
+		Name:      name,
+		PlanGuid:  planGuid,
+		Params:    params,
+		Tags:      tags,
+	})
+	return repo.CreateServiceInstanceInSpaceErr
+}
+
+func (repo *FakeServiceRepo) UpdateServiceInstance(instanceGuid, planGuid string, params map[string]interface{}, tags []string) (apiErr error) {
 
 	if repo.UpdateServiceInstanceReturnsErr {
 		apiErr = errors.New("Error updating service instance")
 	} else {
 		repo.UpdateServiceInstanceArgs.InstanceGuid = instanceGuid
 		repo.UpdateServiceInstanceArgs.PlanGuid = planGuid
+		repo.UpdateServiceInstanceArgs.Params = params
+		repo.UpdateServiceInstanceArgs.Tags = tags
 	}
 
 	return
@@ -161,8 +188,16 @@ func (repo *FakeServiceRepo) UpdateServiceInstance(instanceGuid, planGuid string
 
 func (repo *FakeServiceRepo) FindInstanceByName(name string) (instance models.ServiceInstance, apiErr error) {
 	repo.FindInstanceByNameName = name
+	repo.FindInstanceByNameCallCount++
 
-	if repo.FindInstanceByNameMap != nil && repo.FindInstanceByNameMap.Has(name) {
+	if len(repo.FindInstanceByNameSequence) > 0 {
+		instance = repo.FindInstanceByNameSequence[0]
+		repo.FindInstanceByNameSequence = repo.FindInstanceByNameSequence[1:]
+		return
+	}
+
+	inMap := repo.FindInstanceByNameMap != nil && repo.FindInstanceByNameMap.Has(name)
+	if inMap {
 		instance = repo.FindInstanceByNameMap.Get(name).(models.ServiceInstance)
 	} else {
 		instance = repo.FindInstanceByNameServiceInstance
@@ -172,7 +207,7 @@ func (repo *FakeServiceRepo) FindInstanceByName(name string) (instance models.Se
 		apiErr = errors.New("Error finding instance")
 	}
 
-	if repo.FindInstanceByNameNotFound {
+	if repo.FindInstanceByNameNotFound && !inMap {
 		apiErr = errors.NewModelNotFoundError("Service instance", name)
 	}
 
@@ -231,3 +266,11 @@ func (repo *FakeServiceRepo) MigrateServicePlanFromV1ToV2(v1PlanGuid, v2PlanGuid
 	apiErr = repo.MigrateServicePlanFromV1ToV2Response
 	return
 }
+
+type CreateServiceInstanceInSpaceArgs struct {
+	SpaceGuid string
+	Name      string
+	PlanGuid  string
+	Params    map[string]interface{}
+	Tags      []string
+}
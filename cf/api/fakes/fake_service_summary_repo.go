@@ -4,9 +4,55 @@ import "github.com/cloudfoundry/cli/cf/models"
 
 type FakeServiceSummaryRepo struct {
 	GetSummariesInCurrentSpaceInstances []models.ServiceInstance
+
+	GetSummariesInSpaceGuid      string
+	GetSummariesInSpaceInstances []models.ServiceInstance
+	GetSummariesInSpaceErr       error
+
+	// GetSummariesInSpaceSequence is returned one list per call before
+	// falling back to the fields above.
+	GetSummariesInSpaceSequence [][]models.ServiceInstance
+
+	GetServiceInstanceSummaryGuid     string
+	GetServiceInstanceSummaryInstance models.ServiceInstance
+	GetServiceInstanceSummaryErr      error
+
+	GetUserProvidedServiceInstanceSummaryGuid     string
+	GetUserProvidedServiceInstanceSummaryInstance models.ServiceInstance
+	GetUserProvidedServiceInstanceSummaryErr      error
+
+	GetServicePlanHistoryGuid    string
+	GetServicePlanHistoryChanges []models.ServicePlanChange
+	GetServicePlanHistoryErr     error
 }
 
 func (repo *FakeServiceSummaryRepo) GetSummariesInCurrentSpace() (instances []models.ServiceInstance, apiErr error) {
 	instances = repo.GetSummariesInCurrentSpaceInstances
 	return
 }
+
+func (repo *FakeServiceSummaryRepo) GetSummariesInSpace(spaceGuid string) (instances []models.ServiceInstance, apiErr error) {
+	repo.GetSummariesInSpaceGuid = spaceGuid
+
+	if len(repo.GetSummariesInSpaceSequence) > 0 {
+		instances = repo.GetSummariesInSpaceSequence[0]
+		repo.GetSummariesInSpaceSequence = repo.GetSummariesInSpaceSequence[1:]
+		return
+	}
+	return repo.GetSummariesInSpaceInstances, repo.GetSummariesInSpaceErr
+}
+
+func (repo *FakeServiceSummaryRepo) GetServiceInstanceSummary(instanceGuid string) (instance models.ServiceInstance, apiErr error) {
+	repo.GetServiceInstanceSummaryGuid = instanceGuid
+	return repo.GetServiceInstanceSummaryInstance, repo.GetServiceInstanceSummaryErr
+}
+
+func (repo *FakeServiceSummaryRepo) GetUserProvidedServiceInstanceSummary(instanceGuid string) (instance models.ServiceInstance, apiErr error) {
+	repo.GetUserProvidedServiceInstanceSummaryGuid = instanceGuid
+	return repo.GetUserProvidedServiceInstanceSummaryInstance, repo.GetUserProvidedServiceInstanceSummaryErr
+}
+
+func (repo *FakeServiceSummaryRepo) GetServicePlanHistory(instanceGuid string) (changes []models.ServicePlanChange, apiErr error) {
+	repo.GetServicePlanHistoryGuid = instanceGuid
+	return repo.GetServicePlanHistoryChanges, repo.GetServicePlanHistoryErr
+}
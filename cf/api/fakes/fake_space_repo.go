@@ -10,6 +10,8 @@ type FakeSpaceRepository struct {
 
 	Spaces []models.Space
 
+	ListSpacesFromOrgGuid string
+
 	FindByNameName     string
 	FindByNameSpace    models.Space
 	FindByNameErr      bool
@@ -20,6 +22,10 @@ type FakeSpaceRepository struct {
 	FindByNameInOrgSpace   models.Space
 	FindByNameInOrgError   error
 
+	FindByGuidGuid  string
+	FindByGuidSpace models.Space
+	FindByGuidErr   error
+
 	SummarySpace models.Space
 
 	CreateSpaceName           string
@@ -47,6 +53,16 @@ func (repo FakeSpaceRepository) ListSpaces(callback func(models.Space) bool) err
 	return nil
 }
 
+func (repo *FakeSpaceRepository) ListSpacesFromOrg(orgGuid string, callback func(models.Space) bool) error {
+	repo.ListSpacesFromOrgGuid = orgGuid
+	for _, space := range repo.Spaces {
+		if !callback(space) {
+			break
+		}
+	}
+	return nil
+}
+
 func (repo *FakeSpaceRepository) FindByName(name string) (space models.Space, apiErr error) {
 	repo.FindByNameName = name
 
@@ -78,6 +94,11 @@ func (repo *FakeSpaceRepository) FindByNameInOrg(name, orgGuid string) (space mo
 	return
 }
 
+func (repo *FakeSpaceRepository) FindByGuid(guid string) (space models.Space, apiErr error) {
+	repo.FindByGuidGuid = guid
+	return repo.FindByGuidSpace, repo.FindByGuidErr
+}
+
 func (repo *FakeSpaceRepository) GetSummary() (space models.Space, apiErr error) {
 	space = repo.SummarySpace
 	return
@@ -6,14 +6,27 @@ type FakeUserProvidedServiceInstanceRepo struct {
 	CreateName     string
 	CreateDrainUrl string
 	CreateParams   map[string]interface{}
+	CreateTags     []string
+
+	CreateInSpaceSpaceGuid string
+	CreateInSpaceName      string
+	CreateInSpaceDrainUrl  string
+	CreateInSpaceParams    map[string]interface{}
+	CreateInSpaceTags      []string
+	CreateInSpaceErr       error
+
+	FindByGuidGuid                  string
+	FindByGuidServiceInstanceFields models.ServiceInstanceFields
+	FindByGuidErr                   error
 
 	UpdateServiceInstance models.ServiceInstanceFields
 }
 
-func (repo *FakeUserProvidedServiceInstanceRepo) Create(name, drainUrl string, params map[string]interface{}) (apiErr error) {
+func (repo *FakeUserProvidedServiceInstanceRepo) Create(name, drainUrl string, params map[string]interface{}, tags []string) (apiErr error) {
 	repo.CreateName = name
 	repo.CreateDrainUrl = drainUrl
 	repo.CreateParams = params
+	repo.CreateTags = tags
 	return
 }
 
@@ -21,3 +34,17 @@ func (repo *FakeUserProvidedServiceInstanceRepo) Update(serviceInstance models.S
 	repo.UpdateServiceInstance = serviceInstance
 	return
 }
+
+func (repo *FakeUserProvidedServiceInstanceRepo) CreateInSpace(spaceGuid, name, drainUrl string, params map[string]interface{}, tags []string) (apiErr error) {
+	repo.CreateInSpaceSpaceGuid = spaceGuid
+	repo.CreateInSpaceName = name
+	repo.CreateInSpaceDrainUrl = drainUrl
+	repo.CreateInSpaceParams = params
+	repo.CreateInSpaceTags = tags
+	return repo.CreateInSpaceErr
+}
+
+func (repo *FakeUserProvidedServiceInstanceRepo) FindByGuid(guid string) (serviceInstanceFields models.ServiceInstanceFields, apiErr error) {
+	repo.FindByGuidGuid = guid
+	return repo.FindByGuidServiceInstanceFields, repo.FindByGuidErr
+}
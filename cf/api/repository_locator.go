@@ -21,7 +21,10 @@ import (
 	"github.com/cloudfoundry/cli/cf/api/security_groups/defaults/running"
 	"github.com/cloudfoundry/cli/cf/api/security_groups/defaults/staging"
 	securitygroupspaces "github.com/cloudfoundry/cli/cf/api/security_groups/spaces"
+	"github.com/cloudfoundry/cli/cf/api/service_broker_client"
+	"github.com/cloudfoundry/cli/cf/api/service_keys"
 	"github.com/cloudfoundry/cli/cf/api/space_quotas"
+	"github.com/cloudfoundry/cli/cf/api/space_usage"
 	"github.com/cloudfoundry/cli/cf/api/spaces"
 	stacks "github.com/cloudfoundry/cli/cf/api/stacks"
 	"github.com/cloudfoundry/cli/cf/api/strategy"
@@ -69,6 +72,9 @@ type RepositoryLocator struct {
 	featureFlagRepo                 feature_flags.FeatureFlagRepository
 	environmentVariableGroupRepo    environment_variable_groups.EnvironmentVariableGroupsRepository
 	copyAppSourceRepo               copy_application_source.CopyApplicationSourceRepository
+	serviceKeyRepo                  service_keys.ServiceKeyRepository
+	serviceBrokerClient             service_broker_client.ServiceBrokerClient
+	spaceUsageRepo                  space_usage.SpaceUsageRepository
 }
 
 func NewRepositoryLocator(config core_config.ReadWriter, gatewaysByName map[string]net.Gateway) (loc RepositoryLocator) {
@@ -77,6 +83,7 @@ func NewRepositoryLocator(config core_config.ReadWriter, gatewaysByName map[stri
 	authGateway := gatewaysByName["auth"]
 	cloudControllerGateway := gatewaysByName["cloud-controller"]
 	uaaGateway := gatewaysByName["uaa"]
+	serviceBrokerGateway := gatewaysByName["service-broker"]
 	loc.authRepo = authentication.NewUAAAuthenticationRepository(authGateway, config)
 
 	// ensure gateway refreshers are set before passing them by value to repositories
@@ -122,6 +129,9 @@ func NewRepositoryLocator(config core_config.ReadWriter, gatewaysByName map[stri
 	loc.featureFlagRepo = feature_flags.NewCloudControllerFeatureFlagRepository(config, cloudControllerGateway)
 	loc.environmentVariableGroupRepo = environment_variable_groups.NewCloudControllerEnvironmentVariableGroupsRepository(config, cloudControllerGateway)
 	loc.copyAppSourceRepo = copy_application_source.NewCloudControllerCopyApplicationSourceRepository(config, cloudControllerGateway)
+	loc.serviceKeyRepo = service_keys.NewCloudControllerServiceKeyRepository(config, cloudControllerGateway)
+	loc.serviceBrokerClient = service_broker_client.NewHttpServiceBrokerClient(serviceBrokerGateway)
+	loc.spaceUsageRepo = space_usage.NewCloudControllerSpaceUsageRepository(config, cloudControllerGateway)
 	return
 }
 
@@ -268,3 +278,15 @@ func (locator RepositoryLocator) GetEnvironmentVariableGroupsRepository() enviro
 func (locator RepositoryLocator) GetCopyApplicationSourceRepository() copy_application_source.CopyApplicationSourceRepository {
 	return locator.copyAppSourceRepo
 }
+
+func (locator RepositoryLocator) GetServiceKeyRepository() service_keys.ServiceKeyRepository {
+	return locator.serviceKeyRepo
+}
+
+func (locator RepositoryLocator) GetServiceBrokerClient() service_broker_client.ServiceBrokerClient {
+	return locator.serviceBrokerClient
+}
+
+func (locator RepositoryLocator) GetSpaceUsageRepository() space_usage.SpaceUsageRepository {
+	return locator.spaceUsageRepo
+}
@@ -51,6 +51,8 @@ type ApplicationEntity struct {
 	Buildpack            *string                 `json:"buildpack,omitempty"`
 	EnvironmentJson      *map[string]interface{} `json:"environment_json,omitempty"`
 	HealthCheckTimeout   *int                    `json:"health_check_timeout,omitempty"`
+	HealthCheckType      *string                 `json:"health_check_type,omitempty"`
+	HealthCheckEndpoint  *string                 `json:"health_check_http_endpoint,omitempty"`
 }
 
 func (resource AppRouteResource) ToFields() (route models.RouteSummary) {
@@ -69,15 +71,17 @@ func (resource AppRouteResource) ToModel() (route models.RouteSummary) {
 
 func NewApplicationEntityFromAppParams(app models.AppParams) ApplicationEntity {
 	entity := ApplicationEntity{
-		Buildpack:          app.BuildpackUrl,
-		Name:               app.Name,
-		SpaceGuid:          app.SpaceGuid,
-		Instances:          app.InstanceCount,
-		Memory:             app.Memory,
-		DiskQuota:          app.DiskQuota,
-		StackGuid:          app.StackGuid,
-		Command:            app.Command,
-		HealthCheckTimeout: app.HealthCheckTimeout,
+		Buildpack:           app.BuildpackUrl,
+		Name:                app.Name,
+		SpaceGuid:           app.SpaceGuid,
+		Instances:           app.InstanceCount,
+		Memory:              app.Memory,
+		DiskQuota:           app.DiskQuota,
+		StackGuid:           app.StackGuid,
+		Command:             app.Command,
+		HealthCheckTimeout:  app.HealthCheckTimeout,
+		HealthCheckType:     app.HealthCheckType,
+		HealthCheckEndpoint: app.HealthCheckEndpoint,
 	}
 	if app.State != nil {
 		state := strings.ToUpper(*app.State)
@@ -120,6 +124,12 @@ func (resource ApplicationResource) ToFields() (app models.ApplicationFields) {
 	if entity.Command != nil {
 		app.Command = *entity.Command
 	}
+	if entity.HealthCheckType != nil {
+		app.HealthCheckType = *entity.HealthCheckType
+	}
+	if entity.HealthCheckEndpoint != nil {
+		app.HealthCheckEndpoint = *entity.HealthCheckEndpoint
+	}
 	return
 }
 
@@ -8,12 +8,16 @@ type ServiceBindingResource struct {
 }
 
 type ServiceBindingEntity struct {
-	AppGuid string `json:"app_guid"`
+	AppGuid string              `json:"app_guid"`
+	App     ApplicationResource `json:"app"`
 }
 
 func (resource ServiceBindingResource) ToFields() (fields models.ServiceBindingFields) {
 	fields.Url = resource.Metadata.Url
 	fields.Guid = resource.Metadata.Guid
 	fields.AppGuid = resource.Entity.AppGuid
+	if resource.Entity.App.Entity.Name != nil {
+		fields.AppName = *resource.Entity.App.Entity.Name
+	}
 	return
 }
@@ -8,11 +8,12 @@ type ServiceBrokerResource struct {
 }
 
 type ServiceBrokerEntity struct {
-	Guid     string
-	Name     string
-	Password string `json:"auth_password"`
-	Username string `json:"auth_username"`
-	Url      string `json:"broker_url"`
+	Guid      string
+	Name      string
+	Password  string `json:"auth_password"`
+	Username  string `json:"auth_username"`
+	Url       string `json:"broker_url"`
+	SpaceGuid string `json:"space_guid"`
 }
 
 func (resource ServiceBrokerResource) ToFields() (fields models.ServiceBroker) {
@@ -21,5 +22,6 @@ func (resource ServiceBrokerResource) ToFields() (fields models.ServiceBroker) {
 	fields.Url = resource.Entity.Url
 	fields.Username = resource.Entity.Username
 	fields.Password = resource.Entity.Password
+	fields.SpaceGuid = resource.Entity.SpaceGuid
 	return
 }
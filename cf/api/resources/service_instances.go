@@ -15,20 +15,44 @@ type ServiceInstanceResource struct {
 type ServiceInstanceEntity struct {
 	Name            string
 	DashboardUrl    string                   `json:"dashboard_url"`
+	Tags            []string                 `json:"tags"`
 	ServiceBindings []ServiceBindingResource `json:"service_bindings"`
 	ServicePlan     ServicePlanResource      `json:"service_plan"`
+	Space           SpaceResource            `json:"space"`
+	LastOperation   LastOperation            `json:"last_operation"`
+}
+
+type LastOperation struct {
+	Type        string `json:"type"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+func (resource LastOperation) ToFields() (fields models.LastOperationFields) {
+	fields.Type = resource.Type
+	fields.State = resource.State
+	fields.Description = resource.Description
+	fields.CreatedAt = resource.CreatedAt
+	fields.UpdatedAt = resource.UpdatedAt
+	return
 }
 
 func (resource ServiceInstanceResource) ToFields() (fields models.ServiceInstanceFields) {
 	fields.Guid = resource.Metadata.Guid
 	fields.Name = resource.Entity.Name
 	fields.DashboardUrl = resource.Entity.DashboardUrl
+	fields.Tags = resource.Entity.Tags
+	fields.LastOperation = resource.Entity.LastOperation.ToFields()
 	return
 }
 
 func (resource ServiceInstanceResource) ToModel() (instance models.ServiceInstance) {
 	instance.ServiceInstanceFields = resource.ToFields()
 	instance.ServicePlan = resource.Entity.ServicePlan.ToFields()
+	instance.Space = resource.Entity.Space.ToFields()
+	instance.Organization = resource.Entity.Space.Entity.Organization.ToFields()
 
 	instance.ServiceBindings = []models.ServiceBindingFields{}
 	for _, bindingResource := range resource.Entity.ServiceBindings {
@@ -18,6 +18,7 @@ type ServiceOfferingEntity struct {
 	DocumentationUrl string                `json:"documentation_url"`
 	Provider         string                `json:"provider"`
 	BrokerGuid       string                `json:"service_broker_guid"`
+	Tags             []string              `json:"tags"`
 	ServicePlans     []ServicePlanResource `json:"service_plans"`
 }
 
@@ -29,6 +30,7 @@ func (resource ServiceOfferingResource) ToFields() (fields models.ServiceOfferin
 	fields.BrokerGuid = resource.Entity.BrokerGuid
 	fields.Guid = resource.Metadata.Guid
 	fields.DocumentationUrl = resource.Entity.DocumentationUrl
+	fields.Tags = resource.Entity.Tags
 	return
 }
 
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/cloudfoundry/cli/cf/models"
@@ -19,6 +20,18 @@ type ServicePlanEntity struct {
 	Description         string                  `json:"description"`
 	ServiceOfferingGuid string                  `json:"service_guid"`
 	ServiceOffering     ServiceOfferingResource `json:"service"`
+	Extra               string                  `json:"extra"`
+}
+
+type ServicePlanExtraResource struct {
+	DisplayName string                    `json:"displayName"`
+	Bullets     []string                  `json:"bullets"`
+	Costs       []ServicePlanCostResource `json:"costs"`
+}
+
+type ServicePlanCostResource struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
 }
 
 type ServicePlanDescription struct {
@@ -35,6 +48,28 @@ func (resource ServicePlanResource) ToFields() (fields models.ServicePlanFields)
 	fields.Public = resource.Entity.Public
 	fields.Active = resource.Entity.Active
 	fields.ServiceOfferingGuid = resource.Entity.ServiceOfferingGuid
+	fields.Extra = resource.extra()
+	return
+}
+
+// extra decodes the broker supplied plan metadata. Brokers are free to put
+// anything there, so metadata that does not parse is ignored.
+func (resource ServicePlanResource) extra() (extra models.ServicePlanExtra) {
+	if resource.Entity.Extra == "" {
+		return
+	}
+
+	extraResource := ServicePlanExtraResource{}
+	err := json.Unmarshal([]byte(resource.Entity.Extra), &extraResource)
+	if err != nil {
+		return
+	}
+
+	extra.DisplayName = extraResource.DisplayName
+	extra.Bullets = extraResource.Bullets
+	for _, cost := range extraResource.Costs {
+		extra.Costs = append(extra.Costs, models.ServicePlanCost{Amount: cost.Amount, Unit: cost.Unit})
+	}
 	return
 }
 
@@ -72,6 +72,29 @@ var _ = Describe("route repository", func() {
 			Expect(apiErr).NotTo(HaveOccurred())
 		})
 
+		It("lists routes in an org", func() {
+			ts, handler = testnet.NewServer([]testnet.TestRequest{
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "GET",
+					Path:     "/v2/routes?inline-relations-depth=1&q=organization_guid%3Amy-org-guid",
+					Response: secondPageRoutesResponse,
+				}),
+			})
+			configRepo.SetApiEndpoint(ts.URL)
+
+			routes := []models.Route{}
+			apiErr := repo.ListRoutesInOrg("my-org-guid", func(route models.Route) bool {
+				routes = append(routes, route)
+				return true
+			})
+
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(len(routes)).To(Equal(1))
+			Expect(routes[0].Guid).To(Equal("route-2-guid"))
+			Expect(routes[0].Space.Name).To(Equal("space-2"))
+		})
+
 		It("finds a route by host and domain", func() {
 			ts, handler = testnet.NewServer([]testnet.TestRequest{
 				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
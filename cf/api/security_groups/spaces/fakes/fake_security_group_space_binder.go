@@ -3,6 +3,7 @@ package tmp
 
 import (
 	. "github.com/cloudfoundry/cli/cf/api/security_groups/spaces"
+	"github.com/cloudfoundry/cli/cf/models"
 	"sync"
 )
 
@@ -26,6 +27,16 @@ type FakeSecurityGroupSpaceBinder struct {
 	unbindSpaceReturns struct {
 		result1 error
 	}
+
+	ListSecurityGroupsStub        func(spaceGuid string) ([]models.SecurityGroupFields, error)
+	listSecurityGroupsMutex       sync.RWMutex
+	listSecurityGroupsArgsForCall []struct {
+		arg1 string
+	}
+	listSecurityGroupsReturns struct {
+		result1 []models.SecurityGroupFields
+		result2 error
+	}
 }
 
 func (fake *FakeSecurityGroupSpaceBinder) BindSpace(arg1 string, arg2 string) error {
@@ -92,4 +103,36 @@ func (fake *FakeSecurityGroupSpaceBinder) UnbindSpaceReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecurityGroupSpaceBinder) ListSecurityGroups(arg1 string) ([]models.SecurityGroupFields, error) {
+	fake.listSecurityGroupsMutex.Lock()
+	defer fake.listSecurityGroupsMutex.Unlock()
+	fake.listSecurityGroupsArgsForCall = append(fake.listSecurityGroupsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	if fake.ListSecurityGroupsStub != nil {
+		return fake.ListSecurityGroupsStub(arg1)
+	} else {
+		return fake.listSecurityGroupsReturns.result1, fake.listSecurityGroupsReturns.result2
+	}
+}
+
+func (fake *FakeSecurityGroupSpaceBinder) ListSecurityGroupsCallCount() int {
+	fake.listSecurityGroupsMutex.RLock()
+	defer fake.listSecurityGroupsMutex.RUnlock()
+	return len(fake.listSecurityGroupsArgsForCall)
+}
+
+func (fake *FakeSecurityGroupSpaceBinder) ListSecurityGroupsArgsForCall(i int) string {
+	fake.listSecurityGroupsMutex.RLock()
+	defer fake.listSecurityGroupsMutex.RUnlock()
+	return fake.listSecurityGroupsArgsForCall[i].arg1
+}
+
+func (fake *FakeSecurityGroupSpaceBinder) ListSecurityGroupsReturns(result1 []models.SecurityGroupFields, result2 error) {
+	fake.listSecurityGroupsReturns = struct {
+		result1 []models.SecurityGroupFields
+		result2 error
+	}{result1, result2}
+}
+
 var _ SecurityGroupSpaceBinder = new(FakeSecurityGroupSpaceBinder)
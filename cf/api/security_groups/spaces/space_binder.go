@@ -3,6 +3,7 @@ package spaces
 import (
 	"fmt"
 
+	"github.com/cloudfoundry/cli/cf/api/resources"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/net"
@@ -11,6 +12,7 @@ import (
 type SecurityGroupSpaceBinder interface {
 	BindSpace(securityGroupGuid string, spaceGuid string) error
 	UnbindSpace(securityGroupGuid string, spaceGuid string) error
+	ListSecurityGroups(spaceGuid string) ([]models.SecurityGroupFields, error)
 }
 
 type securityGroupSpaceBinder struct {
@@ -42,3 +44,23 @@ func (repo securityGroupSpaceBinder) UnbindSpace(securityGroupGuid string, space
 
 	return repo.gateway.DeleteResource(repo.configRepo.ApiEndpoint(), url)
 }
+
+func (repo securityGroupSpaceBinder) ListSecurityGroups(spaceGuid string) ([]models.SecurityGroupFields, error) {
+	groups := []models.SecurityGroupFields{}
+	err := repo.gateway.ListPaginatedResources(
+		repo.configRepo.ApiEndpoint(),
+		fmt.Sprintf("/v2/spaces/%s/security_groups", spaceGuid),
+		resources.SecurityGroupResource{},
+		func(resource interface{}) bool {
+			if securityGroupResource, ok := resource.(resources.SecurityGroupResource); ok {
+				groups = append(groups, securityGroupResource.ToFields())
+			}
+			return true
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
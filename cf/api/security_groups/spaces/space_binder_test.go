@@ -7,6 +7,7 @@ import (
 
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/net"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testnet "github.com/cloudfoundry/cli/testhelpers/net"
@@ -80,4 +81,46 @@ var _ = Describe("SecurityGroupSpaceBinder", func() {
 			Expect(testHandler).To(HaveAllRequestsCalled())
 		})
 	})
+
+	Describe(".ListSecurityGroups", func() {
+		It("lists the security groups bound to the space across pages", func() {
+			setupTestServer(
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/spaces/yes-its-a-space-guid/security_groups",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body: `
+{
+  "next_url": "/v2/spaces/yes-its-a-space-guid/security_groups?page=2",
+  "resources": [
+    { "metadata": {"guid": "public-guid"}, "entity": {"name": "public"} }
+  ]
+}`,
+					},
+				}),
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/spaces/yes-its-a-space-guid/security_groups?page=2",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body: `
+{
+  "resources": [
+    { "metadata": {"guid": "dns-guid"}, "entity": {"name": "dns"} }
+  ]
+}`,
+					},
+				}))
+
+			groups, err := repo.ListSecurityGroups("yes-its-a-space-guid")
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(groups).To(Equal([]models.SecurityGroupFields{
+				{Name: "public", Guid: "public-guid"},
+				{Name: "dns", Guid: "dns-guid"},
+			}))
+			Expect(testHandler).To(HaveAllRequestsCalled())
+		})
+	})
 })
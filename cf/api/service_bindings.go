@@ -1,16 +1,18 @@
 package api
 
 import (
-	"fmt"
-	"strings"
+	"bytes"
+	"encoding/json"
 
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/net"
 )
 
 type ServiceBindingRepository interface {
-	Create(instanceGuid, appGuid string) (apiErr error)
+	Create(instanceGuid, appGuid string, params map[string]interface{}) (apiErr error)
 	Delete(instance models.ServiceInstance, appGuid string) (found bool, apiErr error)
 }
 
@@ -25,13 +27,27 @@ func NewCloudControllerServiceBindingRepository(config core_config.Reader, gatew
 	return
 }
 
-func (repo CloudControllerServiceBindingRepository) Create(instanceGuid, appGuid string) (apiErr error) {
+func (repo CloudControllerServiceBindingRepository) Create(instanceGuid, appGuid string, params map[string]interface{}) (apiErr error) {
 	path := "/v2/service_bindings"
-	body := fmt.Sprintf(
-		`{"app_guid":"%s","service_instance_guid":"%s","async":true}`,
-		appGuid, instanceGuid,
-	)
-	return repo.gateway.CreateResource(repo.config.ApiEndpoint(), path, strings.NewReader(body))
+
+	type RequestBody struct {
+		AppGuid             string                 `json:"app_guid"`
+		ServiceInstanceGuid string                 `json:"service_instance_guid"`
+		Async               bool                   `json:"async"`
+		Params              map[string]interface{} `json:"parameters,omitempty"`
+	}
+
+	jsonBytes, err := json.Marshal(RequestBody{
+		AppGuid:             appGuid,
+		ServiceInstanceGuid: instanceGuid,
+		Async:               true,
+		Params:              params,
+	})
+	if err != nil {
+		return errors.NewWithError(T("Error parsing service parameters"), err)
+	}
+
+	return repo.gateway.CreateResource(repo.config.ApiEndpoint(), path, bytes.NewReader(jsonBytes))
 }
 
 func (repo CloudControllerServiceBindingRepository) Delete(instance models.ServiceInstance, appGuid string) (found bool, apiErr error) {
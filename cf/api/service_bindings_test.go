@@ -56,7 +56,25 @@ var _ = Describe("ServiceBindingsRepository", func() {
 			})
 
 			It("creates the service binding", func() {
-				apiErr := repo.Create("my-service-instance-guid", "my-app-guid")
+				apiErr := repo.Create("my-service-instance-guid", "my-app-guid", nil)
+
+				Expect(testHandler).To(HaveAllRequestsCalled())
+				Expect(apiErr).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when parameters are provided", func() {
+			BeforeEach(func() {
+				setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "POST",
+					Path:     "/v2/service_bindings",
+					Matcher:  testnet.RequestBodyMatcher(`{"app_guid":"my-app-guid","service_instance_guid":"my-service-instance-guid","async":true,"parameters":{"permissions":"read-only"}}`),
+					Response: testnet.TestResponse{Status: http.StatusCreated},
+				}))
+			})
+
+			It("sends the parameters with the request", func() {
+				apiErr := repo.Create("my-service-instance-guid", "my-app-guid", map[string]interface{}{"permissions": "read-only"})
 
 				Expect(testHandler).To(HaveAllRequestsCalled())
 				Expect(apiErr).NotTo(HaveOccurred())
@@ -77,7 +95,7 @@ var _ = Describe("ServiceBindingsRepository", func() {
 			})
 
 			It("returns an error", func() {
-				apiErr := repo.Create("my-service-instance-guid", "my-app-guid")
+				apiErr := repo.Create("my-service-instance-guid", "my-app-guid", nil)
 
 				Expect(testHandler).To(HaveAllRequestsCalled())
 				Expect(apiErr).To(HaveOccurred())
@@ -0,0 +1,116 @@
+package service_broker_client
+
+import (
+	"fmt"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+type Catalog struct {
+	Services []CatalogService `json:"services"`
+}
+
+type CatalogService struct {
+	Id              string           `json:"id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	Bindable        *bool            `json:"bindable"`
+	Plans           []CatalogPlan    `json:"plans"`
+	DashboardClient *DashboardClient `json:"dashboard_client"`
+}
+
+type CatalogPlan struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type DashboardClient struct {
+	Id          string `json:"id"`
+	Secret      string `json:"secret"`
+	RedirectUri string `json:"redirect_uri"`
+}
+
+// Validate returns the problems the cloud controller would reject the
+// catalog for. An empty result means the catalog is valid.
+func (catalog Catalog) Validate() (problems []string) {
+	if len(catalog.Services) == 0 {
+		problems = append(problems, T("The catalog does not contain any services"))
+	}
+
+	serviceIds := map[string]bool{}
+	serviceNames := map[string]bool{}
+	planIds := map[string]bool{}
+	dashboardClientIds := map[string]bool{}
+
+	for index, service := range catalog.Services {
+		serviceName := service.Name
+		if serviceName == "" {
+			serviceName = fmt.Sprintf("#%d", index+1)
+			problems = append(problems, T("Service {{.ServiceName}} is missing a name", map[string]interface{}{"ServiceName": serviceName}))
+		}
+
+		args := map[string]interface{}{"ServiceName": serviceName, "Id": service.Id}
+		if service.Id == "" {
+			problems = append(problems, T("Service {{.ServiceName}} is missing an id", args))
+		} else if serviceIds[service.Id] {
+			problems = append(problems, T("Service {{.ServiceName}} has id {{.Id}}, which is used by another service", args))
+		}
+		serviceIds[service.Id] = true
+
+		if service.Name != "" && serviceNames[service.Name] {
+			problems = append(problems, T("Service name {{.ServiceName}} is used more than once", args))
+		}
+		serviceNames[service.Name] = true
+
+		if service.Description == "" {
+			problems = append(problems, T("Service {{.ServiceName}} is missing a description", args))
+		}
+		if service.Bindable == nil {
+			problems = append(problems, T("Service {{.ServiceName}} does not say whether it is bindable", args))
+		}
+		if len(service.Plans) == 0 {
+			problems = append(problems, T("Service {{.ServiceName}} does not have any plans", args))
+		}
+
+		if service.DashboardClient != nil {
+			client := *service.DashboardClient
+			if client.Id == "" || client.Secret == "" || client.RedirectUri == "" {
+				problems = append(problems, T("The dashboard client of service {{.ServiceName}} needs an id, a secret and a redirect_uri", args))
+			}
+			if client.Id != "" && dashboardClientIds[client.Id] {
+				problems = append(problems, T("The dashboard client id of service {{.ServiceName}} is used by another service", args))
+			}
+			dashboardClientIds[client.Id] = true
+		}
+
+		planNames := map[string]bool{}
+		for planIndex, plan := range service.Plans {
+			planName := plan.Name
+			if planName == "" {
+				planName = fmt.Sprintf("#%d", planIndex+1)
+				problems = append(problems, T("Plan {{.PlanName}} of service {{.ServiceName}} is missing a name",
+					map[string]interface{}{"PlanName": planName, "ServiceName": serviceName}))
+			}
+
+			planArgs := map[string]interface{}{"PlanName": planName, "ServiceName": serviceName, "Id": plan.Id}
+			if plan.Id == "" {
+				problems = append(problems, T("Plan {{.PlanName}} of service {{.ServiceName}} is missing an id", planArgs))
+			} else if planIds[plan.Id] || serviceIds[plan.Id] {
+				problems = append(problems, T("Plan {{.PlanName}} of service {{.ServiceName}} has id {{.Id}}, which is already in use", planArgs))
+			}
+			planIds[plan.Id] = true
+
+			if plan.Name != "" && planNames[plan.Name] {
+				problems = append(problems, T("Plan name {{.PlanName}} is used more than once in service {{.ServiceName}}", planArgs))
+			}
+			planNames[plan.Name] = true
+
+			if plan.Description == "" {
+				problems = append(problems, T("Plan {{.PlanName}} of service {{.ServiceName}} is missing a description", planArgs))
+			}
+		}
+	}
+
+	return
+}
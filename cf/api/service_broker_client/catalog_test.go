@@ -0,0 +1,105 @@
+package service_broker_client_test
+
+import (
+	. "github.com/cloudfoundry/cli/cf/api/service_broker_client"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Catalog", func() {
+	var catalog Catalog
+
+	BeforeEach(func() {
+		catalog = Catalog{}
+	})
+
+	validService := func(id, name string, plans ...CatalogPlan) CatalogService {
+		bindable := true
+		return CatalogService{
+			Id:          id,
+			Name:        name,
+			Description: "a service",
+			Bindable:    &bindable,
+			Plans:       plans,
+		}
+	}
+
+	validPlan := func(id, name string) CatalogPlan {
+		return CatalogPlan{Id: id, Name: name, Description: "a plan"}
+	}
+
+	It("has no problems when the catalog is valid", func() {
+		catalog = Catalog{Services: []CatalogService{
+			validService("service-1", "mysql", validPlan("plan-1", "small"), validPlan("plan-2", "large")),
+			validService("service-2", "redis", validPlan("plan-3", "small")),
+		}}
+
+		Expect(catalog.Validate()).To(BeEmpty())
+	})
+
+	It("complains about a catalog without services", func() {
+		Expect(catalog.Validate()).To(Equal([]string{"The catalog does not contain any services"}))
+	})
+
+	It("complains about missing service fields", func() {
+		catalog = Catalog{Services: []CatalogService{
+			{Plans: []CatalogPlan{validPlan("plan-1", "small")}},
+		}}
+
+		Expect(catalog.Validate()).To(Equal([]string{
+			"Service #1 is missing a name",
+			"Service #1 is missing an id",
+			"Service #1 is missing a description",
+			"Service #1 does not say whether it is bindable",
+		}))
+	})
+
+	It("complains about services without plans", func() {
+		catalog = Catalog{Services: []CatalogService{validService("service-1", "mysql")}}
+
+		Expect(catalog.Validate()).To(Equal([]string{"Service mysql does not have any plans"}))
+	})
+
+	It("complains about duplicate service ids and names", func() {
+		catalog = Catalog{Services: []CatalogService{
+			validService("service-1", "mysql", validPlan("plan-1", "small")),
+			validService("service-1", "mysql", validPlan("plan-2", "small")),
+		}}
+
+		Expect(catalog.Validate()).To(Equal([]string{
+			"Service mysql has id service-1, which is used by another service",
+			"Service name mysql is used more than once",
+		}))
+	})
+
+	It("complains about plans with missing fields or reused ids and names", func() {
+		catalog = Catalog{Services: []CatalogService{
+			validService("service-1", "mysql",
+				validPlan("plan-1", "small"),
+				validPlan("plan-1", "small"),
+				CatalogPlan{Id: "service-1"},
+			),
+		}}
+
+		Expect(catalog.Validate()).To(Equal([]string{
+			"Plan small of service mysql has id plan-1, which is already in use",
+			"Plan name small is used more than once in service mysql",
+			"Plan #3 of service mysql is missing a name",
+			"Plan #3 of service mysql has id service-1, which is already in use",
+			"Plan #3 of service mysql is missing a description",
+		}))
+	})
+
+	It("complains about incomplete or shared dashboard clients", func() {
+		first := validService("service-1", "mysql", validPlan("plan-1", "small"))
+		first.DashboardClient = &DashboardClient{Id: "client", Secret: "secret", RedirectUri: "https://example.com"}
+		second := validService("service-2", "redis", validPlan("plan-2", "small"))
+		second.DashboardClient = &DashboardClient{Id: "client"}
+		catalog = Catalog{Services: []CatalogService{first, second}}
+
+		Expect(catalog.Validate()).To(Equal([]string{
+			"The dashboard client of service redis needs an id, a secret and a redirect_uri",
+			"The dashboard client id of service redis is used by another service",
+		}))
+	})
+})
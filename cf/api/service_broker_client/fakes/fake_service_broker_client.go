@@ -0,0 +1,235 @@
+// This file was generated by counterfeiter
+package fakes
+
+import (
+	"sync"
+
+	. "github.com/cloudfoundry/cli/cf/api/service_broker_client"
+	"github.com/cloudfoundry/cli/cf/models"
+)
+
+type FakeServiceBrokerClient struct {
+	GetCatalogStub        func(broker models.ServiceBroker) (Catalog, error)
+	getCatalogMutex       sync.RWMutex
+	getCatalogArgsForCall []struct {
+		broker models.ServiceBroker
+	}
+	getCatalogReturns struct {
+		result1 Catalog
+		result2 error
+	}
+	ProvisionStub        func(broker models.ServiceBroker, instanceId string, request ProvisionRequest) error
+	provisionMutex       sync.RWMutex
+	provisionArgsForCall []struct {
+		broker     models.ServiceBroker
+		instanceId string
+		request    ProvisionRequest
+	}
+	provisionReturns struct {
+		result1 error
+	}
+	BindStub        func(broker models.ServiceBroker, instanceId string, bindingId string, request BindRequest) error
+	bindMutex       sync.RWMutex
+	bindArgsForCall []struct {
+		broker     models.ServiceBroker
+		instanceId string
+		bindingId  string
+		request    BindRequest
+	}
+	bindReturns struct {
+		result1 error
+	}
+	UnbindStub        func(broker models.ServiceBroker, instanceId string, bindingId string, serviceId string, planId string) error
+	unbindMutex       sync.RWMutex
+	unbindArgsForCall []struct {
+		broker     models.ServiceBroker
+		instanceId string
+		bindingId  string
+		serviceId  string
+		planId     string
+	}
+	unbindReturns struct {
+		result1 error
+	}
+	DeprovisionStub        func(broker models.ServiceBroker, instanceId string, serviceId string, planId string) error
+	deprovisionMutex       sync.RWMutex
+	deprovisionArgsForCall []struct {
+		broker     models.ServiceBroker
+		instanceId string
+		serviceId  string
+		planId     string
+	}
+	deprovisionReturns struct {
+		result1 error
+	}
+}
+
+func (fake *FakeServiceBrokerClient) GetCatalog(broker models.ServiceBroker) (Catalog, error) {
+	fake.getCatalogMutex.Lock()
+	defer fake.getCatalogMutex.Unlock()
+	fake.getCatalogArgsForCall = append(fake.getCatalogArgsForCall, struct {
+		broker models.ServiceBroker
+	}{broker})
+	if fake.GetCatalogStub != nil {
+		return fake.GetCatalogStub(broker)
+	} else {
+		return fake.getCatalogReturns.result1, fake.getCatalogReturns.result2
+	}
+}
+
+func (fake *FakeServiceBrokerClient) GetCatalogCallCount() int {
+	fake.getCatalogMutex.RLock()
+	defer fake.getCatalogMutex.RUnlock()
+	return len(fake.getCatalogArgsForCall)
+}
+
+func (fake *FakeServiceBrokerClient) GetCatalogArgsForCall(i int) models.ServiceBroker {
+	fake.getCatalogMutex.RLock()
+	defer fake.getCatalogMutex.RUnlock()
+	return fake.getCatalogArgsForCall[i].broker
+}
+
+func (fake *FakeServiceBrokerClient) GetCatalogReturns(result1 Catalog, result2 error) {
+	fake.getCatalogReturns = struct {
+		result1 Catalog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceBrokerClient) Provision(broker models.ServiceBroker, instanceId string, request ProvisionRequest) error {
+	fake.provisionMutex.Lock()
+	defer fake.provisionMutex.Unlock()
+	fake.provisionArgsForCall = append(fake.provisionArgsForCall, struct {
+		broker     models.ServiceBroker
+		instanceId string
+		request    ProvisionRequest
+	}{broker, instanceId, request})
+	if fake.ProvisionStub != nil {
+		return fake.ProvisionStub(broker, instanceId, request)
+	} else {
+		return fake.provisionReturns.result1
+	}
+}
+
+func (fake *FakeServiceBrokerClient) ProvisionCallCount() int {
+	fake.provisionMutex.RLock()
+	defer fake.provisionMutex.RUnlock()
+	return len(fake.provisionArgsForCall)
+}
+
+func (fake *FakeServiceBrokerClient) ProvisionArgsForCall(i int) (models.ServiceBroker, string, ProvisionRequest) {
+	fake.provisionMutex.RLock()
+	defer fake.provisionMutex.RUnlock()
+	return fake.provisionArgsForCall[i].broker, fake.provisionArgsForCall[i].instanceId, fake.provisionArgsForCall[i].request
+}
+
+func (fake *FakeServiceBrokerClient) ProvisionReturns(result1 error) {
+	fake.provisionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServiceBrokerClient) Bind(broker models.ServiceBroker, instanceId string, bindingId string, request BindRequest) error {
+	fake.bindMutex.Lock()
+	defer fake.bindMutex.Unlock()
+	fake.bindArgsForCall = append(fake.bindArgsForCall, struct {
+		broker     models.ServiceBroker
+		instanceId string
+		bindingId  string
+		request    BindRequest
+	}{broker, instanceId, bindingId, request})
+	if fake.BindStub != nil {
+		return fake.BindStub(broker, instanceId, bindingId, request)
+	} else {
+		return fake.bindReturns.result1
+	}
+}
+
+func (fake *FakeServiceBrokerClient) BindCallCount() int {
+	fake.bindMutex.RLock()
+	defer fake.bindMutex.RUnlock()
+	return len(fake.bindArgsForCall)
+}
+
+func (fake *FakeServiceBrokerClient) BindArgsForCall(i int) (models.ServiceBroker, string, string, BindRequest) {
+	fake.bindMutex.RLock()
+	defer fake.bindMutex.RUnlock()
+	return fake.bindArgsForCall[i].broker, fake.bindArgsForCall[i].instanceId, fake.bindArgsForCall[i].bindingId, fake.bindArgsForCall[i].request
+}
+
+func (fake *FakeServiceBrokerClient) BindReturns(result1 error) {
+	fake.bindReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServiceBrokerClient) Unbind(broker models.ServiceBroker, instanceId string, bindingId string, serviceId string, planId string) error {
+	fake.unbindMutex.Lock()
+	defer fake.unbindMutex.Unlock()
+	fake.unbindArgsForCall = append(fake.unbindArgsForCall, struct {
+		broker     models.ServiceBroker
+		instanceId string
+		bindingId  string
+		serviceId  string
+		planId     string
+	}{broker, instanceId, bindingId, serviceId, planId})
+	if fake.UnbindStub != nil {
+		return fake.UnbindStub(broker, instanceId, bindingId, serviceId, planId)
+	} else {
+		return fake.unbindReturns.result1
+	}
+}
+
+func (fake *FakeServiceBrokerClient) UnbindCallCount() int {
+	fake.unbindMutex.RLock()
+	defer fake.unbindMutex.RUnlock()
+	return len(fake.unbindArgsForCall)
+}
+
+func (fake *FakeServiceBrokerClient) UnbindArgsForCall(i int) (models.ServiceBroker, string, string, string, string) {
+	fake.unbindMutex.RLock()
+	defer fake.unbindMutex.RUnlock()
+	return fake.unbindArgsForCall[i].broker, fake.unbindArgsForCall[i].instanceId, fake.unbindArgsForCall[i].bindingId, fake.unbindArgsForCall[i].serviceId, fake.unbindArgsForCall[i].planId
+}
+
+func (fake *FakeServiceBrokerClient) UnbindReturns(result1 error) {
+	fake.unbindReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServiceBrokerClient) Deprovision(broker models.ServiceBroker, instanceId string, serviceId string, planId string) error {
+	fake.deprovisionMutex.Lock()
+	defer fake.deprovisionMutex.Unlock()
+	fake.deprovisionArgsForCall = append(fake.deprovisionArgsForCall, struct {
+		broker     models.ServiceBroker
+		instanceId string
+		serviceId  string
+		planId     string
+	}{broker, instanceId, serviceId, planId})
+	if fake.DeprovisionStub != nil {
+		return fake.DeprovisionStub(broker, instanceId, serviceId, planId)
+	} else {
+		return fake.deprovisionReturns.result1
+	}
+}
+
+func (fake *FakeServiceBrokerClient) DeprovisionCallCount() int {
+	fake.deprovisionMutex.RLock()
+	defer fake.deprovisionMutex.RUnlock()
+	return len(fake.deprovisionArgsForCall)
+}
+
+func (fake *FakeServiceBrokerClient) DeprovisionArgsForCall(i int) (models.ServiceBroker, string, string, string) {
+	fake.deprovisionMutex.RLock()
+	defer fake.deprovisionMutex.RUnlock()
+	return fake.deprovisionArgsForCall[i].broker, fake.deprovisionArgsForCall[i].instanceId, fake.deprovisionArgsForCall[i].serviceId, fake.deprovisionArgsForCall[i].planId
+}
+
+func (fake *FakeServiceBrokerClient) DeprovisionReturns(result1 error) {
+	fake.deprovisionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+var _ ServiceBrokerClient = new(FakeServiceBrokerClient)
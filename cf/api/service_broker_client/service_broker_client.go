@@ -0,0 +1,119 @@
+package service_broker_client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/net"
+)
+
+// BrokerApiVersion is the version of the service broker API the client
+// speaks.
+const BrokerApiVersion = "2.5"
+
+// ServiceBrokerClient talks to a service broker directly rather than through
+// the cloud controller, so brokers can be checked before they are registered.
+type ServiceBrokerClient interface {
+	GetCatalog(broker models.ServiceBroker) (Catalog, error)
+	Provision(broker models.ServiceBroker, instanceId string, request ProvisionRequest) error
+	Bind(broker models.ServiceBroker, instanceId, bindingId string, request BindRequest) error
+	Unbind(broker models.ServiceBroker, instanceId, bindingId, serviceId, planId string) error
+	Deprovision(broker models.ServiceBroker, instanceId, serviceId, planId string) error
+}
+
+type ProvisionRequest struct {
+	ServiceId        string `json:"service_id"`
+	PlanId           string `json:"plan_id"`
+	OrganizationGuid string `json:"organization_guid"`
+	SpaceGuid        string `json:"space_guid"`
+}
+
+type BindRequest struct {
+	ServiceId string `json:"service_id"`
+	PlanId    string `json:"plan_id"`
+	AppGuid   string `json:"app_guid"`
+}
+
+type HttpServiceBrokerClient struct {
+	gateway net.Gateway
+}
+
+func NewHttpServiceBrokerClient(gateway net.Gateway) HttpServiceBrokerClient {
+	return HttpServiceBrokerClient{gateway: gateway}
+}
+
+func (client HttpServiceBrokerClient) GetCatalog(broker models.ServiceBroker) (catalog Catalog, err error) {
+	request, err := client.newRequest("GET", broker, "/v2/catalog", nil)
+	if err != nil {
+		return
+	}
+
+	_, err = client.gateway.PerformRequestForJSONResponse(request, &catalog)
+	return
+}
+
+func (client HttpServiceBrokerClient) Provision(broker models.ServiceBroker, instanceId string, provisionRequest ProvisionRequest) error {
+	path := fmt.Sprintf("/v2/service_instances/%s", instanceId)
+	return client.perform("PUT", broker, path, provisionRequest)
+}
+
+func (client HttpServiceBrokerClient) Bind(broker models.ServiceBroker, instanceId, bindingId string, bindRequest BindRequest) error {
+	path := fmt.Sprintf("/v2/service_instances/%s/service_bindings/%s", instanceId, bindingId)
+	return client.perform("PUT", broker, path, bindRequest)
+}
+
+func (client HttpServiceBrokerClient) Unbind(broker models.ServiceBroker, instanceId, bindingId, serviceId, planId string) error {
+	path := fmt.Sprintf("/v2/service_instances/%s/service_bindings/%s?service_id=%s&plan_id=%s",
+		instanceId, bindingId, url.QueryEscape(serviceId), url.QueryEscape(planId))
+	return client.perform("DELETE", broker, path, nil)
+}
+
+func (client HttpServiceBrokerClient) Deprovision(broker models.ServiceBroker, instanceId, serviceId, planId string) error {
+	path := fmt.Sprintf("/v2/service_instances/%s?service_id=%s&plan_id=%s",
+		instanceId, url.QueryEscape(serviceId), url.QueryEscape(planId))
+	return client.perform("DELETE", broker, path, nil)
+}
+
+func (client HttpServiceBrokerClient) perform(method string, broker models.ServiceBroker, path string, body interface{}) error {
+	request, err := client.newRequest(method, broker, path, body)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.gateway.PerformRequest(request)
+	return err
+}
+
+func (client HttpServiceBrokerClient) newRequest(method string, broker models.ServiceBroker, path string, body interface{}) (*net.Request, error) {
+	var reader *bytes.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.NewWithError(T("Error building request"), err)
+		}
+		reader = bytes.NewReader(bodyBytes)
+	}
+
+	brokerUrl := strings.TrimRight(broker.Url, "/") + path
+
+	var request *net.Request
+	var err error
+	if reader == nil {
+		request, err = client.gateway.NewRequest(method, brokerUrl, "", nil)
+	} else {
+		request, err = client.gateway.NewRequest(method, brokerUrl, "", reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	request.HttpReq.SetBasicAuth(broker.Username, broker.Password)
+	request.HttpReq.Header.Set("X-Broker-Api-Version", BrokerApiVersion)
+	return request, nil
+}
@@ -0,0 +1,19 @@
+package service_broker_client_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/i18n/detection"
+	"github.com/cloudfoundry/cli/testhelpers/configuration"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestServiceBrokerClient(t *testing.T) {
+	config := configuration.NewRepositoryWithDefaults()
+	i18n.T = i18n.Init(config, &detection.JibberJabberDetector{})
+
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Service Broker Client Suite")
+}
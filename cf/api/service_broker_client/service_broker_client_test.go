@@ -0,0 +1,224 @@
+package service_broker_client_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/net"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testnet "github.com/cloudfoundry/cli/testhelpers/net"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/api/service_broker_client"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HttpServiceBrokerClient", func() {
+	var (
+		testServer  *httptest.Server
+		testHandler *testnet.TestHandler
+		client      ServiceBrokerClient
+		broker      models.ServiceBroker
+	)
+
+	BeforeEach(func() {
+		configRepo := testconfig.NewRepositoryWithDefaults()
+		gateway := net.NewServiceBrokerGateway(configRepo, &testterm.FakeUI{})
+		client = NewHttpServiceBrokerClient(gateway)
+	})
+
+	AfterEach(func() {
+		if testServer != nil {
+			testServer.Close()
+		}
+	})
+
+	brokerRequest := func(request testnet.TestRequest) testnet.TestRequest {
+		request.Header = http.Header{
+			"authorization":        {"Basic YWRtaW46c2VjcmV0"},
+			"x-broker-api-version": {BrokerApiVersion},
+		}
+		return request
+	}
+
+	setupTestServer := func(reqs ...testnet.TestRequest) {
+		testServer, testHandler = testnet.NewServer(reqs)
+		broker = models.ServiceBroker{Url: testServer.URL + "/", Username: "admin", Password: "secret"}
+	}
+
+	Describe("GetCatalog", func() {
+		It("fetches the catalog with basic auth", func() {
+			setupTestServer(brokerRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/catalog",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   catalogResponse,
+				},
+			}))
+
+			catalog, err := client.GetCatalog(broker)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testHandler).To(HaveAllRequestsCalled())
+
+			Expect(catalog.Services).To(HaveLen(1))
+			service := catalog.Services[0]
+			Expect(service.Id).To(Equal("service-id"))
+			Expect(service.Name).To(Equal("mysql"))
+			Expect(*service.Bindable).To(BeTrue())
+			Expect(service.DashboardClient.RedirectUri).To(Equal("https://dashboard.example.com"))
+			Expect(service.Plans).To(Equal([]CatalogPlan{
+				{Id: "plan-id", Name: "small", Description: "A small database"},
+			}))
+		})
+
+		It("returns an error when the broker rejects the request", func() {
+			setupTestServer(brokerRequest(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/catalog",
+				Response: testnet.TestResponse{Status: http.StatusUnauthorized},
+			}))
+
+			_, err := client.GetCatalog(broker)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("credentials", func() {
+		It("never sends the user's access token, even when the broker answers with an invalid token error", func() {
+			configRepo := testconfig.NewRepositoryWithDefaults()
+			configRepo.SetAccessToken("bearer my-access-token")
+			gateway := net.NewServiceBrokerGateway(configRepo, &testterm.FakeUI{})
+			gateway.SetTokenRefresher(&fakeTokenRefresher{token: "bearer refreshed-token"})
+			client = NewHttpServiceBrokerClient(gateway)
+
+			authorizationHeaders := []string{}
+			testServer = httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+				authorizationHeaders = append(authorizationHeaders, request.Header.Get("Authorization"))
+				writer.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(writer, `{"code":1000,"description":"Invalid Auth Token"}`)
+			}))
+			broker = models.ServiceBroker{Url: testServer.URL, Username: "admin", Password: "secret"}
+
+			_, err := client.GetCatalog(broker)
+
+			Expect(authorizationHeaders).To(Equal([]string{"Basic YWRtaW46c2VjcmV0"}))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("401"))
+			Expect(err.Error()).To(ContainSubstring(`{"code":1000,"description":"Invalid Auth Token"}`))
+		})
+	})
+
+	Describe("Provision", func() {
+		It("puts the instance", func() {
+			setupTestServer(brokerRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/service_instances/instance-id",
+				Matcher:  testnet.RequestBodyMatcher(`{"service_id":"service-id","plan_id":"plan-id","organization_guid":"org-guid","space_guid":"space-guid"}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated, Body: `{}`},
+			}))
+
+			err := client.Provision(broker, "instance-id", ProvisionRequest{
+				ServiceId:        "service-id",
+				PlanId:           "plan-id",
+				OrganizationGuid: "org-guid",
+				SpaceGuid:        "space-guid",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testHandler).To(HaveAllRequestsCalled())
+		})
+	})
+
+	Describe("Bind", func() {
+		It("puts the binding", func() {
+			setupTestServer(brokerRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/service_instances/instance-id/service_bindings/binding-id",
+				Matcher:  testnet.RequestBodyMatcher(`{"service_id":"service-id","plan_id":"plan-id","app_guid":"app-guid"}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated, Body: `{"credentials":{}}`},
+			}))
+
+			err := client.Bind(broker, "instance-id", "binding-id", BindRequest{
+				ServiceId: "service-id",
+				PlanId:    "plan-id",
+				AppGuid:   "app-guid",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testHandler).To(HaveAllRequestsCalled())
+		})
+	})
+
+	Describe("Unbind", func() {
+		It("deletes the binding", func() {
+			setupTestServer(brokerRequest(testnet.TestRequest{
+				Method:   "DELETE",
+				Path:     "/v2/service_instances/instance-id/service_bindings/binding-id?service_id=service-id&plan_id=plan-id",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{}`},
+			}))
+
+			err := client.Unbind(broker, "instance-id", "binding-id", "service-id", "plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testHandler).To(HaveAllRequestsCalled())
+		})
+	})
+
+	Describe("Deprovision", func() {
+		It("deletes the instance", func() {
+			setupTestServer(brokerRequest(testnet.TestRequest{
+				Method:   "DELETE",
+				Path:     "/v2/service_instances/instance-id?service_id=service-id&plan_id=plan-id",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{}`},
+			}))
+
+			err := client.Deprovision(broker, "instance-id", "service-id", "plan-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(testHandler).To(HaveAllRequestsCalled())
+		})
+
+		It("returns an error when the broker fails", func() {
+			setupTestServer(brokerRequest(testnet.TestRequest{
+				Method:   "DELETE",
+				Path:     "/v2/service_instances/instance-id?service_id=service-id&plan_id=plan-id",
+				Response: testnet.TestResponse{Status: http.StatusInternalServerError, Body: `{"description":"boom"}`},
+			}))
+
+			err := client.Deprovision(broker, "instance-id", "service-id", "plan-id")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
+
+type fakeTokenRefresher struct {
+	token string
+}
+
+func (refresher *fakeTokenRefresher) RefreshAuthToken() (string, error) {
+	return refresher.token, nil
+}
+
+var catalogResponse = `{
+  "services": [
+    {
+      "id": "service-id",
+      "name": "mysql",
+      "description": "A MySQL database",
+      "bindable": true,
+      "dashboard_client": {
+        "id": "client-id",
+        "secret": "client-secret",
+        "redirect_uri": "https://dashboard.example.com"
+      },
+      "plans": [
+        {
+          "id": "plan-id",
+          "name": "small",
+          "description": "A small database"
+        }
+      ]
+    }
+  ]
+}`
@@ -17,6 +17,7 @@ type ServiceBrokerRepository interface {
 	FindByName(name string) (serviceBroker models.ServiceBroker, apiErr error)
 	FindByGuid(guid string) (serviceBroker models.ServiceBroker, apiErr error)
 	Create(name, url, username, password string) (apiErr error)
+	CreateSpaceScoped(name, url, username, password, spaceGuid string) (apiErr error)
 	Update(serviceBroker models.ServiceBroker) (apiErr error)
 	Rename(guid, name string) (apiErr error)
 	Delete(guid string) (apiErr error)
@@ -77,6 +78,14 @@ func (repo CloudControllerServiceBrokerRepository) Create(name, url, username, p
 	return repo.gateway.CreateResource(repo.config.ApiEndpoint(), path, strings.NewReader(body))
 }
 
+func (repo CloudControllerServiceBrokerRepository) CreateSpaceScoped(name, url, username, password, spaceGuid string) (apiErr error) {
+	path := "/v2/service_brokers"
+	body := fmt.Sprintf(
+		`{"name":"%s","broker_url":"%s","auth_username":"%s","auth_password":"%s","space_guid":"%s"}`, name, url, username, password, spaceGuid,
+	)
+	return repo.gateway.CreateResource(repo.config.ApiEndpoint(), path, strings.NewReader(body))
+}
+
 func (repo CloudControllerServiceBrokerRepository) Update(serviceBroker models.ServiceBroker) (apiErr error) {
 	path := fmt.Sprintf("/v2/service_brokers/%s", serviceBroker.Guid)
 	body := fmt.Sprintf(
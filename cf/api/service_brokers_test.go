@@ -60,7 +60,8 @@ var _ = Describe("Service Brokers Repo", func() {
 						"name": "found-name-2",
 						"broker_url": "http://found.example.com-2",
 						"auth_username": "found-username-2",
-						"auth_password": "found-password-2"
+						"auth_password": "found-password-2",
+						"space_guid": "found-space-guid"
 					  }
 					}
 				  ]
@@ -80,6 +81,8 @@ var _ = Describe("Service Brokers Repo", func() {
 		Expect(len(serviceBrokers)).To(Equal(2))
 		Expect(serviceBrokers[0].Guid).To(Equal("found-guid-1"))
 		Expect(serviceBrokers[1].Guid).To(Equal("found-guid-2"))
+		Expect(serviceBrokers[0].SpaceGuid).To(BeEmpty())
+		Expect(serviceBrokers[1].SpaceGuid).To(Equal("found-space-guid"))
 		Expect(handler).To(HaveAllRequestsCalled())
 		Expect(apiErr).NotTo(HaveOccurred())
 	})
@@ -216,6 +219,27 @@ var _ = Describe("Service Brokers Repo", func() {
 		})
 	})
 
+	Describe("CreateSpaceScoped", func() {
+		It("creates a service broker scoped to the given space", func() {
+			expectedReqBody := `{"name":"foobroker","broker_url":"http://example.com","auth_username":"foouser","auth_password":"password","space_guid":"my-space-guid"}`
+
+			req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "POST",
+				Path:     "/v2/service_brokers",
+				Matcher:  testnet.RequestBodyMatcher(expectedReqBody),
+				Response: testnet.TestResponse{Status: http.StatusCreated},
+			})
+
+			ts, handler, repo := createServiceBrokerRepo(req)
+			defer ts.Close()
+
+			apiErr := repo.CreateSpaceScoped("foobroker", "http://example.com", "foouser", "password", "my-space-guid")
+
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(apiErr).NotTo(HaveOccurred())
+		})
+	})
+
 	Describe("Update", func() {
 		It("updates the service broker with the given guid", func() {
 			expectedReqBody := `{"broker_url":"http://update.example.com","auth_username":"update-foouser","auth_password":"update-password"}`
@@ -0,0 +1,178 @@
+// This file was generated by counterfeiter
+package fakes
+
+import (
+	"sync"
+
+	. "github.com/cloudfoundry/cli/cf/api/service_keys"
+	"github.com/cloudfoundry/cli/cf/models"
+)
+
+type FakeServiceKeyRepository struct {
+	CreateServiceKeyStub        func(serviceInstanceGuid string, keyName string) error
+	createServiceKeyMutex       sync.RWMutex
+	createServiceKeyArgsForCall []struct {
+		serviceInstanceGuid string
+		keyName             string
+	}
+	createServiceKeyReturns struct {
+		result1 error
+	}
+	ListServiceKeysStub        func(serviceInstanceGuid string) ([]models.ServiceKey, error)
+	listServiceKeysMutex       sync.RWMutex
+	listServiceKeysArgsForCall []struct {
+		serviceInstanceGuid string
+	}
+	listServiceKeysReturns struct {
+		result1 []models.ServiceKey
+		result2 error
+	}
+	GetServiceKeyStub        func(serviceInstanceGuid string, keyName string) (models.ServiceKey, error)
+	getServiceKeyMutex       sync.RWMutex
+	getServiceKeyArgsForCall []struct {
+		serviceInstanceGuid string
+		keyName             string
+	}
+	getServiceKeyReturns struct {
+		result1 models.ServiceKey
+		result2 error
+	}
+	DeleteServiceKeyStub        func(serviceKeyGuid string) error
+	deleteServiceKeyMutex       sync.RWMutex
+	deleteServiceKeyArgsForCall []struct {
+		serviceKeyGuid string
+	}
+	deleteServiceKeyReturns struct {
+		result1 error
+	}
+}
+
+func (fake *FakeServiceKeyRepository) CreateServiceKey(serviceInstanceGuid string, keyName string) error {
+	fake.createServiceKeyMutex.Lock()
+	defer fake.createServiceKeyMutex.Unlock()
+	fake.createServiceKeyArgsForCall = append(fake.createServiceKeyArgsForCall, struct {
+		serviceInstanceGuid string
+		keyName             string
+	}{serviceInstanceGuid, keyName})
+	if fake.CreateServiceKeyStub != nil {
+		return fake.CreateServiceKeyStub(serviceInstanceGuid, keyName)
+	} else {
+		return fake.createServiceKeyReturns.result1
+	}
+}
+
+func (fake *FakeServiceKeyRepository) CreateServiceKeyCallCount() int {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return len(fake.createServiceKeyArgsForCall)
+}
+
+func (fake *FakeServiceKeyRepository) CreateServiceKeyArgsForCall(i int) (string, string) {
+	fake.createServiceKeyMutex.RLock()
+	defer fake.createServiceKeyMutex.RUnlock()
+	return fake.createServiceKeyArgsForCall[i].serviceInstanceGuid, fake.createServiceKeyArgsForCall[i].keyName
+}
+
+func (fake *FakeServiceKeyRepository) CreateServiceKeyReturns(result1 error) {
+	fake.createServiceKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeServiceKeyRepository) ListServiceKeys(serviceInstanceGuid string) ([]models.ServiceKey, error) {
+	fake.listServiceKeysMutex.Lock()
+	defer fake.listServiceKeysMutex.Unlock()
+	fake.listServiceKeysArgsForCall = append(fake.listServiceKeysArgsForCall, struct {
+		serviceInstanceGuid string
+	}{serviceInstanceGuid})
+	if fake.ListServiceKeysStub != nil {
+		return fake.ListServiceKeysStub(serviceInstanceGuid)
+	} else {
+		return fake.listServiceKeysReturns.result1, fake.listServiceKeysReturns.result2
+	}
+}
+
+func (fake *FakeServiceKeyRepository) ListServiceKeysCallCount() int {
+	fake.listServiceKeysMutex.RLock()
+	defer fake.listServiceKeysMutex.RUnlock()
+	return len(fake.listServiceKeysArgsForCall)
+}
+
+func (fake *FakeServiceKeyRepository) ListServiceKeysArgsForCall(i int) string {
+	fake.listServiceKeysMutex.RLock()
+	defer fake.listServiceKeysMutex.RUnlock()
+	return fake.listServiceKeysArgsForCall[i].serviceInstanceGuid
+}
+
+func (fake *FakeServiceKeyRepository) ListServiceKeysReturns(result1 []models.ServiceKey, result2 error) {
+	fake.listServiceKeysReturns = struct {
+		result1 []models.ServiceKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceKeyRepository) GetServiceKey(serviceInstanceGuid string, keyName string) (models.ServiceKey, error) {
+	fake.getServiceKeyMutex.Lock()
+	defer fake.getServiceKeyMutex.Unlock()
+	fake.getServiceKeyArgsForCall = append(fake.getServiceKeyArgsForCall, struct {
+		serviceInstanceGuid string
+		keyName             string
+	}{serviceInstanceGuid, keyName})
+	if fake.GetServiceKeyStub != nil {
+		return fake.GetServiceKeyStub(serviceInstanceGuid, keyName)
+	} else {
+		return fake.getServiceKeyReturns.result1, fake.getServiceKeyReturns.result2
+	}
+}
+
+func (fake *FakeServiceKeyRepository) GetServiceKeyCallCount() int {
+	fake.getServiceKeyMutex.RLock()
+	defer fake.getServiceKeyMutex.RUnlock()
+	return len(fake.getServiceKeyArgsForCall)
+}
+
+func (fake *FakeServiceKeyRepository) GetServiceKeyArgsForCall(i int) (string, string) {
+	fake.getServiceKeyMutex.RLock()
+	defer fake.getServiceKeyMutex.RUnlock()
+	return fake.getServiceKeyArgsForCall[i].serviceInstanceGuid, fake.getServiceKeyArgsForCall[i].keyName
+}
+
+func (fake *FakeServiceKeyRepository) GetServiceKeyReturns(result1 models.ServiceKey, result2 error) {
+	fake.getServiceKeyReturns = struct {
+		result1 models.ServiceKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceKeyRepository) DeleteServiceKey(serviceKeyGuid string) error {
+	fake.deleteServiceKeyMutex.Lock()
+	defer fake.deleteServiceKeyMutex.Unlock()
+	fake.deleteServiceKeyArgsForCall = append(fake.deleteServiceKeyArgsForCall, struct {
+		serviceKeyGuid string
+	}{serviceKeyGuid})
+	if fake.DeleteServiceKeyStub != nil {
+		return fake.DeleteServiceKeyStub(serviceKeyGuid)
+	} else {
+		return fake.deleteServiceKeyReturns.result1
+	}
+}
+
+func (fake *FakeServiceKeyRepository) DeleteServiceKeyCallCount() int {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return len(fake.deleteServiceKeyArgsForCall)
+}
+
+func (fake *FakeServiceKeyRepository) DeleteServiceKeyArgsForCall(i int) string {
+	fake.deleteServiceKeyMutex.RLock()
+	defer fake.deleteServiceKeyMutex.RUnlock()
+	return fake.deleteServiceKeyArgsForCall[i].serviceKeyGuid
+}
+
+func (fake *FakeServiceKeyRepository) DeleteServiceKeyReturns(result1 error) {
+	fake.deleteServiceKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+var _ ServiceKeyRepository = new(FakeServiceKeyRepository)
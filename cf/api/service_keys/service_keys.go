@@ -0,0 +1,92 @@
+package service_keys
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/api/resources"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/net"
+)
+
+type ServiceKeyRepository interface {
+	CreateServiceKey(serviceInstanceGuid string, keyName string) error
+	ListServiceKeys(serviceInstanceGuid string) ([]models.ServiceKey, error)
+	GetServiceKey(serviceInstanceGuid string, keyName string) (models.ServiceKey, error)
+	DeleteServiceKey(serviceKeyGuid string) error
+}
+
+type CloudControllerServiceKeyRepository struct {
+	config  core_config.Reader
+	gateway net.Gateway
+}
+
+func NewCloudControllerServiceKeyRepository(config core_config.Reader, gateway net.Gateway) CloudControllerServiceKeyRepository {
+	return CloudControllerServiceKeyRepository{
+		config:  config,
+		gateway: gateway,
+	}
+}
+
+func (repo CloudControllerServiceKeyRepository) CreateServiceKey(serviceInstanceGuid string, keyName string) error {
+	body, err := json.Marshal(map[string]string{
+		"service_instance_guid": serviceInstanceGuid,
+		"name":                  keyName,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = repo.gateway.CreateResource(repo.config.ApiEndpoint(), "/v2/service_keys", strings.NewReader(string(body)))
+	if httpErr, ok := err.(errors.HttpError); ok && httpErr.ErrorCode() == errors.SERVICE_KEY_NAME_TAKEN {
+		return errors.NewModelAlreadyExistsError("Service key", keyName)
+	}
+	return err
+}
+
+func (repo CloudControllerServiceKeyRepository) ListServiceKeys(serviceInstanceGuid string) ([]models.ServiceKey, error) {
+	path := fmt.Sprintf("/v2/service_instances/%s/service_keys", serviceInstanceGuid)
+	return repo.listServiceKeys(path)
+}
+
+func (repo CloudControllerServiceKeyRepository) GetServiceKey(serviceInstanceGuid string, keyName string) (models.ServiceKey, error) {
+	path := fmt.Sprintf("/v2/service_instances/%s/service_keys?q=%s", serviceInstanceGuid, url.QueryEscape("name:"+keyName))
+	serviceKeys, err := repo.listServiceKeys(path)
+	if err != nil {
+		return models.ServiceKey{}, err
+	}
+
+	if len(serviceKeys) == 0 {
+		return models.ServiceKey{}, errors.NewModelNotFoundError("Service key", keyName)
+	}
+
+	return serviceKeys[0], nil
+}
+
+func (repo CloudControllerServiceKeyRepository) DeleteServiceKey(serviceKeyGuid string) error {
+	path := fmt.Sprintf("/v2/service_keys/%s", serviceKeyGuid)
+	return repo.gateway.DeleteResource(repo.config.ApiEndpoint(), path)
+}
+
+func (repo CloudControllerServiceKeyRepository) listServiceKeys(path string) ([]models.ServiceKey, error) {
+	serviceKeys := []models.ServiceKey{}
+	err := repo.gateway.ListPaginatedResources(
+		repo.config.ApiEndpoint(),
+		path,
+		resources.ServiceKeyResource{},
+		func(resource interface{}) bool {
+			if serviceKey, ok := resource.(resources.ServiceKeyResource); ok {
+				serviceKeys = append(serviceKeys, serviceKey.ToModel())
+			}
+			return true
+		})
+
+	if err != nil {
+		return nil, err
+	}
+	return serviceKeys, nil
+}
@@ -0,0 +1,182 @@
+package service_keys_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/net"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testnet "github.com/cloudfoundry/cli/testhelpers/net"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/api/service_keys"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Keys Repository", func() {
+	var (
+		testServer  *httptest.Server
+		testHandler *testnet.TestHandler
+		configRepo  core_config.ReadWriter
+		repo        CloudControllerServiceKeyRepository
+	)
+
+	BeforeEach(func() {
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		gateway := net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{})
+		repo = NewCloudControllerServiceKeyRepository(configRepo, gateway)
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	setupTestServer := func(reqs ...testnet.TestRequest) {
+		testServer, testHandler = testnet.NewServer(reqs)
+		configRepo.SetApiEndpoint(testServer.URL)
+	}
+
+	Describe("CreateServiceKey", func() {
+		It("makes the right request", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "POST",
+				Path:     "/v2/service_keys",
+				Matcher:  testnet.RequestBodyMatcher(`{"name":"fake-key-name","service_instance_guid":"fake-instance-guid"}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated},
+			}))
+
+			err := repo.CreateServiceKey("fake-instance-guid", "fake-key-name")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns a ModelAlreadyExistsError when the key name is taken", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "POST",
+				Path:   "/v2/service_keys",
+				Response: testnet.TestResponse{
+					Status: http.StatusBadRequest,
+					Body:   `{"code":360001,"description":"The service key name is taken: fake-key-name"}`,
+				},
+			}))
+
+			err := repo.CreateServiceKey("fake-instance-guid", "fake-key-name")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).To(BeAssignableToTypeOf(&errors.ModelAlreadyExistsError{}))
+		})
+	})
+
+	Describe("ListServiceKeys", func() {
+		It("returns the keys of the service instance", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/service_instances/fake-instance-guid/service_keys",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   serviceKeysResponse,
+				},
+			}))
+
+			serviceKeys, err := repo.ListServiceKeys("fake-instance-guid")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(len(serviceKeys)).To(Equal(2))
+			Expect(serviceKeys[0].Fields.Guid).To(Equal("fake-key-guid-1"))
+			Expect(serviceKeys[0].Fields.Name).To(Equal("fake-key-name-1"))
+			Expect(serviceKeys[0].Fields.ServiceInstanceGuid).To(Equal("fake-instance-guid"))
+			Expect(serviceKeys[0].Credentials).To(HaveKeyWithValue("username", "fake-username-1"))
+			Expect(serviceKeys[1].Fields.Name).To(Equal("fake-key-name-2"))
+		})
+	})
+
+	Describe("GetServiceKey", func() {
+		It("returns the key with the given name", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/service_instances/fake-instance-guid/service_keys?q=name%3Afake-key-name-1",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   serviceKeysResponse,
+				},
+			}))
+
+			serviceKey, err := repo.GetServiceKey("fake-instance-guid", "fake-key-name-1")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceKey.Fields.Guid).To(Equal("fake-key-guid-1"))
+			Expect(serviceKey.Credentials).To(HaveKeyWithValue("password", "fake-password-1"))
+		})
+
+		It("returns a ModelNotFoundError when there is no such key", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/service_instances/fake-instance-guid/service_keys?q=name%3Amissing-key",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   `{"resources": []}`,
+				},
+			}))
+
+			_, err := repo.GetServiceKey("fake-instance-guid", "missing-key")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+		})
+	})
+
+	Describe("DeleteServiceKey", func() {
+		It("deletes the key", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "DELETE",
+				Path:     "/v2/service_keys/fake-key-guid",
+				Response: testnet.TestResponse{Status: http.StatusNoContent},
+			}))
+
+			err := repo.DeleteServiceKey("fake-key-guid")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
+
+var serviceKeysResponse = `{
+	"total_results": 2,
+	"resources": [
+		{
+			"metadata": {
+				"guid": "fake-key-guid-1",
+				"url": "/v2/service_keys/fake-key-guid-1"
+			},
+			"entity": {
+				"name": "fake-key-name-1",
+				"service_instance_guid": "fake-instance-guid",
+				"service_instance_url": "/v2/service_instances/fake-instance-guid",
+				"credentials": {
+					"username": "fake-username-1",
+					"password": "fake-password-1"
+				}
+			}
+		},
+		{
+			"metadata": {
+				"guid": "fake-key-guid-2",
+				"url": "/v2/service_keys/fake-key-guid-2"
+			},
+			"entity": {
+				"name": "fake-key-name-2",
+				"service_instance_guid": "fake-instance-guid",
+				"service_instance_url": "/v2/service_instances/fake-instance-guid",
+				"credentials": {
+					"username": "fake-username-2",
+					"password": "fake-password-2"
+				}
+			}
+		}
+	]
+}`
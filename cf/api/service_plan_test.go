@@ -64,6 +64,13 @@ var _ = Describe("Service Plan Repository", func() {
 				Expect(servicePlansFields[1].Free).To(BeTrue())
 				Expect(servicePlansFields[1].Public).To(BeFalse())
 				Expect(servicePlansFields[1].Active).To(BeFalse())
+				Expect(servicePlansFields[1].Extra).To(Equal(models.ServicePlanExtra{
+					DisplayName: "Small Second",
+					Bullets:     []string{"5 GB storage"},
+					Costs: []models.ServicePlanCost{
+						{Amount: map[string]float64{"usd": 9.5}, Unit: "MONTHLY"},
+					},
+				}))
 			})
 		})
 		Context("With query parameters", func() {
@@ -160,7 +167,8 @@ var secondPlanRequest = testapi.NewCloudControllerTestRequest(testnet.TestReques
         "name": "The small second",
         "free": true,
         "public": false,
-        "active": false
+        "active": false,
+        "extra": "{\"displayName\":\"Small Second\",\"bullets\":[\"5 GB storage\"],\"costs\":[{\"amount\":{\"usd\":9.5},\"unit\":\"MONTHLY\"}]}"
       }
     }
   ]
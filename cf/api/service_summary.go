@@ -2,7 +2,11 @@ package api
 
 import (
 	"fmt"
+	"net/url"
+
+	"github.com/cloudfoundry/cli/cf/api/resources"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/net"
 )
@@ -28,10 +32,13 @@ func (resource ServiceInstancesSummaries) ToModels() (instances []models.Service
 		serviceOffering.Version = offeringSummary.Version
 
 		instance := models.ServiceInstance{}
+		instance.Guid = instanceSummary.Guid
 		instance.Name = instanceSummary.Name
 		instance.ApplicationNames = applicationNames
 		instance.ServicePlan = servicePlan
 		instance.ServiceOffering = serviceOffering
+		instance.Tags = instanceSummary.Tags
+		instance.LastOperation = instanceSummary.LastOperation.ToFields()
 
 		instances = append(instances, instance)
 	}
@@ -57,8 +64,11 @@ type ServiceInstanceSummaryApp struct {
 }
 
 type ServiceInstanceSummary struct {
-	Name        string
-	ServicePlan ServicePlanSummary `json:"service_plan"`
+	Guid          string
+	Name          string
+	Tags          []string
+	ServicePlan   ServicePlanSummary      `json:"service_plan"`
+	LastOperation resources.LastOperation `json:"last_operation"`
 }
 
 type ServicePlanSummary struct {
@@ -75,6 +85,10 @@ type ServiceOfferingSummary struct {
 
 type ServiceSummaryRepository interface {
 	GetSummariesInCurrentSpace() (instances []models.ServiceInstance, apiErr error)
+	GetSummariesInSpace(spaceGuid string) (instances []models.ServiceInstance, apiErr error)
+	GetServiceInstanceSummary(instanceGuid string) (instance models.ServiceInstance, apiErr error)
+	GetUserProvidedServiceInstanceSummary(instanceGuid string) (instance models.ServiceInstance, apiErr error)
+	GetServicePlanHistory(instanceGuid string) (changes []models.ServicePlanChange, apiErr error)
 }
 
 type CloudControllerServiceSummaryRepository struct {
@@ -89,7 +103,11 @@ func NewCloudControllerServiceSummaryRepository(config core_config.Reader, gatew
 }
 
 func (repo CloudControllerServiceSummaryRepository) GetSummariesInCurrentSpace() (instances []models.ServiceInstance, apiErr error) {
-	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), repo.config.SpaceFields().Guid)
+	return repo.GetSummariesInSpace(repo.config.SpaceFields().Guid)
+}
+
+func (repo CloudControllerServiceSummaryRepository) GetSummariesInSpace(spaceGuid string) (instances []models.ServiceInstance, apiErr error) {
+	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), spaceGuid)
 	resource := new(ServiceInstancesSummaries)
 
 	apiErr = repo.gateway.GetResource(path, resource)
@@ -101,3 +119,102 @@ func (repo CloudControllerServiceSummaryRepository) GetSummariesInCurrentSpace()
 
 	return
 }
+
+// GetServiceInstanceSummary returns the service instance together with its
+// space and organization and the names of the apps bound to it.
+func (repo CloudControllerServiceSummaryRepository) GetServiceInstanceSummary(instanceGuid string) (instance models.ServiceInstance, apiErr error) {
+	return repo.getInstanceSummary("service_instances", instanceGuid)
+}
+
+// GetUserProvidedServiceInstanceSummary is GetServiceInstanceSummary for
+// user-provided instances, which the cloud controller serves separately.
+func (repo CloudControllerServiceSummaryRepository) GetUserProvidedServiceInstanceSummary(instanceGuid string) (instance models.ServiceInstance, apiErr error) {
+	return repo.getInstanceSummary("user_provided_service_instances", instanceGuid)
+}
+
+func (repo CloudControllerServiceSummaryRepository) getInstanceSummary(collection, instanceGuid string) (instance models.ServiceInstance, apiErr error) {
+	path := fmt.Sprintf("%s/v2/%s/%s?inline-relations-depth=2", repo.config.ApiEndpoint(), collection, instanceGuid)
+	resource := new(resources.ServiceInstanceResource)
+
+	apiErr = repo.gateway.GetResource(path, resource)
+	if apiErr != nil {
+		if httpErr, ok := apiErr.(errors.HttpError); ok && httpErr.StatusCode() == 404 {
+			apiErr = errors.NewModelNotFoundError("Service instance", instanceGuid)
+		}
+		return
+	}
+
+	instance = resource.ToModel()
+
+	// bindings inlined in the instance stop at one page, so list them instead
+	instance.ServiceBindings = []models.ServiceBindingFields{}
+	apiErr = repo.gateway.ListPaginatedResources(
+		repo.config.ApiEndpoint(),
+		fmt.Sprintf("/v2/%s/%s/service_bindings?inline-relations-depth=1", collection, instanceGuid),
+		resources.ServiceBindingResource{},
+		func(resource interface{}) bool {
+			binding := resource.(resources.ServiceBindingResource)
+			instance.ServiceBindings = append(instance.ServiceBindings, binding.ToFields())
+			return true
+		})
+	return
+}
+
+// GetServicePlanHistory returns the plan changes recorded by
+// audit.service_instance.update events, oldest first.
+func (repo CloudControllerServiceSummaryRepository) GetServicePlanHistory(instanceGuid string) (changes []models.ServicePlanChange, apiErr error) {
+	path := fmt.Sprintf("/v2/events?q=%s&q=%s",
+		url.QueryEscape("actee:"+instanceGuid),
+		url.QueryEscape("type:audit.service_instance.update"))
+
+	apiErr = repo.gateway.ListPaginatedResources(
+		repo.config.ApiEndpoint(),
+		path,
+		resources.EventResourceNewV2{},
+		func(resource interface{}) bool {
+			event := resource.(resources.EventResourceNewV2)
+			planGuid := servicePlanGuidFromEvent(event)
+			if planGuid != "" {
+				changes = append(changes, models.ServicePlanChange{
+					Timestamp: event.Entity.Timestamp,
+					ActorName: event.Entity.ActorName,
+					PlanGuid:  planGuid,
+				})
+			}
+			return true
+		})
+	if apiErr != nil {
+		return
+	}
+
+	planNames := map[string]string{}
+	for index, change := range changes {
+		if _, found := planNames[change.PlanGuid]; !found {
+			planNames[change.PlanGuid] = repo.servicePlanName(change.PlanGuid)
+		}
+		changes[index].PlanName = planNames[change.PlanGuid]
+	}
+	return
+}
+
+func servicePlanGuidFromEvent(event resources.EventResourceNewV2) string {
+	request, ok := event.Entity.Metadata["request"].(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	planGuid, _ := request["service_plan_guid"].(string)
+	return planGuid
+}
+
+// servicePlanName falls back to the guid for plans that no longer exist or
+// are not visible to the user.
+func (repo CloudControllerServiceSummaryRepository) servicePlanName(planGuid string) string {
+	path := fmt.Sprintf("%s/v2/service_plans/%s", repo.config.ApiEndpoint(), planGuid)
+	resource := new(resources.ServicePlanResource)
+
+	if err := repo.gateway.GetResource(path, resource); err != nil {
+		return planGuid
+	}
+	return resource.Entity.Name
+}
@@ -1,11 +1,14 @@
 package api_test
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"time"
 
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/net"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
 	testnet "github.com/cloudfoundry/cli/testhelpers/net"
@@ -41,6 +44,7 @@ var _ = Describe("ServiceSummaryRepository", func() {
 				  "guid": "my-service-instance-guid",
 				  "name": "my-service-instance",
 				  "bound_app_count": 2,
+				  "tags": ["db"],
 				  "service_plan": {
 					"guid": "service-plan-guid",
 					"name": "spark",
@@ -50,6 +54,11 @@ var _ = Describe("ServiceSummaryRepository", func() {
 					  "provider": "cleardb-provider",
 					  "version": "n/a"
 					}
+				  },
+				  "last_operation": {
+					"type": "update",
+					"state": "failed",
+					"description": "Cluster resize failed"
 				  }
 				}
 			  ]
@@ -74,6 +83,7 @@ var _ = Describe("ServiceSummaryRepository", func() {
 		Expect(1).To(Equal(len(serviceInstances)))
 
 		instance1 := serviceInstances[0]
+		Expect(instance1.Guid).To(Equal("my-service-instance-guid"))
 		Expect(instance1.Name).To(Equal("my-service-instance"))
 		Expect(instance1.ServicePlan.Name).To(Equal("spark"))
 		Expect(instance1.ServiceOffering.Label).To(Equal("cleardb"))
@@ -83,14 +93,227 @@ var _ = Describe("ServiceSummaryRepository", func() {
 		Expect(len(instance1.ApplicationNames)).To(Equal(2))
 		Expect(instance1.ApplicationNames[0]).To(Equal("app1"))
 		Expect(instance1.ApplicationNames[1]).To(Equal("app2"))
+		Expect(instance1.Tags).To(Equal([]string{"db"}))
+		Expect(instance1.LastOperation.Type).To(Equal("update"))
+		Expect(instance1.LastOperation.State).To(Equal("failed"))
+		Expect(instance1.LastOperation.Description).To(Equal("Cluster resize failed"))
+	})
+
+	It("gets a summary of services in a space other than the targeted one", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/spaces/other-space-guid/summary",
+			Response: serviceInstanceSummariesResponse,
+		})
+
+		ts, handler, repo := createServiceSummaryRepo(req)
+		defer ts.Close()
+
+		serviceInstances, apiErr := repo.GetSummariesInSpace("other-space-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(len(serviceInstances)).To(Equal(1))
+		Expect(serviceInstances[0].Name).To(Equal("my-service-instance"))
+	})
+
+	It("gets a summary of a single service instance", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/service_instances/my-service-instance-guid?inline-relations-depth=2",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+			{
+			  "metadata": {"guid": "my-service-instance-guid"},
+			  "entity": {
+				"name": "my-service-instance",
+				"dashboard_url": "http://dashboard.example.com",
+				"space": {
+				  "metadata": {"guid": "my-space-guid"},
+				  "entity": {
+					"name": "my-space",
+					"organization": {"metadata": {"guid": "my-org-guid"}, "entity": {"name": "my-org"}}
+				  }
+				},
+				"last_operation": {"type": "create", "state": "succeeded"}
+			  }
+			}`},
+		})
+
+		firstBindingsPage := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/service_instances/my-service-instance-guid/service_bindings?inline-relations-depth=1",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: serviceBindingsPage(1, 50, "/v2/service_instances/my-service-instance-guid/service_bindings?inline-relations-depth=1&page=2")},
+		})
+		secondBindingsPage := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/service_instances/my-service-instance-guid/service_bindings?inline-relations-depth=1&page=2",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: serviceBindingsPage(51, 51, "")},
+		})
+
+		ts, handler, repo := createServiceSummaryRepo(req, firstBindingsPage, secondBindingsPage)
+		defer ts.Close()
+
+		instance, apiErr := repo.GetServiceInstanceSummary("my-service-instance-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+
+		Expect(instance.Name).To(Equal("my-service-instance"))
+		Expect(instance.DashboardUrl).To(Equal("http://dashboard.example.com"))
+		Expect(instance.Space.Name).To(Equal("my-space"))
+		Expect(instance.Organization.Name).To(Equal("my-org"))
+		Expect(instance.LastOperation.State).To(Equal("succeeded"))
+		Expect(len(instance.ServiceBindings)).To(Equal(51))
+		Expect(instance.ServiceBindings[0].Guid).To(Equal("binding1-guid"))
+		Expect(instance.ServiceBindings[0].AppGuid).To(Equal("app1-guid"))
+		Expect(instance.ServiceBindings[0].AppName).To(Equal("app1"))
+		Expect(instance.ServiceBindings[50].AppName).To(Equal("app51"))
+	})
+
+	It("gets the summary of a user-provided service instance", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/user_provided_service_instances/my-ups-guid?inline-relations-depth=2",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+			{
+			  "metadata": {"guid": "my-ups-guid"},
+			  "entity": {
+				"name": "my-ups",
+				"space": {
+				  "metadata": {"guid": "my-space-guid"},
+				  "entity": {
+					"name": "my-space",
+					"organization": {"metadata": {"guid": "my-org-guid"}, "entity": {"name": "my-org"}}
+				  }
+				}
+			  }
+			}`},
+		})
+
+		bindingsReq := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/user_provided_service_instances/my-ups-guid/service_bindings?inline-relations-depth=1",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: serviceBindingsPage(1, 1, "")},
+		})
+
+		ts, handler, repo := createServiceSummaryRepo(req, bindingsReq)
+		defer ts.Close()
+
+		instance, apiErr := repo.GetUserProvidedServiceInstanceSummary("my-ups-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+
+		Expect(instance.Name).To(Equal("my-ups"))
+		Expect(instance.Space.Name).To(Equal("my-space"))
+		Expect(instance.Organization.Name).To(Equal("my-org"))
+		Expect(instance.ServiceBindings[0].AppName).To(Equal("app1"))
+	})
+
+	It("returns a ModelNotFoundError when the service instance does not exist", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/service_instances/missing-guid?inline-relations-depth=2",
+			Response: testnet.TestResponse{Status: http.StatusNotFound, Body: `{"code": 60004, "description": "not found"}`},
+		})
+
+		ts, handler, repo := createServiceSummaryRepo(req)
+		defer ts.Close()
+
+		_, apiErr := repo.GetServiceInstanceSummary("missing-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+	})
+
+	It("gets the plan history of a service instance from its update events", func() {
+		eventsReq := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/events?q=actee%3Amy-service-instance-guid&q=type%3Aaudit.service_instance.update",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+			{
+			  "resources": [
+				{
+				  "metadata": {"guid": "event1-guid"},
+				  "entity": {
+					"type": "audit.service_instance.update",
+					"actor_name": "admin",
+					"timestamp": "2015-05-01T10:00:00Z",
+					"metadata": {"request": {"service_plan_guid": "flare-guid"}}
+				  }
+				},
+				{
+				  "metadata": {"guid": "event2-guid"},
+				  "entity": {
+					"type": "audit.service_instance.update",
+					"actor_name": "admin",
+					"timestamp": "2015-05-02T10:00:00Z",
+					"metadata": {"request": {"parameters": {"ram_gb": 4}}}
+				  }
+				},
+				{
+				  "metadata": {"guid": "event3-guid"},
+				  "entity": {
+					"type": "audit.service_instance.update",
+					"actor_name": "someone",
+					"timestamp": "2015-05-03T10:00:00Z",
+					"metadata": {"request": {"service_plan_guid": "deleted-plan-guid"}}
+				  }
+				}
+			  ]
+			}`},
+		})
+		planReq := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/service_plans/flare-guid",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+			{"metadata": {"guid": "flare-guid"}, "entity": {"name": "flare"}}`},
+		})
+		missingPlanReq := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/service_plans/deleted-plan-guid",
+			Response: testnet.TestResponse{Status: http.StatusNotFound},
+		})
+
+		ts, handler, repo := createServiceSummaryRepo(eventsReq, planReq, missingPlanReq)
+		defer ts.Close()
+
+		changes, apiErr := repo.GetServicePlanHistory("my-service-instance-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+
+		Expect(len(changes)).To(Equal(2))
+		Expect(changes[0].ActorName).To(Equal("admin"))
+		Expect(changes[0].PlanGuid).To(Equal("flare-guid"))
+		Expect(changes[0].PlanName).To(Equal("flare"))
+		Expect(changes[0].Timestamp.Format(time.RFC3339)).To(Equal("2015-05-01T10:00:00Z"))
+		Expect(changes[1].ActorName).To(Equal("someone"))
+		Expect(changes[1].PlanName).To(Equal("deleted-plan-guid"))
 	})
 })
 
-func createServiceSummaryRepo(req testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo ServiceSummaryRepository) {
-	ts, handler = testnet.NewServer([]testnet.TestRequest{req})
+func createServiceSummaryRepo(reqs ...testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo ServiceSummaryRepository) {
+	ts, handler = testnet.NewServer(reqs)
 	configRepo := testconfig.NewRepositoryWithDefaults()
 	configRepo.SetApiEndpoint(ts.URL)
 	gateway := net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{})
 	repo = NewCloudControllerServiceSummaryRepository(configRepo, gateway)
 	return
 }
+
+// serviceBindingsPage lists bindings first to last, each to the app with the
+// same number.
+func serviceBindingsPage(first, last int, nextUrl string) string {
+	bindings := []string{}
+	for i := first; i <= last; i++ {
+		bindings = append(bindings, fmt.Sprintf(`{
+			"metadata": {"guid": "binding%[1]d-guid"},
+			"entity": {
+				"app_guid": "app%[1]d-guid",
+				"app": {"metadata": {"guid": "app%[1]d-guid"}, "entity": {"name": "app%[1]d"}}
+			}
+		}`, i))
+	}
+
+	next := "null"
+	if nextUrl != "" {
+		next = fmt.Sprintf("%q", nextUrl)
+	}
+	return fmt.Sprintf(`{"next_url": %s, "resources": [%s]}`, next, strings.Join(bindings, ","))
+}
@@ -1,6 +1,8 @@
 package api
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
@@ -8,6 +10,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/api/resources"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/net"
 )
@@ -23,8 +26,9 @@ type ServiceRepository interface {
 	GetAllServiceOfferings() (offerings models.ServiceOfferings, apiErr error)
 	GetServiceOfferingsForSpace(spaceGuid string) (offerings models.ServiceOfferings, apiErr error)
 	FindInstanceByName(name string) (instance models.ServiceInstance, apiErr error)
-	CreateServiceInstance(name, planGuid string) (apiErr error)
-	UpdateServiceInstance(instanceGuid, planGuid string) (apiErr error)
+	CreateServiceInstance(name, planGuid string, params map[string]interface{}, tags []string) (apiErr error)
+	CreateServiceInstanceInSpace(spaceGuid, name, planGuid string, params map[string]interface{}, tags []string) (apiErr error)
+	UpdateServiceInstance(instanceGuid, planGuid string, params map[string]interface{}, tags []string) (apiErr error)
 	RenameService(instance models.ServiceInstance, newName string) (apiErr error)
 	DeleteService(instance models.ServiceInstance) (apiErr error)
 	FindServicePlanByDescription(planDescription resources.ServicePlanDescription) (planGuid string, apiErr error)
@@ -132,14 +136,8 @@ func (repo CloudControllerServiceRepository) FindInstanceByName(name string) (in
 	return
 }
 
-func (repo CloudControllerServiceRepository) CreateServiceInstance(name, planGuid string) (err error) {
-	path := "/v2/service_instances"
-	data := fmt.Sprintf(
-		`{"name":"%s","service_plan_guid":"%s","space_guid":"%s", "async": true}`,
-		name, planGuid, repo.config.SpaceFields().Guid,
-	)
-
-	err = repo.gateway.CreateResource(repo.config.ApiEndpoint(), path, strings.NewReader(data))
+func (repo CloudControllerServiceRepository) CreateServiceInstance(name, planGuid string, params map[string]interface{}, tags []string) (err error) {
+	err = repo.CreateServiceInstanceInSpace(repo.config.SpaceFields().Guid, name, planGuid, params, tags)
 
 	if httpErr, ok := err.(errors.HttpError); ok && httpErr.ErrorCode() == errors.SERVICE_INSTANCE_NAME_TAKEN {
 		serviceInstance, findInstanceErr := repo.FindInstanceByName(name)
@@ -152,11 +150,58 @@ func (repo CloudControllerServiceRepository) CreateServiceInstance(name, planGui
 	return
 }
 
-func (repo CloudControllerServiceRepository) UpdateServiceInstance(instanceGuid, planGuid string) (err error) {
-	path := fmt.Sprintf("/v2/service_instances/%s", instanceGuid)
-	data := fmt.Sprintf(`{"service_plan_guid":"%s"}`, planGuid)
+func (repo CloudControllerServiceRepository) CreateServiceInstanceInSpace(spaceGuid, name, planGuid string, params map[string]interface{}, tags []string) (err error) {
+	path := "/v2/service_instances?accepts_incomplete=true"
+
+	type RequestBody struct {
+		Name      string                 `json:"name"`
+		PlanGuid  string                 `json:"service_plan_guid"`
+		SpaceGuid string                 `json:"space_guid"`
+		Async     bool                   `json:"async"`
+		Params    map[string]interface{} `json:"parameters,omitempty"`
+		Tags      []string               `json:"tags,omitempty"`
+	}
+
+	jsonBytes, err := json.Marshal(RequestBody{
+		Name:      name,
+		PlanGuid:  planGuid,
+		SpaceGuid: spaceGuid,
+		Async:     true,
+		Params:    params,
+		Tags:      tags,
+	})
+	if err != nil {
+		return errors.NewWithError(T("Error parsing service parameters"), err)
+	}
+
+	return repo.gateway.CreateResource(repo.config.ApiEndpoint(), path, bytes.NewReader(jsonBytes))
+}
+
+// UpdateServiceInstance leaves the tags unchanged when tags is nil, while an
+// empty slice removes them.
+func (repo CloudControllerServiceRepository) UpdateServiceInstance(instanceGuid, planGuid string, params map[string]interface{}, tags []string) (err error) {
+	path := fmt.Sprintf("/v2/service_instances/%s?accepts_incomplete=true", instanceGuid)
 
-	err = repo.gateway.UpdateResource(repo.config.ApiEndpoint(), path, strings.NewReader(data))
+	type RequestBody struct {
+		PlanGuid string                 `json:"service_plan_guid,omitempty"`
+		Params   map[string]interface{} `json:"parameters,omitempty"`
+		Tags     *[]string              `json:"tags,omitempty"`
+	}
+
+	body := RequestBody{
+		PlanGuid: planGuid,
+		Params:   params,
+	}
+	if tags != nil {
+		body.Tags = &tags
+	}
+
+	jsonBytes, err := json.Marshal(body)
+	if err != nil {
+		return errors.NewWithError(T("Error parsing service parameters"), err)
+	}
+
+	err = repo.gateway.UpdateResource(repo.config.ApiEndpoint(), path, bytes.NewReader(jsonBytes))
 
 	return
 }
@@ -175,7 +220,7 @@ func (repo CloudControllerServiceRepository) DeleteService(instance models.Servi
 	if len(instance.ServiceBindings) > 0 {
 		return errors.New("Cannot delete service instance, apps are still bound to it")
 	}
-	path := fmt.Sprintf("/v2/service_instances/%s", instance.Guid)
+	path := fmt.Sprintf("/v2/service_instances/%s?accepts_incomplete=true", instance.Guid)
 	return repo.gateway.DeleteResource(repo.config.ApiEndpoint(), path)
 }
 
@@ -196,12 +196,51 @@ var _ = Describe("Services Repo", func() {
 		It("makes the right request", func() {
 			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
 				Method:   "POST",
-				Path:     "/v2/service_instances",
+				Path:     "/v2/service_instances?accepts_incomplete=true",
 				Matcher:  testnet.RequestBodyMatcher(`{"name":"instance-name","service_plan_guid":"plan-guid","space_guid":"my-space-guid","async":true}`),
 				Response: testnet.TestResponse{Status: http.StatusCreated},
 			}))
 
-			err := repo.CreateServiceInstance("instance-name", "plan-guid")
+			err := repo.CreateServiceInstance("instance-name", "plan-guid", nil, nil)
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("sends the service parameters when they are given", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "POST",
+				Path:     "/v2/service_instances",
+				Matcher:  testnet.RequestBodyMatcher(`{"name":"instance-name","service_plan_guid":"plan-guid","space_guid":"my-space-guid","async":true,"parameters":{"storage":10}}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated},
+			}))
+
+			err := repo.CreateServiceInstance("instance-name", "plan-guid", map[string]interface{}{"storage": 10}, nil)
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("sends the tags when they are given", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "POST",
+				Path:     "/v2/service_instances",
+				Matcher:  testnet.RequestBodyMatcher(`{"name":"instance-name","service_plan_guid":"plan-guid","space_guid":"my-space-guid","async":true,"tags":["db","mysql"]}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated},
+			}))
+
+			err := repo.CreateServiceInstance("instance-name", "plan-guid", nil, []string{"db", "mysql"})
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("creates the instance in the given space", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "POST",
+				Path:     "/v2/service_instances",
+				Matcher:  testnet.RequestBodyMatcher(`{"name":"instance-name","service_plan_guid":"plan-guid","space_guid":"other-space-guid","async":true}`),
+				Response: testnet.TestResponse{Status: http.StatusCreated},
+			}))
+
+			err := repo.CreateServiceInstanceInSpace("other-space-guid", "instance-name", "plan-guid", nil, nil)
 			Expect(testHandler).To(HaveAllRequestsCalled())
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -222,7 +261,7 @@ var _ = Describe("Services Repo", func() {
 			})
 
 			It("returns a ModelAlreadyExistsError if the plan is the same", func() {
-				err := repo.CreateServiceInstance("my-service", "plan-guid")
+				err := repo.CreateServiceInstance("my-service", "plan-guid", nil, nil)
 				Expect(testHandler).To(HaveAllRequestsCalled())
 				Expect(err).To(BeAssignableToTypeOf(&errors.ModelAlreadyExistsError{}))
 			})
@@ -244,7 +283,7 @@ var _ = Describe("Services Repo", func() {
 			})
 
 			It("fails if the plan is different", func() {
-				err := repo.CreateServiceInstance("my-service", "different-plan-guid")
+				err := repo.CreateServiceInstance("my-service", "different-plan-guid", nil, nil)
 
 				Expect(testHandler).To(HaveAllRequestsCalled())
 				Expect(err).To(HaveOccurred())
@@ -257,12 +296,51 @@ var _ = Describe("Services Repo", func() {
 		It("makes the right request", func() {
 			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
 				Method:   "PUT",
-				Path:     "/v2/service_instances/instance-guid",
+				Path:     "/v2/service_instances/instance-guid?accepts_incomplete=true",
 				Matcher:  testnet.RequestBodyMatcher(`{"service_plan_guid":"plan-guid"}`),
 				Response: testnet.TestResponse{Status: http.StatusOK},
 			}))
 
-			err := repo.UpdateServiceInstance("instance-guid", "plan-guid")
+			err := repo.UpdateServiceInstance("instance-guid", "plan-guid", nil, nil)
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("sends only the parameters when no plan is given", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/service_instances/instance-guid?accepts_incomplete=true",
+				Matcher:  testnet.RequestBodyMatcher(`{"parameters":{"ram_gb":4}}`),
+				Response: testnet.TestResponse{Status: http.StatusOK},
+			}))
+
+			err := repo.UpdateServiceInstance("instance-guid", "", map[string]interface{}{"ram_gb": 4}, nil)
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("replaces the tags when they are given", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/service_instances/instance-guid",
+				Matcher:  testnet.RequestBodyMatcher(`{"tags":["db"]}`),
+				Response: testnet.TestResponse{Status: http.StatusOK},
+			}))
+
+			err := repo.UpdateServiceInstance("instance-guid", "", nil, []string{"db"})
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("removes the tags when given an empty list", func() {
+			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method:   "PUT",
+				Path:     "/v2/service_instances/instance-guid",
+				Matcher:  testnet.RequestBodyMatcher(`{"tags":[]}`),
+				Response: testnet.TestResponse{Status: http.StatusOK},
+			}))
+
+			err := repo.UpdateServiceInstance("instance-guid", "", nil, []string{})
 			Expect(testHandler).To(HaveAllRequestsCalled())
 			Expect(err).NotTo(HaveOccurred())
 		})
@@ -276,7 +354,7 @@ var _ = Describe("Services Repo", func() {
 					Response: testnet.TestResponse{Status: http.StatusNotFound},
 				}))
 
-				err := repo.UpdateServiceInstance("instance-guid", "plan-guid")
+				err := repo.UpdateServiceInstance("instance-guid", "plan-guid", nil, nil)
 				Expect(testHandler).To(HaveAllRequestsCalled())
 				Expect(err).To(HaveOccurred())
 			})
@@ -295,6 +373,15 @@ var _ = Describe("Services Repo", func() {
 			Expect(instance.Name).To(Equal("my-service"))
 			Expect(instance.Guid).To(Equal("my-service-instance-guid"))
 			Expect(instance.DashboardUrl).To(Equal("my-dashboard-url"))
+			Expect(instance.Tags).To(Equal([]string{"db", "mysql"}))
+			Expect(instance.LastOperation).To(Equal(models.LastOperationFields{
+				Type:        "create",
+				State:       "in progress",
+				Description: "Provisioning cluster",
+				CreatedAt:   "2015-05-01T10:00:00Z",
+				UpdatedAt:   "2015-05-01T10:05:00Z",
+			}))
+			Expect(instance.IsOperationInProgress()).To(BeTrue())
 			Expect(instance.ServiceOffering.Label).To(Equal("mysql"))
 			Expect(instance.ServiceOffering.DocumentationUrl).To(Equal("http://info.example.com"))
 			Expect(instance.ServiceOffering.Description).To(Equal("MySQL database"))
@@ -381,7 +468,7 @@ var _ = Describe("Services Repo", func() {
 		It("it deletes the service when no apps are bound", func() {
 			setupTestServer(testapi.NewCloudControllerTestRequest(testnet.TestRequest{
 				Method:   "DELETE",
-				Path:     "/v2/service_instances/my-service-instance-guid",
+				Path:     "/v2/service_instances/my-service-instance-guid?accepts_incomplete=true",
 				Response: testnet.TestResponse{Status: http.StatusOK},
 			}))
 
@@ -558,6 +645,7 @@ var _ = Describe("Services Repo", func() {
 									"description": "offering 1 description",
 									"version" : "1.0",
 									"service_plans": [],
+									"tags": ["mysql", "relational"],
                   "service_broker_guid": "broker-1-guid"
 								  }
 								}
@@ -573,6 +661,7 @@ var _ = Describe("Services Repo", func() {
 				Expect(offerings[0].Description).To(Equal("offering 1 description"))
 				Expect(offerings[0].Version).To(Equal("1.0"))
 				Expect(offerings[0].BrokerGuid).To(Equal("broker-1-guid"))
+				Expect(offerings[0].Tags).To(Equal([]string{"mysql", "relational"}))
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -1218,6 +1307,7 @@ var findServiceInstanceReq = testapi.NewCloudControllerTestRequest(testnet.TestR
           "entity": {
             "name": "my-service",
 						"dashboard_url":"my-dashboard-url",
+            "tags": ["db", "mysql"],
             "service_bindings": [
               {
                 "metadata": {
@@ -1246,6 +1336,13 @@ var findServiceInstanceReq = testapi.NewCloudControllerTestRequest(testnet.TestR
                 "name": "plan-name",
                 "service_guid": "the-service-guid"
               }
+            },
+            "last_operation": {
+              "type": "create",
+              "state": "in progress",
+              "description": "Provisioning cluster",
+              "created_at": "2015-05-01T10:00:00Z",
+              "updated_at": "2015-05-01T10:05:00Z"
             }
           }
         }
@@ -0,0 +1,96 @@
+// This file was generated by counterfeiter
+package fakes
+
+import (
+	"sync"
+
+	"github.com/cloudfoundry/cli/cf/api/space_usage"
+	"github.com/cloudfoundry/cli/cf/models"
+)
+
+type FakeSpaceUsageRepository struct {
+	ListSpaceUsageStub        func(orgGuid string) ([]models.SpaceUsage, error)
+	listSpaceUsageMutex       sync.RWMutex
+	listSpaceUsageArgsForCall []struct {
+		orgGuid string
+	}
+	listSpaceUsageReturns struct {
+		result1 []models.SpaceUsage
+		result2 error
+	}
+	GetSpaceUsageStub        func(spaceGuid string) (models.SpaceUsage, error)
+	getSpaceUsageMutex       sync.RWMutex
+	getSpaceUsageArgsForCall []struct {
+		spaceGuid string
+	}
+	getSpaceUsageReturns struct {
+		result1 models.SpaceUsage
+		result2 error
+	}
+}
+
+func (fake *FakeSpaceUsageRepository) ListSpaceUsage(orgGuid string) ([]models.SpaceUsage, error) {
+	fake.listSpaceUsageMutex.Lock()
+	defer fake.listSpaceUsageMutex.Unlock()
+	fake.listSpaceUsageArgsForCall = append(fake.listSpaceUsageArgsForCall, struct {
+		orgGuid string
+	}{orgGuid})
+	if fake.ListSpaceUsageStub != nil {
+		return fake.ListSpaceUsageStub(orgGuid)
+	} else {
+		return fake.listSpaceUsageReturns.result1, fake.listSpaceUsageReturns.result2
+	}
+}
+
+func (fake *FakeSpaceUsageRepository) ListSpaceUsageCallCount() int {
+	fake.listSpaceUsageMutex.RLock()
+	defer fake.listSpaceUsageMutex.RUnlock()
+	return len(fake.listSpaceUsageArgsForCall)
+}
+
+func (fake *FakeSpaceUsageRepository) ListSpaceUsageArgsForCall(i int) string {
+	fake.listSpaceUsageMutex.RLock()
+	defer fake.listSpaceUsageMutex.RUnlock()
+	return fake.listSpaceUsageArgsForCall[i].orgGuid
+}
+
+func (fake *FakeSpaceUsageRepository) ListSpaceUsageReturns(result1 []models.SpaceUsage, result2 error) {
+	fake.listSpaceUsageReturns = struct {
+		result1 []models.SpaceUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSpaceUsageRepository) GetSpaceUsage(spaceGuid string) (models.SpaceUsage, error) {
+	fake.getSpaceUsageMutex.Lock()
+	defer fake.getSpaceUsageMutex.Unlock()
+	fake.getSpaceUsageArgsForCall = append(fake.getSpaceUsageArgsForCall, struct {
+		spaceGuid string
+	}{spaceGuid})
+	if fake.GetSpaceUsageStub != nil {
+		return fake.GetSpaceUsageStub(spaceGuid)
+	} else {
+		return fake.getSpaceUsageReturns.result1, fake.getSpaceUsageReturns.result2
+	}
+}
+
+func (fake *FakeSpaceUsageRepository) GetSpaceUsageCallCount() int {
+	fake.getSpaceUsageMutex.RLock()
+	defer fake.getSpaceUsageMutex.RUnlock()
+	return len(fake.getSpaceUsageArgsForCall)
+}
+
+func (fake *FakeSpaceUsageRepository) GetSpaceUsageArgsForCall(i int) string {
+	fake.getSpaceUsageMutex.RLock()
+	defer fake.getSpaceUsageMutex.RUnlock()
+	return fake.getSpaceUsageArgsForCall[i].spaceGuid
+}
+
+func (fake *FakeSpaceUsageRepository) GetSpaceUsageReturns(result1 models.SpaceUsage, result2 error) {
+	fake.getSpaceUsageReturns = struct {
+		result1 models.SpaceUsage
+		result2 error
+	}{result1, result2}
+}
+
+var _ space_usage.SpaceUsageRepository = new(FakeSpaceUsageRepository)
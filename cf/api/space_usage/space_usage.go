@@ -0,0 +1,102 @@
+package space_usage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/api/resources"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/net"
+)
+
+type SpaceUsageRepository interface {
+	ListSpaceUsage(orgGuid string) ([]models.SpaceUsage, error)
+	GetSpaceUsage(spaceGuid string) (models.SpaceUsage, error)
+}
+
+type CloudControllerSpaceUsageRepository struct {
+	config  core_config.Reader
+	gateway net.Gateway
+}
+
+func NewCloudControllerSpaceUsageRepository(config core_config.Reader, gateway net.Gateway) CloudControllerSpaceUsageRepository {
+	return CloudControllerSpaceUsageRepository{
+		config:  config,
+		gateway: gateway,
+	}
+}
+
+type spaceSummary struct {
+	Apps []struct {
+		Memory           int64
+		Instances        int
+		RunningInstances int `json:"running_instances"`
+		State            string
+	}
+	Services []struct {
+		Guid string
+	}
+}
+
+func (repo CloudControllerSpaceUsageRepository) ListSpaceUsage(orgGuid string) ([]models.SpaceUsage, error) {
+	usages := []models.SpaceUsage{}
+	err := repo.gateway.ListPaginatedResources(
+		repo.config.ApiEndpoint(),
+		fmt.Sprintf("/v2/organizations/%s/spaces", orgGuid),
+		resources.SpaceResource{},
+		func(resource interface{}) bool {
+			if space, ok := resource.(resources.SpaceResource); ok {
+				usages = append(usages, models.SpaceUsage{
+					Space:          space.ToFields(),
+					SpaceQuotaGuid: space.Entity.SpaceQuotaGuid,
+				})
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range usages {
+		err = repo.fillUsage(&usages[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return usages, nil
+}
+
+// GetSpaceUsage returns the usage of a single space. Only the guid of the
+// space is set.
+func (repo CloudControllerSpaceUsageRepository) GetSpaceUsage(spaceGuid string) (models.SpaceUsage, error) {
+	usage := models.SpaceUsage{Space: models.SpaceFields{Guid: spaceGuid}}
+	err := repo.fillUsage(&usage)
+	return usage, err
+}
+
+func (repo CloudControllerSpaceUsageRepository) fillUsage(usage *models.SpaceUsage) error {
+	summary := new(spaceSummary)
+	path := fmt.Sprintf("%s/v2/spaces/%s/summary", repo.config.ApiEndpoint(), usage.Space.Guid)
+	err := repo.gateway.GetResource(path, summary)
+	if err != nil {
+		return err
+	}
+
+	for _, app := range summary.Apps {
+		if strings.ToLower(app.State) == "started" {
+			usage.Memory += app.Memory * int64(app.Instances)
+		}
+		usage.RunningInstances += app.RunningInstances
+	}
+	usage.ServiceInstances = len(summary.Services)
+
+	return repo.gateway.ListPaginatedResources(
+		repo.config.ApiEndpoint(),
+		fmt.Sprintf("/v2/spaces/%s/routes", usage.Space.Guid),
+		resources.RouteResource{},
+		func(resource interface{}) bool {
+			usage.Routes++
+			return true
+		})
+}
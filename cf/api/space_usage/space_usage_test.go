@@ -0,0 +1,174 @@
+package space_usage_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/net"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testnet "github.com/cloudfoundry/cli/testhelpers/net"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/api/space_usage"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Usage Repository", func() {
+	var (
+		testServer  *httptest.Server
+		testHandler *testnet.TestHandler
+		configRepo  core_config.ReadWriter
+		repo        CloudControllerSpaceUsageRepository
+	)
+
+	BeforeEach(func() {
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		gateway := net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{})
+		repo = NewCloudControllerSpaceUsageRepository(configRepo, gateway)
+	})
+
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	setupTestServer := func(reqs ...testnet.TestRequest) {
+		testServer, testHandler = testnet.NewServer(reqs)
+		configRepo.SetApiEndpoint(testServer.URL)
+	}
+
+	Describe("ListSpaceUsage", func() {
+		It("totals the apps, services and routes of each space in the org", func() {
+			setupTestServer(
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/organizations/my-org-guid/spaces",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body: `{"resources": [
+							{"metadata": {"guid": "space1-guid"}, "entity": {"name": "space1", "space_quota_definition_guid": "quota-guid"}},
+							{"metadata": {"guid": "space2-guid"}, "entity": {"name": "space2"}}
+						]}`,
+					},
+				}),
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/spaces/space1-guid/summary",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body: `{
+							"apps": [
+								{"name": "app1", "memory": 256, "instances": 2, "running_instances": 2, "state": "STARTED"},
+								{"name": "app2", "memory": 1024, "instances": 1, "running_instances": 0, "state": "STOPPED"}
+							],
+							"services": [{"guid": "service1-guid"}, {"guid": "service2-guid"}]
+						}`,
+					},
+				}),
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/spaces/space1-guid/routes",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body:   `{"resources": [{"metadata": {"guid": "route1-guid"}}, {"metadata": {"guid": "route2-guid"}}, {"metadata": {"guid": "route3-guid"}}]}`,
+					},
+				}),
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/spaces/space2-guid/summary",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body:   `{"apps": [], "services": []}`,
+					},
+				}),
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/spaces/space2-guid/routes",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body:   `{"resources": []}`,
+					},
+				}),
+			)
+
+			usages, err := repo.ListSpaceUsage("my-org-guid")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(len(usages)).To(Equal(2))
+			Expect(usages[0].Space.Name).To(Equal("space1"))
+			Expect(usages[0].SpaceQuotaGuid).To(Equal("quota-guid"))
+			Expect(usages[0].Memory).To(Equal(int64(512)))
+			Expect(usages[0].RunningInstances).To(Equal(2))
+			Expect(usages[0].ServiceInstances).To(Equal(2))
+			Expect(usages[0].Routes).To(Equal(3))
+
+			Expect(usages[1].Space.Name).To(Equal("space2"))
+			Expect(usages[1].SpaceQuotaGuid).To(BeEmpty())
+			Expect(usages[1].Memory).To(Equal(int64(0)))
+			Expect(usages[1].Routes).To(Equal(0))
+		})
+
+		It("returns an error when a space summary cannot be fetched", func() {
+			setupTestServer(
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/organizations/my-org-guid/spaces",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body:   `{"resources": [{"metadata": {"guid": "space1-guid"}, "entity": {"name": "space1"}}]}`,
+					},
+				}),
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method:   "GET",
+					Path:     "/v2/spaces/space1-guid/summary",
+					Response: testnet.TestResponse{Status: http.StatusInternalServerError},
+				}),
+			)
+
+			_, err := repo.ListSpaceUsage("my-org-guid")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("GetSpaceUsage", func() {
+		It("totals the apps, services and routes of the space", func() {
+			setupTestServer(
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/spaces/space1-guid/summary",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body: `{
+							"apps": [{"name": "app1", "memory": 256, "instances": 2, "running_instances": 1, "state": "STARTED"}],
+							"services": [{"guid": "service1-guid"}]
+						}`,
+					},
+				}),
+				testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+					Method: "GET",
+					Path:   "/v2/spaces/space1-guid/routes",
+					Response: testnet.TestResponse{
+						Status: http.StatusOK,
+						Body:   `{"resources": [{"metadata": {"guid": "route1-guid"}}]}`,
+					},
+				}),
+			)
+
+			usage, err := repo.GetSpaceUsage("space1-guid")
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(usage.Space.Guid).To(Equal("space1-guid"))
+			Expect(usage.Memory).To(Equal(int64(512)))
+			Expect(usage.RunningInstances).To(Equal(1))
+			Expect(usage.ServiceInstances).To(Equal(1))
+			Expect(usage.Routes).To(Equal(1))
+		})
+	})
+})
@@ -3,6 +3,7 @@ package spaces
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -15,8 +16,10 @@ import (
 
 type SpaceRepository interface {
 	ListSpaces(func(models.Space) bool) error
+	ListSpacesFromOrg(orgGuid string, spaceFunc func(models.Space) bool) error
 	FindByName(name string) (space models.Space, apiErr error)
 	FindByNameInOrg(name, orgGuid string) (space models.Space, apiErr error)
+	FindByGuid(guid string) (space models.Space, apiErr error)
 	Create(name string, orgGuid string, spaceQuotaGuid string) (space models.Space, apiErr error)
 	Rename(spaceGuid, newName string) (apiErr error)
 	Delete(spaceGuid string) (apiErr error)
@@ -34,9 +37,13 @@ func NewCloudControllerSpaceRepository(config core_config.Reader, gateway net.Ga
 }
 
 func (repo CloudControllerSpaceRepository) ListSpaces(callback func(models.Space) bool) error {
+	return repo.ListSpacesFromOrg(repo.config.OrganizationFields().Guid, callback)
+}
+
+func (repo CloudControllerSpaceRepository) ListSpacesFromOrg(orgGuid string, callback func(models.Space) bool) error {
 	return repo.gateway.ListPaginatedResources(
 		repo.config.ApiEndpoint(),
-		fmt.Sprintf("/v2/organizations/%s/spaces?inline-relations-depth=1", repo.config.OrganizationFields().Guid),
+		fmt.Sprintf("/v2/organizations/%s/spaces?inline-relations-depth=1", orgGuid),
 		resources.SpaceResource{},
 		func(resource interface{}) bool {
 			return callback(resource.(resources.SpaceResource).ToModel())
@@ -66,6 +73,20 @@ func (repo CloudControllerSpaceRepository) FindByNameInOrg(name, orgGuid string)
 	return
 }
 
+func (repo CloudControllerSpaceRepository) FindByGuid(guid string) (space models.Space, apiErr error) {
+	resource := new(resources.SpaceResource)
+	apiErr = repo.gateway.GetResource(fmt.Sprintf("%s/v2/spaces/%s?inline-relations-depth=1", repo.config.ApiEndpoint(), guid), resource)
+	if apiErr != nil {
+		if httpErr, ok := apiErr.(errors.HttpError); ok && httpErr.StatusCode() == http.StatusNotFound {
+			apiErr = errors.NewModelNotFoundError("Space", guid)
+		}
+		return
+	}
+
+	space = resource.ToModel()
+	return
+}
+
 func (repo CloudControllerSpaceRepository) Create(name, orgGuid, spaceQuotaGuid string) (space models.Space, apiErr error) {
 	path := "/v2/spaces?inline-relations-depth=1"
 
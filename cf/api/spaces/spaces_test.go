@@ -89,6 +89,54 @@ var _ = Describe("Space Repository", func() {
 		Expect(handler).To(HaveAllRequestsCalled())
 	})
 
+	It("lists the spaces of a particular org across pages", func() {
+		firstPageSpacesRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/organizations/other-org-guid/spaces?inline-relations-depth=1",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body: `
+				{
+					"next_url": "/v2/organizations/other-org-guid/spaces?inline-relations-depth=1&page=2",
+					"resources": [
+						{
+							"metadata": { "guid": "dev-space-guid" },
+							"entity": { "name": "dev" }
+						}
+					]
+				}`}})
+
+		secondPageSpacesRequest := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/organizations/other-org-guid/spaces?inline-relations-depth=1&page=2",
+			Response: testnet.TestResponse{
+				Status: http.StatusOK,
+				Body: `
+				{
+					"resources": [
+						{
+							"metadata": { "guid": "prod-space-guid" },
+							"entity": { "name": "prod" }
+						}
+					]
+				}`}})
+
+		ts, handler, repo := createSpacesRepo(firstPageSpacesRequest, secondPageSpacesRequest)
+		defer ts.Close()
+
+		spaces := []models.Space{}
+		apiErr := repo.ListSpacesFromOrg("other-org-guid", func(space models.Space) bool {
+			spaces = append(spaces, space)
+			return true
+		})
+
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(len(spaces)).To(Equal(2))
+		Expect(spaces[0].Name).To(Equal("dev"))
+		Expect(spaces[1].Name).To(Equal("prod"))
+		Expect(handler).To(HaveAllRequestsCalled())
+	})
+
 	Describe("finding spaces by name", func() {
 		It("returns the space", func() {
 			testSpacesFindByNameWithOrg("my-org-guid",
@@ -321,6 +369,51 @@ func testSpacesDidNotFindByNameWithOrg(orgGuid string, findByName func(SpaceRepo
 	Expect(apiErr.(*errors.ModelNotFoundError)).NotTo(BeNil())
 }
 
+var _ = Describe("finding a space by guid", func() {
+	It("returns the space with its organization", func() {
+		request := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/spaces/my-space-guid?inline-relations-depth=1",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `
+				{
+					"metadata": { "guid": "my-space-guid" },
+					"entity": {
+						"name": "my-space",
+						"organization": {
+							"metadata": { "guid": "my-org-guid" },
+							"entity": { "name": "my-org" }
+						}
+					}
+				}`},
+		})
+
+		ts, handler, repo := createSpacesRepo(request)
+		defer ts.Close()
+
+		space, apiErr := repo.FindByGuid("my-space-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(space.Name).To(Equal("my-space"))
+		Expect(space.Organization.Name).To(Equal("my-org"))
+		Expect(space.Organization.Guid).To(Equal("my-org-guid"))
+	})
+
+	It("returns a ModelNotFoundError when the space does not exist", func() {
+		request := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "GET",
+			Path:     "/v2/spaces/my-space-guid?inline-relations-depth=1",
+			Response: testnet.TestResponse{Status: http.StatusNotFound},
+		})
+
+		ts, handler, repo := createSpacesRepo(request)
+		defer ts.Close()
+
+		_, apiErr := repo.FindByGuid("my-space-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+	})
+})
+
 func createSpacesRepo(reqs ...testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo SpaceRepository) {
 	ts, handler = testnet.NewServer(reqs)
 	configRepo := testconfig.NewRepositoryWithDefaults()
@@ -2,6 +2,7 @@ package stacks
 
 import (
 	"fmt"
+	"net/http"
 	"net/url"
 
 	"github.com/cloudfoundry/cli/cf/api/resources"
@@ -13,6 +14,7 @@ import (
 
 type StackRepository interface {
 	FindByName(name string) (stack models.Stack, apiErr error)
+	FindByGUID(guid string) (stack models.Stack, apiErr error)
 	FindAll() (stacks []models.Stack, apiErr error)
 }
 
@@ -43,6 +45,19 @@ func (repo CloudControllerStackRepository) FindByName(name string) (stack models
 	return
 }
 
+func (repo CloudControllerStackRepository) FindByGUID(guid string) (models.Stack, error) {
+	stackResource := new(resources.StackResource)
+	apiErr := repo.gateway.GetResource(repo.config.ApiEndpoint()+fmt.Sprintf("/v2/stacks/%s", guid), stackResource)
+	if apiErr != nil {
+		if httpErr, ok := apiErr.(errors.HttpError); ok && httpErr.StatusCode() == http.StatusNotFound {
+			apiErr = errors.NewModelNotFoundError("Stack", guid)
+		}
+		return models.Stack{}, apiErr
+	}
+
+	return *stackResource.ToFields(), nil
+}
+
 func (repo CloudControllerStackRepository) FindAll() (stacks []models.Stack, apiErr error) {
 	return repo.findAllWithPath("/v2/stacks")
 }
@@ -45,6 +45,43 @@ var _ = Describe("StacksRepo", func() {
 		testServer.Close()
 	})
 
+	Describe("FindByGUID", func() {
+		It("finds the stack", func() {
+			setupTestServer(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/stacks/custom-linux-guid",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body: `
+				{
+				  "metadata": { "guid": "custom-linux-guid" },
+				  "entity": { "name": "custom-linux" }
+				}`}})
+
+			stack, err := repo.FindByGUID("custom-linux-guid")
+
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stack).To(Equal(models.Stack{
+				Name: "custom-linux",
+				Guid: "custom-linux-guid",
+			}))
+		})
+
+		It("returns a ModelNotFoundError when the stack does not exist", func() {
+			setupTestServer(testnet.TestRequest{
+				Method:   "GET",
+				Path:     "/v2/stacks/missing-guid",
+				Response: testnet.TestResponse{Status: http.StatusNotFound},
+			})
+
+			_, err := repo.FindByGUID("missing-guid")
+
+			Expect(testHandler).To(HaveAllRequestsCalled())
+			Expect(err).To(BeAssignableToTypeOf(&errors.ModelNotFoundError{}))
+		})
+	})
+
 	Describe("FindByName", func() {
 		Context("when a stack exists", func() {
 			BeforeEach(func() {
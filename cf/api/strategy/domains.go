@@ -9,6 +9,7 @@ type DomainsEndpointStrategy interface {
 	DeleteDomainURL(guid string) string
 	DeleteSharedDomainURL(guid string) string
 	PrivateDomainsByOrgURL(guid string) string
+	SharedPrivateDomainURL(orgGuid, domainGuid string) string
 }
 
 type domainsEndpointStrategy struct{}
@@ -43,6 +44,10 @@ func (s domainsEndpointStrategy) PrivateDomainsByOrgURL(orgGuid string) string {
 	return v2("domains")
 }
 
+func (s domainsEndpointStrategy) SharedPrivateDomainURL(orgGuid, domainGuid string) string {
+	return v2("organizations", orgGuid, "domains", domainGuid)
+}
+
 func (s domainsEndpointStrategy) DeleteDomainURL(guid string) string {
 	return buildURL(v2("domains", guid), params{recursive: true})
 }
@@ -80,6 +85,10 @@ func (s separatedDomainsEndpointStrategy) PrivateDomainsByOrgURL(orgGuid string)
 	return v2("organizations", orgGuid, "private_domains")
 }
 
+func (s separatedDomainsEndpointStrategy) SharedPrivateDomainURL(orgGuid, domainGuid string) string {
+	return v2("organizations", orgGuid, "private_domains", domainGuid)
+}
+
 func (s separatedDomainsEndpointStrategy) DeleteDomainURL(guid string) string {
 	return buildURL(v2("private_domains", guid), params{recursive: true})
 }
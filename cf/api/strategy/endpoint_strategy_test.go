@@ -60,6 +60,10 @@ var _ = Describe("EndpointStrategy", func() {
 			It("uses the general domains endpoint", func() {
 				Expect(strategy.PrivateDomainsURL()).To(Equal("/v2/domains"))
 			})
+
+			It("shares private domains through the org domains endpoint", func() {
+				Expect(strategy.SharedPrivateDomainURL("org-guid", "domain-guid")).To(Equal("/v2/organizations/org-guid/domains/domain-guid"))
+			})
 		})
 
 		Context("when targeting a v2.1.0 cloud controller", func() {
@@ -70,6 +74,10 @@ var _ = Describe("EndpointStrategy", func() {
 			It("uses the private domains endpoint", func() {
 				Expect(strategy.PrivateDomainsURL()).To(Equal("/v2/private_domains"))
 			})
+
+			It("shares private domains through the org private domains endpoint", func() {
+				Expect(strategy.SharedPrivateDomainURL("org-guid", "domain-guid")).To(Equal("/v2/organizations/org-guid/private_domains/domain-guid"))
+			})
 		})
 	})
 })
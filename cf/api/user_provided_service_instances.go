@@ -12,7 +12,9 @@ import (
 )
 
 type UserProvidedServiceInstanceRepository interface {
-	Create(name, drainUrl string, params map[string]interface{}) (apiErr error)
+	Create(name, drainUrl string, params map[string]interface{}, tags []string) (apiErr error)
+	CreateInSpace(spaceGuid, name, drainUrl string, params map[string]interface{}, tags []string) (apiErr error)
+	FindByGuid(guid string) (serviceInstanceFields models.ServiceInstanceFields, apiErr error)
 	Update(serviceInstanceFields models.ServiceInstanceFields) (apiErr error)
 }
 
@@ -27,7 +29,11 @@ func NewCCUserProvidedServiceInstanceRepository(config core_config.Reader, gatew
 	return
 }
 
-func (repo CCUserProvidedServiceInstanceRepository) Create(name, drainUrl string, params map[string]interface{}) (apiErr error) {
+func (repo CCUserProvidedServiceInstanceRepository) Create(name, drainUrl string, params map[string]interface{}, tags []string) (apiErr error) {
+	return repo.CreateInSpace(repo.config.SpaceFields().Guid, name, drainUrl, params, tags)
+}
+
+func (repo CCUserProvidedServiceInstanceRepository) CreateInSpace(spaceGuid, name, drainUrl string, params map[string]interface{}, tags []string) (apiErr error) {
 	path := "/v2/user_provided_service_instances"
 
 	type RequestBody struct {
@@ -35,13 +41,15 @@ func (repo CCUserProvidedServiceInstanceRepository) Create(name, drainUrl string
 		Credentials    map[string]interface{} `json:"credentials"`
 		SpaceGuid      string                 `json:"space_guid"`
 		SysLogDrainUrl string                 `json:"syslog_drain_url"`
+		Tags           []string               `json:"tags,omitempty"`
 	}
 
 	jsonBytes, err := json.Marshal(RequestBody{
 		Name:           name,
 		Credentials:    params,
-		SpaceGuid:      repo.config.SpaceFields().Guid,
+		SpaceGuid:      spaceGuid,
 		SysLogDrainUrl: drainUrl,
+		Tags:           tags,
 	})
 
 	if err != nil {
@@ -69,3 +77,31 @@ func (repo CCUserProvidedServiceInstanceRepository) Update(serviceInstanceFields
 
 	return repo.gateway.UpdateResource(repo.config.ApiEndpoint(), path, bytes.NewReader(jsonBytes))
 }
+
+// FindByGuid returns the instance with its credentials in Params.
+func (repo CCUserProvidedServiceInstanceRepository) FindByGuid(guid string) (serviceInstanceFields models.ServiceInstanceFields, apiErr error) {
+	resource := new(struct {
+		Metadata struct {
+			Guid string `json:"guid"`
+		} `json:"metadata"`
+		Entity struct {
+			Name           string                 `json:"name"`
+			Credentials    map[string]interface{} `json:"credentials"`
+			SysLogDrainUrl string                 `json:"syslog_drain_url"`
+			Tags           []string               `json:"tags"`
+		} `json:"entity"`
+	})
+
+	path := fmt.Sprintf("%s/v2/user_provided_service_instances/%s", repo.config.ApiEndpoint(), guid)
+	apiErr = repo.gateway.GetResource(path, resource)
+	if apiErr != nil {
+		return
+	}
+
+	serviceInstanceFields.Guid = resource.Metadata.Guid
+	serviceInstanceFields.Name = resource.Entity.Name
+	serviceInstanceFields.Params = resource.Entity.Credentials
+	serviceInstanceFields.SysLogDrainUrl = resource.Entity.SysLogDrainUrl
+	serviceInstanceFields.Tags = resource.Entity.Tags
+	return
+}
@@ -34,7 +34,23 @@ var _ = Describe("UserProvidedServiceRepository", func() {
 			"host":     "example.com",
 			"user":     "me",
 			"password": "secret",
+		}, nil)
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+
+	It("creates user provided service instances with tags", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "POST",
+			Path:     "/v2/user_provided_service_instances",
+			Matcher:  testnet.RequestBodyMatcher(`{"name":"my-custom-service","credentials":{},"space_guid":"my-space-guid","syslog_drain_url":"","tags":["db","mysql"]}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
 		})
+
+		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.Create("my-custom-service", "", map[string]interface{}{}, []string{"db", "mysql"})
 		Expect(handler).To(HaveAllRequestsCalled())
 		Expect(apiErr).NotTo(HaveOccurred())
 	})
@@ -54,7 +70,7 @@ var _ = Describe("UserProvidedServiceRepository", func() {
 			"host":     "example.com",
 			"user":     "me",
 			"password": "secret",
-		})
+		}, nil)
 		Expect(handler).To(HaveAllRequestsCalled())
 		Expect(apiErr).NotTo(HaveOccurred())
 	})
@@ -84,6 +100,50 @@ var _ = Describe("UserProvidedServiceRepository", func() {
 		Expect(handler).To(HaveAllRequestsCalled())
 		Expect(apiErr).NotTo(HaveOccurred())
 	})
+
+	It("creates user provided service instances in a given space", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method:   "POST",
+			Path:     "/v2/user_provided_service_instances",
+			Matcher:  testnet.RequestBodyMatcher(`{"name":"my-custom-service","credentials":{"user":"me"},"space_guid":"other-space-guid","syslog_drain_url":"syslog://example.com"}`),
+			Response: testnet.TestResponse{Status: http.StatusCreated},
+		})
+
+		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
+		defer ts.Close()
+
+		apiErr := repo.CreateInSpace("other-space-guid", "my-custom-service", "syslog://example.com", map[string]interface{}{"user": "me"}, nil)
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+	})
+
+	It("finds a user provided service instance with its credentials", func() {
+		req := testapi.NewCloudControllerTestRequest(testnet.TestRequest{
+			Method: "GET",
+			Path:   "/v2/user_provided_service_instances/my-instance-guid",
+			Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+				"metadata": {"guid": "my-instance-guid"},
+				"entity": {
+					"name": "my-custom-service",
+					"credentials": {"user": "me"},
+					"syslog_drain_url": "syslog://example.com",
+					"tags": ["db"]
+				}
+			}`},
+		})
+
+		ts, handler, repo := createUserProvidedServiceInstanceRepo(req)
+		defer ts.Close()
+
+		instance, apiErr := repo.FindByGuid("my-instance-guid")
+		Expect(handler).To(HaveAllRequestsCalled())
+		Expect(apiErr).NotTo(HaveOccurred())
+		Expect(instance.Guid).To(Equal("my-instance-guid"))
+		Expect(instance.Name).To(Equal("my-custom-service"))
+		Expect(instance.Params).To(Equal(map[string]interface{}{"user": "me"}))
+		Expect(instance.SysLogDrainUrl).To(Equal("syslog://example.com"))
+		Expect(instance.Tags).To(Equal([]string{"db"}))
+	})
 })
 
 func createUserProvidedServiceInstanceRepo(req testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo UserProvidedServiceInstanceRepository) {
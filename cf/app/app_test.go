@@ -54,6 +54,7 @@ var _ = Describe("App", func() {
 		repoLocator := api.NewRepositoryLocator(config, map[string]net.Gateway{
 			"auth":             net.NewUAAGateway(config, ui),
 			"cloud-controller": net.NewCloudControllerGateway(config, time.Now, &testterm.FakeUI{}),
+			"service-broker":   net.NewServiceBrokerGateway(config, ui),
 			"uaa":              net.NewUAAGateway(config, ui),
 		})
 
@@ -141,6 +141,8 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					presentCommand("copy-source"),
 				}, {
 					presentCommand("create-app-manifest"),
+					presentCommand("validate-manifest"),
+					presentCommand("check-ignore"),
 				},
 			},
 		}, {
@@ -158,6 +160,12 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				}, {
 					presentCommand("bind-service"),
 					presentCommand("unbind-service"),
+					presentCommand("rebind-service"),
+				}, {
+					presentCommand("create-service-key"),
+					presentCommand("service-keys"),
+					presentCommand("service-key"),
+					presentCommand("delete-service-key"),
 				}, {
 					presentCommand("create-user-provided-service"),
 					presentCommand("update-user-provided-service"),
@@ -169,10 +177,16 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 				{
 					presentCommand("orgs"),
 					presentCommand("org"),
+					presentCommand("usage"),
 				}, {
 					presentCommand("create-org"),
 					presentCommand("delete-org"),
 					presentCommand("rename-org"),
+				}, {
+					presentCommand("plan-config"),
+					presentCommand("apply-config"),
+					presentCommand("export-org"),
+					presentCommand("import-org"),
 				},
 			},
 		}, {
@@ -194,6 +208,8 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					presentCommand("domains"),
 					presentCommand("create-domain"),
 					presentCommand("delete-domain"),
+					presentCommand("share-private-domain"),
+					presentCommand("unshare-private-domain"),
 					presentCommand("create-shared-domain"),
 					presentCommand("delete-shared-domain"),
 				},
@@ -205,8 +221,11 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					presentCommand("routes"),
 					presentCommand("create-route"),
 					presentCommand("check-route"),
+					presentCommand("route-owner"),
 					presentCommand("map-route"),
 					presentCommand("unmap-route"),
+					presentCommand("move-routes"),
+					presentCommand("transfer-route"),
 					presentCommand("delete-route"),
 					presentCommand("delete-orphaned-routes"),
 				},
@@ -274,6 +293,7 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					presentCommand("delete-service-auth-token"),
 				}, {
 					presentCommand("service-brokers"),
+					presentCommand("check-broker"),
 					presentCommand("create-service-broker"),
 					presentCommand("update-service-broker"),
 					presentCommand("delete-service-broker"),
@@ -285,6 +305,8 @@ func newAppPresenter(app *cli.App) (presenter appPresenter) {
 					presentCommand("service-access"),
 					presentCommand("enable-service-access"),
 					presentCommand("disable-service-access"),
+					presentCommand("apply-service-access"),
+					presentCommand("export-service-access"),
 				},
 			},
 		}, {
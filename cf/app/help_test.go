@@ -90,6 +90,7 @@ func createCommandFactory() command_factory.Factory {
 	apiRepoLocator := api.NewRepositoryLocator(configRepo, map[string]net.Gateway{
 		"auth":             net.NewUAAGateway(configRepo, fakeUI),
 		"cloud-controller": net.NewCloudControllerGateway(configRepo, time.Now, fakeUI),
+		"service-broker":   net.NewServiceBrokerGateway(configRepo, fakeUI),
 		"uaa":              net.NewUAAGateway(configRepo, fakeUI),
 	})
 
@@ -6,7 +6,10 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/models"
 	cffileutils "github.com/cloudfoundry/cli/fileutils"
 	"github.com/cloudfoundry/gofileutils/fileutils"
@@ -17,6 +20,7 @@ type AppFiles interface {
 	CopyFiles(appFiles []models.AppFileFields, fromDir, toDir string) (err error)
 	CountFiles(directory string) int64
 	WalkAppFiles(dir string, onEachFile func(string, string) error) (err error)
+	CheckIgnore(dir string, path string) (match IgnoreMatch, err error)
 }
 
 type ApplicationFiles struct{}
@@ -82,33 +86,122 @@ func (appfiles ApplicationFiles) CountFiles(directory string) int64 {
 }
 
 func (appfiles ApplicationFiles) WalkAppFiles(dir string, onEachFile func(string, string) error) (err error) {
-	cfIgnore := loadIgnoreFile(dir)
-	walkFunc := func(fullPath string, f os.FileInfo, inErr error) (err error) {
-		err = inErr
+	// ignored directories are still walked, as their files may be
+	// re-included, and are only reported once such a file turns up
+	type ignoredDir struct{ relativePath, fullPath string }
+	ignoredDirs := []ignoredDir{}
+
+	_, err = walkWithIgnoreRules(dir, func(fullPath, fileRelativePath string, f os.FileInfo, match IgnoreMatch) error {
+		fileRelativeUnixPath := filepath.ToSlash(fileRelativePath)
+		for len(ignoredDirs) > 0 && !strings.HasPrefix(fileRelativeUnixPath, filepath.ToSlash(ignoredDirs[len(ignoredDirs)-1].relativePath)+"/") {
+			ignoredDirs = ignoredDirs[:len(ignoredDirs)-1]
+		}
+
+		if match.Ignored {
+			if f.IsDir() {
+				ignoredDirs = append(ignoredDirs, ignoredDir{fileRelativePath, fullPath})
+			}
+			return nil
+		}
+
+		for _, parent := range ignoredDirs {
+			err := onEachFile(parent.relativePath, parent.fullPath)
+			if err != nil {
+				return err
+			}
+		}
+		ignoredDirs = ignoredDirs[:0]
+
+		return onEachFile(fileRelativePath, fullPath)
+	})
+	return
+}
+
+// CheckIgnore reports whether path, relative to the app directory dir, would
+// be left out of an upload, and which .cfignore rule decided it.
+func (appfiles ApplicationFiles) CheckIgnore(dir string, path string) (match IgnoreMatch, err error) {
+	dir, err = filepath.Abs(dir)
+	if err != nil {
+		return
+	}
+
+	if filepath.IsAbs(path) {
+		path, err = filepath.Rel(dir, path)
 		if err != nil {
 			return
 		}
+	}
+
+	unixPath := filepath.ToSlash(filepath.Clean(path))
+	if unixPath == "." || unixPath == ".." || strings.HasPrefix(unixPath, "../") {
+		err = errors.NewWithFmt(T("{{.Path}} is not inside the app directory {{.Dir}}",
+			map[string]interface{}{"Path": path, "Dir": dir}))
+		return
+	}
+
+	// walk down to path the same way an upload does, so that the same
+	// nested .cfignore files are read
+	found := false
+	cfIgnore, err := walkWithIgnoreRules(dir, func(_, fileRelativePath string, f os.FileInfo, fileMatch IgnoreMatch) error {
+		fileRelativeUnixPath := filepath.ToSlash(fileRelativePath)
+		if fileRelativeUnixPath == unixPath {
+			match = fileMatch
+			found = true
+			return errStopWalk
+		}
+		if f.IsDir() && !strings.HasPrefix(unixPath, fileRelativeUnixPath+"/") {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	if err != nil || found {
+		return
+	}
+
+	match = cfIgnore.match(unixPath)
+	return
+}
+
+var errStopWalk = errors.New("stop walking")
+
+// walkWithIgnoreRules visits every file and directory under dir with the
+// .cfignore rule that decides it. The .cfignore file of a directory is read
+// on the way in, unless the directory itself is ignored.
+func walkWithIgnoreRules(dir string, visit func(fullPath, relativePath string, f os.FileInfo, match IgnoreMatch) error) (*cfIgnore, error) {
+	cfIgnore := loadIgnoreFile(dir)
+
+	err := filepath.Walk(dir, func(fullPath string, f os.FileInfo, inErr error) error {
+		if inErr != nil {
+			return inErr
+		}
 
 		if fullPath == dir {
-			return
+			return nil
 		}
 
 		if !cffileutils.IsRegular(f) && !f.IsDir() {
-			return
+			return nil
 		}
 
 		fileRelativePath, _ := filepath.Rel(dir, fullPath)
 		fileRelativeUnixPath := filepath.ToSlash(fileRelativePath)
 
-		if !cfIgnore.FileShouldBeIgnored(fileRelativeUnixPath) {
-			err = onEachFile(fileRelativePath, fullPath)
+		var match IgnoreMatch
+		if f.IsDir() {
+			match = cfIgnore.match(fileRelativeUnixPath + "/")
+			if !match.Ignored {
+				cfIgnore.loadNestedIgnoreFile(fullPath, fileRelativeUnixPath)
+			}
+		} else {
+			match = cfIgnore.match(fileRelativeUnixPath)
 		}
 
-		return
+		return visit(fullPath, fileRelativePath, f, match)
+	})
+	if err == errStopWalk {
+		err = nil
 	}
-
-	err = filepath.Walk(dir, walkFunc)
-	return
+	return cfIgnore, err
 }
 
 func copyPathToPath(fromPath, toPath string) (err error) {
@@ -137,11 +230,18 @@ func copyPathToPath(fromPath, toPath string) (err error) {
 	return err
 }
 
-func loadIgnoreFile(dir string) CfIgnore {
+func loadIgnoreFile(dir string) *cfIgnore {
+	cfIgnore := newCfIgnore()
 	fileContents, err := ioutil.ReadFile(filepath.Join(dir, ".cfignore"))
 	if err == nil {
-		return NewCfIgnore(string(fileContents))
-	} else {
-		return NewCfIgnore("")
+		cfIgnore.addIgnoreFile("", string(fileContents))
+	}
+	return cfIgnore
+}
+
+func (ignore *cfIgnore) loadNestedIgnoreFile(fullPath string, relativeUnixPath string) {
+	fileContents, err := ioutil.ReadFile(filepath.Join(fullPath, ".cfignore"))
+	if err == nil {
+		ignore.addIgnoreFile(relativeUnixPath, string(fileContents))
 	}
 }
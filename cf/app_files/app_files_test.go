@@ -43,10 +43,27 @@ var _ = Describe("AppFiles", func() {
 				"dir1/child-dir/file3.txt",
 				"dir1/file1.txt",
 				"dir2",
+			}))
+		})
+
+		It("applies .cfignore files in subdirectories to their own directory", func() {
+			appPath := filepath.Join(fixturePath, "app-with-nested-cfignore")
+			files, err := appFiles.AppFilesInDir(appPath)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			paths := []string{}
+			for _, file := range files {
+				paths = append(paths, file.Path)
+			}
 
-				// TODO: this should be excluded.
-				// .cfignore doesn't handle ** patterns right now
-				"dir2/child-dir2",
+			Expect(paths).To(Equal([]string{
+				"generated",
+				"generated/keep.txt",
+				"logs",
+				"logs/keep.txt",
+				"src",
+				"src/app.js",
+				"src/debug.log",
 			}))
 		})
 
@@ -73,6 +90,80 @@ var _ = Describe("AppFiles", func() {
 		})
 	})
 
+	Describe("CheckIgnore", func() {
+		appPath := filepath.Join(fixturePath, "app-with-nested-cfignore")
+
+		It("reports the rule that ignores a file", func() {
+			match, err := appFiles.CheckIgnore(appPath, "error.log")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(IgnoreMatch{Ignored: true, Source: ".cfignore", Line: 1, Pattern: "*.log"}))
+		})
+
+		It("reports the rule that ignores a parent directory", func() {
+			match, err := appFiles.CheckIgnore(appPath, filepath.Join("build", "out.txt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(IgnoreMatch{Ignored: true, Source: ".cfignore", Line: 2, Pattern: "build/"}))
+		})
+
+		It("uses the .cfignore files of the directories above the path", func() {
+			match, err := appFiles.CheckIgnore(appPath, filepath.Join("src", "debug.log"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(IgnoreMatch{Ignored: false, Source: "src/.cfignore", Line: 1, Pattern: "!debug.log"}))
+
+			match, err = appFiles.CheckIgnore(appPath, filepath.Join("src", "generated", "file.txt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(IgnoreMatch{Ignored: true, Source: "src/.cfignore", Line: 2, Pattern: "generated"}))
+		})
+
+		It("does not let a re-included directory bring back files a pattern excluded", func() {
+			match, err := appFiles.CheckIgnore(appPath, filepath.Join("logs", "a.log"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(IgnoreMatch{Ignored: true, Source: ".cfignore", Line: 1, Pattern: "*.log"}))
+		})
+
+		It("agrees with the files that are uploaded", func() {
+			uploaded := map[string]bool{}
+			err := appFiles.WalkAppFiles(appPath, func(relativePath, _ string) error {
+				uploaded[filepath.ToSlash(relativePath)] = true
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			checked := 0
+			err = filepath.Walk(appPath, func(fullPath string, info os.FileInfo, err error) error {
+				if err != nil || info.IsDir() {
+					return err
+				}
+
+				relativePath, _ := filepath.Rel(appPath, fullPath)
+				match, err := appFiles.CheckIgnore(appPath, relativePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(match.Ignored).To(Equal(!uploaded[filepath.ToSlash(relativePath)]), relativePath)
+				checked++
+				return nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checked).To(BeNumerically(">", 5))
+		})
+
+		It("does not read .cfignore files inside ignored directories", func() {
+			match, err := appFiles.CheckIgnore(appPath, filepath.Join("build", "out.txt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match.Ignored).To(BeTrue())
+		})
+
+		It("reports files that no rule matches", func() {
+			match, err := appFiles.CheckIgnore(appPath, filepath.Join("generated", "keep.txt"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(match).To(Equal(IgnoreMatch{}))
+		})
+
+		It("returns an error for paths outside the app directory", func() {
+			_, err := appFiles.CheckIgnore(appPath, filepath.Join("..", "example-app"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("CopyFiles", func() {
 		It("copies only the files specified", func() {
 			copyDir := filepath.Join(fixturePath, "app-copy-test")
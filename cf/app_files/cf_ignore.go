@@ -1,77 +1,258 @@
 package app_files
 
 import (
-	"path"
+	"regexp"
 	"strings"
-
-	"github.com/cloudfoundry/cli/glob"
 )
 
 type CfIgnore interface {
 	FileShouldBeIgnored(path string) bool
 }
 
+// IgnoreMatch describes the rule that decided whether a path is ignored.
+// Source is the .cfignore file the rule was read from, relative to the app
+// directory, and is empty for the built-in defaults.
+type IgnoreMatch struct {
+	Ignored bool
+	Source  string
+	Line    int
+	Pattern string
+}
+
 func NewCfIgnore(text string) CfIgnore {
-	patterns := []ignorePattern{}
-	lines := strings.Split(text, "\n")
-	lines = append(defaultIgnoreLines, lines...)
-
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line == "" {
-			continue
-		}
+	ignore := newCfIgnore()
+	ignore.addIgnoreFile("", text)
+	return ignore
+}
 
-		ignore := true
-		if strings.HasPrefix(line, "!") {
-			line = line[1:]
-			ignore = false
-		}
+func newCfIgnore() *cfIgnore {
+	ignore := &cfIgnore{}
+	for index, line := range defaultIgnoreLines {
+		ignore.addLine("", "", index+1, line)
+	}
+	return ignore
+}
 
-		for _, p := range globsForPattern(path.Clean(line)) {
-			patterns = append(patterns, ignorePattern{ignore, p})
-		}
+// addIgnoreFile adds the patterns of the .cfignore file in dir, a slash
+// separated path relative to the app directory. Files must be added parents
+// first, so that patterns in deeper files take precedence.
+func (ignore *cfIgnore) addIgnoreFile(dir string, text string) {
+	source := ".cfignore"
+	if dir != "" {
+		source = dir + "/.cfignore"
 	}
 
-	return cfIgnore(patterns)
+	for index, line := range strings.Split(text, "\n") {
+		ignore.addLine(dir, source, index+1, line)
+	}
 }
 
-func (ignore cfIgnore) FileShouldBeIgnored(path string) bool {
-	result := false
+func (ignore *cfIgnore) addLine(dir, source string, lineNumber int, line string) {
+	rule, ok := parseIgnoreLine(line)
+	if !ok {
+		return
+	}
+
+	rule.baseDir = dir
+	rule.source = source
+	rule.line = lineNumber
+	ignore.rules = append(ignore.rules, rule)
+}
+
+// FileShouldBeIgnored reports whether the slash separated path, relative to
+// the app directory, is excluded. Directories may be marked with a trailing
+// slash so that directory-only patterns apply to them.
+func (ignore *cfIgnore) FileShouldBeIgnored(path string) bool {
+	return ignore.match(path).Ignored
+}
 
-	for _, pattern := range ignore {
-		if strings.HasPrefix(pattern.glob.String(), "/") && !strings.HasPrefix(path, "/") {
-			path = "/" + path
+// match decides path by the last rule that matches it. Only when no rule
+// matches path itself do the rules for its parent directories apply, nearest
+// first, so excluding a directory excludes everything inside it, a negated
+// pattern can re-include a file there, and re-including a directory does not
+// bring back files that a pattern of their own excluded.
+func (ignore *cfIgnore) match(path string) IgnoreMatch {
+	isDir := strings.HasSuffix(path, "/")
+	components := strings.Split(strings.Trim(path, "/"), "/")
+
+	for j := len(components) - 1; j >= 0; j-- {
+		prefix := strings.Join(components[:j+1], "/")
+		rule := ignore.lastMatchingRule(prefix, isDir || j < len(components)-1)
+		if rule != nil {
+			return IgnoreMatch{
+				Ignored: rule.exclude,
+				Source:  rule.source,
+				Line:    rule.line,
+				Pattern: rule.pattern,
+			}
 		}
+	}
+
+	return IgnoreMatch{}
+}
+
+func (ignore *cfIgnore) lastMatchingRule(path string, isDir bool) *ignoreRule {
+	for i := len(ignore.rules) - 1; i >= 0; i-- {
+		if ignore.rules[i].matches(path, isDir) {
+			return &ignore.rules[i]
+		}
+	}
+	return nil
+}
+
+func (rule *ignoreRule) matches(path string, isDir bool) bool {
+	if rule.dirOnly && !isDir {
+		return false
+	}
 
-		if pattern.glob.Match(path) {
-			result = pattern.exclude
+	relativePath := path
+	if rule.baseDir != "" {
+		if !strings.HasPrefix(path, rule.baseDir+"/") {
+			return false
 		}
+		relativePath = path[len(rule.baseDir)+1:]
 	}
 
-	return result
+	return rule.regexp.MatchString(relativePath)
+}
+
+type ignoreRule struct {
+	exclude bool
+	dirOnly bool
+	baseDir string
+	source  string
+	line    int
+	pattern string
+	regexp  *regexp.Regexp
+}
+
+type cfIgnore struct {
+	rules []ignoreRule
 }
 
-func globsForPattern(pattern string) (globs []glob.Glob) {
-	globs = append(globs, glob.MustCompileGlob(pattern))
-	globs = append(globs, glob.MustCompileGlob(path.Join(pattern, "*")))
-	globs = append(globs, glob.MustCompileGlob(path.Join(pattern, "**", "*")))
+func parseIgnoreLine(line string) (rule ignoreRule, ok bool) {
+	line = trimUnescapedTrailingSpaces(strings.TrimRight(line, "\r"))
+	if line == "" || strings.HasPrefix(line, "#") {
+		return
+	}
+
+	rule.pattern = line
+	rule.exclude = true
+	if strings.HasPrefix(line, "!") {
+		rule.exclude = false
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(strings.TrimPrefix(line, "./"), "/")
+	if line == "" {
+		return
+	}
 
-	if !strings.HasPrefix(pattern, "/") {
-		globs = append(globs, glob.MustCompileGlob(path.Join("**", pattern)))
-		globs = append(globs, glob.MustCompileGlob(path.Join("**", pattern, "*")))
-		globs = append(globs, glob.MustCompileGlob(path.Join("**", pattern, "**", "*")))
+	expression := translateIgnorePattern(line)
+	if !anchored {
+		expression = "(?:.*/)?" + expression
 	}
 
+	rule.regexp, ok = compileIgnoreExpression(expression)
 	return
 }
 
-type ignorePattern struct {
-	exclude bool
-	glob    glob.Glob
+func compileIgnoreExpression(expression string) (*regexp.Regexp, bool) {
+	compiled, err := regexp.Compile("^" + expression + "$")
+	return compiled, err == nil
 }
 
-type cfIgnore []ignorePattern
+func trimUnescapedTrailingSpaces(line string) string {
+	end := len(line)
+	for end > 0 && line[end-1] == ' ' {
+		if end > 1 && line[end-2] == '\\' {
+			break
+		}
+		end--
+	}
+	return line[:end]
+}
+
+// translateIgnorePattern converts a gitignore pattern into a regular
+// expression. `*` and `?` do not match `/`, while `**` matches any number of
+// directories when it appears as a whole path component.
+func translateIgnorePattern(pattern string) string {
+	var expression []string
+
+	for i := 0; i < len(pattern); i++ {
+		rest := pattern[i:]
+		atComponentStart := i == 0 || pattern[i-1] == '/'
+
+		switch {
+		case atComponentStart && strings.HasPrefix(rest, "**/"):
+			expression = append(expression, "(?:.*/)?")
+			i += 2
+		case atComponentStart && rest == "**":
+			expression = append(expression, ".*")
+			i++
+		case rest[0] == '*':
+			expression = append(expression, "[^/]*")
+			for i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+			}
+		case rest[0] == '?':
+			expression = append(expression, "[^/]")
+		case rest[0] == '\\' && len(rest) > 1:
+			expression = append(expression, regexp.QuoteMeta(rest[1:2]))
+			i++
+		case rest[0] == '[':
+			class, length := translateCharacterClass(rest)
+			if length == 0 {
+				expression = append(expression, regexp.QuoteMeta("["))
+				continue
+			}
+			expression = append(expression, class)
+			i += length - 1
+		default:
+			expression = append(expression, regexp.QuoteMeta(rest[0:1]))
+		}
+	}
+
+	return strings.Join(expression, "")
+}
+
+// translateCharacterClass converts a bracket expression at the start of
+// pattern and returns it with the number of bytes it spans, or a length of
+// zero when the bracket is not closed.
+func translateCharacterClass(pattern string) (string, int) {
+	end := 1
+	if end < len(pattern) && (pattern[end] == '!' || pattern[end] == '^') {
+		end++
+	}
+	if end < len(pattern) && pattern[end] == ']' {
+		end++
+	}
+	for end < len(pattern) && pattern[end] != ']' {
+		end++
+	}
+	if end >= len(pattern) {
+		return "", 0
+	}
+
+	contents := pattern[1:end]
+	negated := strings.HasPrefix(contents, "!") || strings.HasPrefix(contents, "^")
+	if negated {
+		contents = contents[1:]
+	}
+	contents = strings.Replace(contents, `\`, `\\`, -1)
+	contents = strings.Replace(contents, "[", `\[`, -1)
+
+	if negated {
+		return "[^" + contents + "/]", end + 1
+	}
+	return "[" + contents + "]", end + 1
+}
 
 var defaultIgnoreLines = []string{
 	".cfignore",
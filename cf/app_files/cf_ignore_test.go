@@ -59,6 +59,84 @@ stuff/exclude.c`)
 		Expect(ignore.FileShouldBeIgnored(".git/objects")).To(BeFalse())
 	})
 
+	It("matches patterns without a slash at any depth", func() {
+		ignore := NewCfIgnore(`*.log`)
+		Expect(ignore.FileShouldBeIgnored("debug.log")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("logs/today/debug.log")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("debug.log.txt")).To(BeFalse())
+	})
+
+	It("anchors patterns with a slash to the top of the app", func() {
+		ignore := NewCfIgnore(`config/local.yml`)
+		Expect(ignore.FileShouldBeIgnored("config/local.yml")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("vendor/config/local.yml")).To(BeFalse())
+	})
+
+	It("only applies patterns with a trailing slash to directories", func() {
+		ignore := NewCfIgnore(`build/`)
+		Expect(ignore.FileShouldBeIgnored("build/")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("build/output.jar")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("src/build/output.jar")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("build")).To(BeFalse())
+	})
+
+	It("matches leading **/ in any directory and trailing /** inside a directory", func() {
+		ignore := NewCfIgnore(`
+**/tmp/cache
+logs/**
+`)
+		Expect(ignore.FileShouldBeIgnored("tmp/cache")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("app/tmp/cache/file")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("logs/2015/01/app.log")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("logs")).To(BeFalse())
+	})
+
+	It("skips comments and supports escaped # and !", func() {
+		ignore := NewCfIgnore(`
+# a comment
+\#notes.txt
+\!important.txt
+`)
+		Expect(ignore.FileShouldBeIgnored("# a comment")).To(BeFalse())
+		Expect(ignore.FileShouldBeIgnored("#notes.txt")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("!important.txt")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("important.txt")).To(BeFalse())
+	})
+
+	It("ignores trailing spaces unless they are escaped", func() {
+		ignore := NewCfIgnore("trailing.txt   \nspace\\ ")
+		Expect(ignore.FileShouldBeIgnored("trailing.txt")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("space ")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("space")).To(BeFalse())
+	})
+
+	It("supports character classes", func() {
+		ignore := NewCfIgnore(`*.py[co]`)
+		Expect(ignore.FileShouldBeIgnored("app.pyc")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("app.pyo")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("app.py")).To(BeFalse())
+	})
+
+	It("re-includes files inside an excluded directory", func() {
+		ignore := NewCfIgnore(`
+vendor/
+!vendor/keep.txt
+`)
+		Expect(ignore.FileShouldBeIgnored("vendor/")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("vendor/other.txt")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("vendor/keep.txt")).To(BeFalse())
+	})
+
+	It("does not re-include excluded files through a negated directory pattern", func() {
+		ignore := NewCfIgnore(`
+*.log
+!logs/
+`)
+		Expect(ignore.FileShouldBeIgnored("logs/")).To(BeFalse())
+		Expect(ignore.FileShouldBeIgnored("logs/a.log")).To(BeTrue())
+		Expect(ignore.FileShouldBeIgnored("logs/a.txt")).To(BeFalse())
+	})
+
 	Describe("files named manifest.yml", func() {
 		var (
 			ignore CfIgnore
@@ -45,6 +45,16 @@ type FakeAppFiles struct {
 	walkAppFilesReturns struct {
 		result1 error
 	}
+	CheckIgnoreStub        func(dir string, path string) (match IgnoreMatch, err error)
+	checkIgnoreMutex       sync.RWMutex
+	checkIgnoreArgsForCall []struct {
+		dir  string
+		path string
+	}
+	checkIgnoreReturns struct {
+		result1 IgnoreMatch
+		result2 error
+	}
 }
 
 func (fake *FakeAppFiles) AppFilesInDir(dir string) (appFiles []models.AppFileFields, err error) {
@@ -175,4 +185,37 @@ func (fake *FakeAppFiles) WalkAppFilesReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeAppFiles) CheckIgnore(dir string, path string) (match IgnoreMatch, err error) {
+	fake.checkIgnoreMutex.Lock()
+	defer fake.checkIgnoreMutex.Unlock()
+	fake.checkIgnoreArgsForCall = append(fake.checkIgnoreArgsForCall, struct {
+		dir  string
+		path string
+	}{dir, path})
+	if fake.CheckIgnoreStub != nil {
+		return fake.CheckIgnoreStub(dir, path)
+	} else {
+		return fake.checkIgnoreReturns.result1, fake.checkIgnoreReturns.result2
+	}
+}
+
+func (fake *FakeAppFiles) CheckIgnoreCallCount() int {
+	fake.checkIgnoreMutex.RLock()
+	defer fake.checkIgnoreMutex.RUnlock()
+	return len(fake.checkIgnoreArgsForCall)
+}
+
+func (fake *FakeAppFiles) CheckIgnoreArgsForCall(i int) (string, string) {
+	fake.checkIgnoreMutex.RLock()
+	defer fake.checkIgnoreMutex.RUnlock()
+	return fake.checkIgnoreArgsForCall[i].dir, fake.checkIgnoreArgsForCall[i].path
+}
+
+func (fake *FakeAppFiles) CheckIgnoreReturns(result1 IgnoreMatch, result2 error) {
+	fake.checkIgnoreReturns = struct {
+		result1 IgnoreMatch
+		result2 error
+	}{result1, result2}
+}
+
 var _ AppFiles = new(FakeAppFiles)
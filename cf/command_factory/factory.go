@@ -9,6 +9,7 @@ import (
 
 	"github.com/cloudfoundry/cli/cf/actors"
 	"github.com/cloudfoundry/cli/cf/actors/broker_builder"
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
 	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/app_files"
 	"github.com/cloudfoundry/cli/cf/command"
@@ -28,6 +29,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/commands/serviceaccess"
 	"github.com/cloudfoundry/cli/cf/commands/serviceauthtoken"
 	"github.com/cloudfoundry/cli/cf/commands/servicebroker"
+	"github.com/cloudfoundry/cli/cf/commands/servicekey"
 	"github.com/cloudfoundry/cli/cf/commands/space"
 	"github.com/cloudfoundry/cli/cf/commands/spacequota"
 	"github.com/cloudfoundry/cli/cf/commands/user"
@@ -72,11 +74,13 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 	factory.cmdsByName["auth"] = commands.NewAuthenticate(ui, config, repoLocator.GetAuthenticationRepository())
 	factory.cmdsByName["buildpacks"] = buildpack.NewListBuildpacks(ui, repoLocator.GetBuildpackRepository())
 	factory.cmdsByName["config"] = commands.NewConfig(ui, config)
-	factory.cmdsByName["create-app-manifest"] = commands.NewCreateAppManifest(ui, config, repoLocator.GetAppSummaryRepository(), manifest.NewGenerator())
+	factory.cmdsByName["create-app-manifest"] = commands.NewCreateAppManifest(ui, config, repoLocator.GetAppSummaryRepository(), repoLocator.GetStackRepository(), manifest.NewGenerator())
+	factory.cmdsByName["validate-manifest"] = commands.NewValidateManifest(ui, manifestRepo)
 	factory.cmdsByName["create-buildpack"] = buildpack.NewCreateBuildpack(ui, repoLocator.GetBuildpackRepository(), repoLocator.GetBuildpackBitsRepository())
 	factory.cmdsByName["create-domain"] = domain.NewCreateDomain(ui, config, repoLocator.GetDomainRepository())
 	factory.cmdsByName["create-org"] = organization.NewCreateOrg(ui, config, repoLocator.GetOrganizationRepository(), repoLocator.GetQuotaRepository())
-	factory.cmdsByName["create-service"] = service.NewCreateService(ui, config, repoLocator.GetServiceRepository(), serviceBuilder)
+	createService := service.NewCreateService(ui, config, repoLocator.GetServiceRepository(), serviceBuilder)
+	factory.cmdsByName["create-service"] = createService
 
 	factory.cmdsByName["update-service"] = service.NewUpdateService(
 		ui,
@@ -90,6 +94,7 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 	)
 
 	factory.cmdsByName["create-service-auth-token"] = serviceauthtoken.NewCreateServiceAuthToken(ui, config, repoLocator.GetServiceAuthTokenRepository())
+	factory.cmdsByName["check-broker"] = servicebroker.NewCheckBroker(ui, config, repoLocator.GetServiceBrokerClient())
 	factory.cmdsByName["create-service-broker"] = servicebroker.NewCreateServiceBroker(ui, config, repoLocator.GetServiceBrokerRepository())
 	factory.cmdsByName["create-user"] = user.NewCreateUser(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["create-user-provided-service"] = service.NewCreateUserProvidedService(ui, config, repoLocator.GetUserProvidedServiceInstanceRepository())
@@ -97,10 +102,14 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 	factory.cmdsByName["delete"] = application.NewDeleteApp(ui, config, repoLocator.GetApplicationRepository(), repoLocator.GetRouteRepository())
 	factory.cmdsByName["delete-buildpack"] = buildpack.NewDeleteBuildpack(ui, repoLocator.GetBuildpackRepository())
 	factory.cmdsByName["delete-domain"] = domain.NewDeleteDomain(ui, config, repoLocator.GetDomainRepository())
+	factory.cmdsByName["share-private-domain"] = domain.NewSharePrivateDomain(ui, config, repoLocator.GetDomainRepository())
+	factory.cmdsByName["unshare-private-domain"] = domain.NewUnsharePrivateDomain(ui, config, repoLocator.GetDomainRepository())
 	factory.cmdsByName["delete-shared-domain"] = domain.NewDeleteSharedDomain(ui, config, repoLocator.GetDomainRepository())
 	factory.cmdsByName["delete-org"] = organization.NewDeleteOrg(ui, config, repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["delete-orphaned-routes"] = route.NewDeleteOrphanedRoutes(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["delete-route"] = route.NewDeleteRoute(ui, config, repoLocator.GetRouteRepository())
+	factory.cmdsByName["route-owner"] = route.NewRouteOwner(ui, config, repoLocator.GetRouteRepository(), repoLocator.GetSpaceRepository())
+	factory.cmdsByName["transfer-route"] = route.NewTransferRoute(ui, config, repoLocator.GetRouteRepository(), repoLocator.GetSpaceRepository())
 	factory.cmdsByName["delete-service"] = service.NewDeleteService(ui, config, repoLocator.GetServiceRepository())
 	factory.cmdsByName["delete-service-auth-token"] = serviceauthtoken.NewDeleteServiceAuthToken(ui, config, repoLocator.GetServiceAuthTokenRepository())
 	factory.cmdsByName["delete-service-broker"] = servicebroker.NewDeleteServiceBroker(ui, config, repoLocator.GetServiceBrokerRepository())
@@ -114,7 +123,7 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 	factory.cmdsByName["logout"] = commands.NewLogout(ui, config)
 	factory.cmdsByName["logs"] = application.NewLogs(ui, config, repoLocator.GetLogsRepository())
 	factory.cmdsByName["oauth-token"] = commands.NewOAuthToken(ui, config, repoLocator.GetAuthenticationRepository())
-	factory.cmdsByName["org"] = organization.NewShowOrg(ui, config)
+	factory.cmdsByName["org"] = organization.NewShowOrg(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["org-users"] = user.NewOrgUsers(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["orgs"] = organization.NewListOrgs(ui, config, repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["passwd"] = commands.NewPassword(ui, repoLocator.GetPasswordRepository(), config)
@@ -132,21 +141,26 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 	factory.cmdsByName["rename-space"] = space.NewRenameSpace(ui, config, repoLocator.GetSpaceRepository())
 	factory.cmdsByName["routes"] = route.NewListRoutes(ui, config, repoLocator.GetRouteRepository())
 	factory.cmdsByName["check-route"] = route.NewCheckRoute(ui, config, repoLocator.GetRouteRepository(), repoLocator.GetDomainRepository())
-	factory.cmdsByName["service"] = service.NewShowService(ui)
+	factory.cmdsByName["service"] = service.NewShowService(ui, repoLocator.GetServiceSummaryRepository())
 	factory.cmdsByName["service-auth-tokens"] = serviceauthtoken.NewListServiceAuthTokens(ui, config, repoLocator.GetServiceAuthTokenRepository())
-	factory.cmdsByName["service-brokers"] = servicebroker.NewListServiceBrokers(ui, config, repoLocator.GetServiceBrokerRepository())
+	factory.cmdsByName["service-brokers"] = servicebroker.NewListServiceBrokers(ui, config, repoLocator.GetServiceBrokerRepository(), repoLocator.GetSpaceRepository())
 	factory.cmdsByName["services"] = service.NewListServices(ui, config, repoLocator.GetServiceSummaryRepository())
 	factory.cmdsByName["migrate-service-instances"] = service.NewMigrateServiceInstances(ui, config, repoLocator.GetServiceRepository())
 	factory.cmdsByName["set-env"] = application.NewSetEnv(ui, config, repoLocator.GetApplicationRepository())
 	factory.cmdsByName["set-org-role"] = user.NewSetOrgRole(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["set-quota"] = organization.NewSetQuota(ui, config, repoLocator.GetQuotaRepository())
 	factory.cmdsByName["create-shared-domain"] = domain.NewCreateSharedDomain(ui, config, repoLocator.GetDomainRepository())
-	factory.cmdsByName["space"] = space.NewShowSpace(ui, config, repoLocator.GetSpaceQuotaRepository())
+	factory.cmdsByName["space"] = space.NewShowSpace(ui, config, repoLocator.GetSpaceQuotaRepository(), repoLocator.GetAppSummaryRepository(), repoLocator.GetUserRepository(), repoLocator.GetSpaceUsageRepository())
 	factory.cmdsByName["space-users"] = user.NewSpaceUsers(ui, config, repoLocator.GetSpaceRepository(), repoLocator.GetUserRepository())
 	factory.cmdsByName["spaces"] = space.NewListSpaces(ui, config, repoLocator.GetSpaceRepository())
 	factory.cmdsByName["stacks"] = commands.NewListStacks(ui, config, repoLocator.GetStackRepository())
 	factory.cmdsByName["target"] = commands.NewTarget(ui, config, repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceRepository())
 	factory.cmdsByName["unbind-service"] = service.NewUnbindService(ui, config, repoLocator.GetServiceBindingRepository())
+	factory.cmdsByName["create-service-key"] = servicekey.NewCreateServiceKey(ui, config, repoLocator.GetServiceKeyRepository())
+	factory.cmdsByName["service-keys"] = servicekey.NewListServiceKeys(ui, config, repoLocator.GetServiceKeyRepository())
+	factory.cmdsByName["service-key"] = servicekey.NewGetServiceKey(ui, config, repoLocator.GetServiceKeyRepository())
+	factory.cmdsByName["delete-service-key"] = servicekey.NewDeleteServiceKey(ui, config, repoLocator.GetServiceKeyRepository())
+	factory.cmdsByName["usage"] = organization.NewShowUsage(ui, config, repoLocator.GetSpaceUsageRepository(), repoLocator.GetSpaceQuotaRepository())
 	factory.cmdsByName["unset-env"] = application.NewUnsetEnv(ui, config, repoLocator.GetApplicationRepository())
 	factory.cmdsByName["unset-org-role"] = user.NewUnsetOrgRole(ui, config, repoLocator.GetUserRepository())
 	factory.cmdsByName["unset-space-role"] = user.NewUnsetSpaceRole(ui, config, repoLocator.GetSpaceRepository(), repoLocator.GetUserRepository())
@@ -195,17 +209,18 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 	)
 	factory.cmdsByName["unbind-security-group"] = securitygroup.NewUnbindSecurityGroup(ui, config, repoLocator.GetSecurityGroupRepository(), repoLocator.GetOrganizationRepository(), repoLocator.GetSpaceRepository(), repoLocator.GetSecurityGroupSpaceBinder())
 
-	createRoute := route.NewCreateRoute(ui, config, repoLocator.GetRouteRepository())
+	createRoute := route.NewCreateRoute(ui, config, repoLocator.GetRouteRepository(), repoLocator.GetSpaceRepository(), repoLocator.GetDomainRepository())
 	factory.cmdsByName["create-route"] = createRoute
-	factory.cmdsByName["map-route"] = route.NewMapRoute(ui, config, repoLocator.GetRouteRepository(), createRoute)
+	factory.cmdsByName["map-route"] = route.NewMapRoute(ui, config, repoLocator.GetRouteRepository(), createRoute, repoLocator.GetAppSummaryRepository(), repoLocator.GetDomainRepository())
 	factory.cmdsByName["unmap-route"] = route.NewUnmapRoute(ui, config, repoLocator.GetRouteRepository())
+	factory.cmdsByName["move-routes"] = route.NewMoveRoutes(ui, config, repoLocator.GetRouteRepository(), repoLocator.GetApplicationRepository())
 
 	displayApp := application.NewShowApp(ui, config, repoLocator.GetAppSummaryRepository(), repoLocator.GetAppInstancesRepository())
 	start := application.NewStart(ui, config, displayApp, repoLocator.GetApplicationRepository(), repoLocator.GetAppInstancesRepository(), repoLocator.GetLogsRepository())
 	stop := application.NewStop(ui, config, repoLocator.GetApplicationRepository())
 	restart := application.NewRestart(ui, config, start, stop)
 	restage := application.NewRestage(ui, config, repoLocator.GetApplicationRepository(), start)
-	bind := service.NewBindService(ui, config, repoLocator.GetServiceBindingRepository())
+	bind := service.NewBindService(ui, config, repoLocator.GetServiceBindingRepository(), repoLocator.GetApplicationRepository(), repoLocator.GetServiceRepository(), repoLocator.GetAppSummaryRepository(), repoLocator.GetServiceSummaryRepository())
 
 	factory.cmdsByName["app"] = displayApp
 	factory.cmdsByName["bind-service"] = bind
@@ -214,8 +229,9 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 	factory.cmdsByName["restart"] = restart
 	factory.cmdsByName["restart-app-instance"] = application.NewRestartAppInstance(ui, config, repoLocator.GetAppInstancesRepository())
 	factory.cmdsByName["restage"] = restage
+	factory.cmdsByName["rebind-service"] = service.NewRebindService(ui, config, repoLocator.GetServiceBindingRepository(), repoLocator.GetApplicationRepository(), restage)
 	factory.cmdsByName["push"] = application.NewPush(
-		ui, config, manifestRepo, start, stop, bind,
+		ui, config, manifestRepo, start, stop, bind, createService,
 		repoLocator.GetApplicationRepository(),
 		repoLocator.GetDomainRepository(),
 		repoLocator.GetRouteRepository(),
@@ -227,6 +243,7 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 		app_files.ApplicationZipper{},
 		app_files.ApplicationFiles{})
 
+	factory.cmdsByName["check-ignore"] = application.NewCheckIgnore(ui, app_files.ApplicationFiles{})
 	factory.cmdsByName["scale"] = application.NewScale(ui, config, restart, repoLocator.GetApplicationRepository())
 
 	spaceRoleSetter := user.NewSetSpaceRole(ui, config, repoLocator.GetSpaceRepository(), repoLocator.GetUserRepository())
@@ -265,7 +282,68 @@ func NewFactory(ui terminal.UI, config core_config.ReadWriter, manifestRepo mani
 		repoLocator.GetAuthenticationRepository(),
 	)
 
-	factory.cmdsByName["marketplace"] = service.NewMarketplaceServices(ui, config, serviceBuilder)
+	factory.cmdsByName["apply-service-access"] = serviceaccess.NewApplyServiceAccess(
+		ui, config,
+		actors.NewServiceHandler(
+			repoLocator.GetOrganizationRepository(),
+			brokerBuilder,
+			serviceBuilder,
+		),
+		actors.NewServicePlanHandler(
+			repoLocator.GetServicePlanRepository(),
+			repoLocator.GetServicePlanVisibilityRepository(),
+			repoLocator.GetOrganizationRepository(),
+			planBuilder,
+			serviceBuilder,
+		),
+		repoLocator.GetAuthenticationRepository(),
+	)
+	factory.cmdsByName["export-service-access"] = serviceaccess.NewExportServiceAccess(
+		ui, config,
+		actors.NewServiceHandler(
+			repoLocator.GetOrganizationRepository(),
+			brokerBuilder,
+			serviceBuilder,
+		),
+		repoLocator.GetAuthenticationRepository(),
+	)
+
+	orgConfigActor := org_config.NewActor(
+		repoLocator.GetOrganizationRepository(),
+		repoLocator.GetSpaceRepository(),
+		repoLocator.GetUserRepository(),
+		repoLocator.GetQuotaRepository(),
+		repoLocator.GetSecurityGroupRepository(),
+		repoLocator.GetSecurityGroupSpaceBinder(),
+	)
+	factory.cmdsByName["plan-config"] = organization.NewPlanConfig(ui, config, orgConfigActor)
+	factory.cmdsByName["apply-config"] = organization.NewApplyConfig(ui, config, orgConfigActor)
+	factory.cmdsByName["export-org"] = organization.NewExportOrg(
+		ui, config, orgConfigActor,
+		repoLocator.GetSpaceRepository(),
+		repoLocator.GetAppSummaryRepository(),
+		repoLocator.GetStackRepository(),
+		repoLocator.GetServiceSummaryRepository(),
+		repoLocator.GetUserProvidedServiceInstanceRepository(),
+		repoLocator.GetRouteRepository(),
+		repoLocator.GetApplicationBitsRepository(),
+	)
+	factory.cmdsByName["import-org"] = organization.NewImportOrg(
+		ui, config, orgConfigActor, manifestRepo,
+		repoLocator.GetOrganizationRepository(),
+		repoLocator.GetSpaceRepository(),
+		repoLocator.GetApplicationRepository(),
+		repoLocator.GetStackRepository(),
+		repoLocator.GetServiceRepository(),
+		repoLocator.GetServiceSummaryRepository(),
+		repoLocator.GetUserProvidedServiceInstanceRepository(),
+		repoLocator.GetServiceBindingRepository(),
+		repoLocator.GetDomainRepository(),
+		repoLocator.GetRouteRepository(),
+		repoLocator.GetApplicationBitsRepository(),
+	)
+
+	factory.cmdsByName["marketplace"] = service.NewMarketplaceServices(ui, config, serviceBuilder, repoLocator.GetServiceBrokerRepository())
 
 	factory.cmdsByName["create-space-quota"] = spacequota.NewCreateSpaceQuota(ui, config, repoLocator.GetSpaceQuotaRepository(), repoLocator.GetOrganizationRepository())
 	factory.cmdsByName["delete-space-quota"] = spacequota.NewDeleteSpaceQuota(ui, config, repoLocator.GetSpaceQuotaRepository())
@@ -31,6 +31,7 @@ var _ = Describe("factory", func() {
 		repoLocator := api.NewRepositoryLocator(config, map[string]net.Gateway{
 			"auth":             net.NewUAAGateway(config, fakeUI),
 			"cloud-controller": net.NewCloudControllerGateway(config, time.Now, fakeUI),
+			"service-broker":   net.NewServiceBrokerGateway(config, fakeUI),
 			"uaa":              net.NewUAAGateway(config, fakeUI),
 		})
 
@@ -74,6 +75,25 @@ var _ = Describe("factory", func() {
 
 		Expect(err).NotTo(HaveOccurred())
 	})
+	It("can be built when CF_SERVICE_OPERATION_TIMEOUT is invalid", func() {
+		os.Setenv("CF_SERVICE_OPERATION_TIMEOUT", "forever")
+		defer os.Unsetenv("CF_SERVICE_OPERATION_TIMEOUT")
+
+		fakeUI := &testterm.FakeUI{}
+		config := testconfig.NewRepository()
+		repoLocator := api.NewRepositoryLocator(config, map[string]net.Gateway{
+			"auth":             net.NewUAAGateway(config, fakeUI),
+			"cloud-controller": net.NewCloudControllerGateway(config, time.Now, fakeUI),
+			"service-broker":   net.NewServiceBrokerGateway(config, fakeUI),
+			"uaa":              net.NewUAAGateway(config, fakeUI),
+		})
+
+		Expect(func() {
+			NewFactory(fakeUI, config, manifest.NewManifestDiskRepository(), repoLocator, &testPluginConfig.FakePluginConfiguration{})
+		}).NotTo(Panic())
+		Expect(fakeUI.Outputs).NotTo(ContainElement(ContainSubstring("FAILED")))
+	})
+
 	Describe("GetByCmdName", func() {
 		It("returns the cmd if it exists", func() {
 			cmd, err := factory.GetByCmdName("push")
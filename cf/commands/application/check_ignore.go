@@ -0,0 +1,85 @@
+package application
+
+import (
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/app_files"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type CheckIgnore struct {
+	ui       terminal.UI
+	appFiles app_files.AppFiles
+}
+
+func NewCheckIgnore(ui terminal.UI, appFiles app_files.AppFiles) (cmd *CheckIgnore) {
+	cmd = new(CheckIgnore)
+	cmd.ui = ui
+	cmd.appFiles = appFiles
+	return
+}
+
+func (cmd *CheckIgnore) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "check-ignore",
+		Description: T("Show whether a file would be excluded from an upload by .cfignore"),
+		Usage:       T("CF_NAME check-ignore PATH [-p APP_PATH]"),
+		Flags: []cli.Flag{
+			flag_helpers.NewStringFlag("p", T("Path to app directory, defaults to the current directory")),
+		},
+	}
+}
+
+func (cmd *CheckIgnore) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		cmd.ui.FailWithUsage(c)
+	}
+	return
+}
+
+func (cmd *CheckIgnore) Run(c *cli.Context) {
+	path := c.Args()[0]
+
+	appDir := c.String("p")
+	if appDir == "" {
+		var err error
+		appDir, err = os.Getwd()
+		if err != nil {
+			cmd.ui.Failed(T("Could not determine the current working directory!"), err)
+		}
+	}
+
+	match, err := cmd.appFiles.CheckIgnore(appDir, path)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	switch {
+	case match.Ignored && match.Source == "":
+		cmd.ui.Say(T("{{.Path}} is ignored by default rule {{.Pattern}}",
+			map[string]interface{}{"Path": terminal.EntityNameColor(path), "Pattern": match.Pattern}))
+	case match.Ignored:
+		cmd.ui.Say(T("{{.Path}} is ignored by {{.Source}}:{{.Line}}: {{.Pattern}}",
+			map[string]interface{}{
+				"Path":    terminal.EntityNameColor(path),
+				"Source":  match.Source,
+				"Line":    match.Line,
+				"Pattern": match.Pattern,
+			}))
+	case match.Pattern != "":
+		cmd.ui.Say(T("{{.Path}} is not ignored, it is re-included by {{.Source}}:{{.Line}}: {{.Pattern}}",
+			map[string]interface{}{
+				"Path":    terminal.EntityNameColor(path),
+				"Source":  match.Source,
+				"Line":    match.Line,
+				"Pattern": match.Pattern,
+			}))
+	default:
+		cmd.ui.Say(T("{{.Path}} is not ignored", map[string]interface{}{"Path": terminal.EntityNameColor(path)}))
+	}
+}
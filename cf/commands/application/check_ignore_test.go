@@ -0,0 +1,91 @@
+package application_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/cli/cf/app_files"
+	fakeappfiles "github.com/cloudfoundry/cli/cf/app_files/fakes"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/application"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("check-ignore command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *testreq.FakeReqFactory
+		appFiles            *fakeappfiles.FakeAppFiles
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = &testreq.FakeReqFactory{}
+		appFiles = &fakeappfiles.FakeAppFiles{}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCommand(NewCheckIgnore(ui, appFiles), args, requirementsFactory)
+	}
+
+	It("fails with usage when not given exactly one path", func() {
+		runCommand()
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("checks the path against the app directory given with -p", func() {
+		runCommand("-p", "/my/app", "logs/debug.log")
+
+		Expect(appFiles.CheckIgnoreCallCount()).To(Equal(1))
+		dir, path := appFiles.CheckIgnoreArgsForCall(0)
+		Expect(dir).To(Equal("/my/app"))
+		Expect(path).To(Equal("logs/debug.log"))
+	})
+
+	It("shows the rule that ignores the path", func() {
+		appFiles.CheckIgnoreReturns(app_files.IgnoreMatch{Ignored: true, Source: "logs/.cfignore", Line: 3, Pattern: "*.log"}, nil)
+		runCommand("-p", "/my/app", "logs/debug.log")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"logs/debug.log", "is ignored by logs/.cfignore:3: *.log"},
+		))
+	})
+
+	It("shows when the path is ignored by a default rule", func() {
+		appFiles.CheckIgnoreReturns(app_files.IgnoreMatch{Ignored: true, Line: 4, Pattern: ".git"}, nil)
+		runCommand("-p", "/my/app", ".git")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{".git", "is ignored by default rule .git"},
+		))
+	})
+
+	It("shows the rule that re-includes the path", func() {
+		appFiles.CheckIgnoreReturns(app_files.IgnoreMatch{Source: ".cfignore", Line: 2, Pattern: "!keep.log"}, nil)
+		runCommand("-p", "/my/app", "keep.log")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"keep.log", "is not ignored, it is re-included by .cfignore:2: !keep.log"},
+		))
+	})
+
+	It("says when no rule matches the path", func() {
+		runCommand("-p", "/my/app", "app.js")
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"app.js", "is not ignored"}))
+	})
+
+	It("fails when the path cannot be checked", func() {
+		appFiles.CheckIgnoreReturns(app_files.IgnoreMatch{}, errors.New("not inside the app directory"))
+		runCommand("-p", "/my/app", "../other")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"not inside the app directory"},
+		))
+	})
+})
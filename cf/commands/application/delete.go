@@ -9,6 +9,7 @@ import (
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/ui_helpers"
 	"github.com/codegangsta/cli"
 )
 
@@ -54,7 +55,11 @@ func (cmd *DeleteApp) GetRequirements(requirementsFactory requirements.Factory,
 func (cmd *DeleteApp) Run(c *cli.Context) {
 	appName := c.Args()[0]
 
-	if !c.Bool("f") {
+	if core_config.IsProtectedSpace(cmd.config.ProtectedTargets(), cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name) {
+		if !ui_helpers.ConfirmProtectedDelete(cmd.ui, T("app"), appName, c.Bool("f")) {
+			return
+		}
+	} else if !c.Bool("f") {
 		response := cmd.ui.ConfirmDelete(T("app"), appName)
 		if !response {
 			return
@@ -1,6 +1,8 @@
 package application_test
 
 import (
+	"os"
+
 	testApplication "github.com/cloudfoundry/cli/cf/api/applications/fakes"
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands/application"
@@ -95,6 +97,52 @@ var _ = Describe("delete app command", func() {
 				))
 			})
 
+			Context("when the targeted space is protected", func() {
+				BeforeEach(func() {
+					configRepo.SetProtectedTargets([]string{"my-org/my-space"})
+				})
+
+				AfterEach(func() {
+					os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "")
+				})
+
+				It("deletes the app when the user types its name", func() {
+					ui.Inputs = []string{"app-to-delete"}
+
+					runCommand("app-to-delete")
+
+					Expect(ui.Prompts).To(ContainSubstrings([]string{"protected target", "app", "app-to-delete"}))
+					Expect(appRepo.DeletedAppGuid).To(Equal("app-to-delete-guid"))
+				})
+
+				It("does not delete the app when the user answers y", func() {
+					ui.Inputs = []string{"y"}
+
+					runCommand("app-to-delete")
+
+					Expect(appRepo.DeletedAppGuid).To(BeEmpty())
+				})
+
+				It("refuses the -f flag", func() {
+					runCommand("-f", "app-to-delete")
+
+					Expect(appRepo.DeletedAppGuid).To(BeEmpty())
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"protected target", core_config.ProtectedTargetsOverrideEnvVar},
+					))
+				})
+
+				It("accepts the -f flag when the override is set", func() {
+					os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "true")
+
+					runCommand("-f", "app-to-delete")
+
+					Expect(ui.Prompts).To(BeEmpty())
+					Expect(appRepo.DeletedAppGuid).To(Equal("app-to-delete-guid"))
+				})
+			})
+
 			Describe("mapped routes", func() {
 				BeforeEach(func() {
 					route1 := models.RouteSummary{}
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/fileutils"
@@ -15,6 +16,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/api/applications"
 	"github.com/cloudfoundry/cli/cf/api/authentication"
 	"github.com/cloudfoundry/cli/cf/api/stacks"
+	"github.com/cloudfoundry/cli/cf/api/strategy"
 	"github.com/cloudfoundry/cli/cf/app_files"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/commands/service"
@@ -31,47 +33,53 @@ import (
 )
 
 type Push struct {
-	ui            terminal.UI
-	config        core_config.Reader
-	manifestRepo  manifest.ManifestRepository
-	appStarter    ApplicationStarter
-	appStopper    ApplicationStopper
-	serviceBinder service.ServiceBinder
-	appRepo       applications.ApplicationRepository
-	domainRepo    api.DomainRepository
-	routeRepo     api.RouteRepository
-	serviceRepo   api.ServiceRepository
-	stackRepo     stacks.StackRepository
-	authRepo      authentication.AuthenticationRepository
-	wordGenerator generator.WordGenerator
-	actor         actors.PushActor
-	zipper        app_files.Zipper
-	app_files     app_files.AppFiles
+	ui             terminal.UI
+	config         core_config.Reader
+	manifestRepo   manifest.ManifestRepository
+	appStarter     ApplicationStarter
+	appStopper     ApplicationStopper
+	serviceBinder  service.ServiceBinder
+	serviceCreator service.ServiceCreator
+	appRepo        applications.ApplicationRepository
+	domainRepo     api.DomainRepository
+	routeRepo      api.RouteRepository
+	serviceRepo    api.ServiceRepository
+	stackRepo      stacks.StackRepository
+	authRepo       authentication.AuthenticationRepository
+	wordGenerator  generator.WordGenerator
+	actor          actors.PushActor
+	zipper         app_files.Zipper
+	app_files      app_files.AppFiles
+
+	ServicePollInterval time.Duration
 }
 
 func NewPush(ui terminal.UI, config core_config.Reader, manifestRepo manifest.ManifestRepository,
-	starter ApplicationStarter, stopper ApplicationStopper, binder service.ServiceBinder,
+	starter ApplicationStarter, stopper ApplicationStopper, binder service.ServiceBinder, creator service.ServiceCreator,
 	appRepo applications.ApplicationRepository, domainRepo api.DomainRepository, routeRepo api.RouteRepository,
 	stackRepo stacks.StackRepository, serviceRepo api.ServiceRepository,
 	authRepo authentication.AuthenticationRepository, wordGenerator generator.WordGenerator,
 	actor actors.PushActor, zipper app_files.Zipper, app_files app_files.AppFiles) *Push {
 	return &Push{
-		ui:            ui,
-		config:        config,
-		manifestRepo:  manifestRepo,
-		appStarter:    starter,
-		appStopper:    stopper,
-		serviceBinder: binder,
-		appRepo:       appRepo,
-		domainRepo:    domainRepo,
-		routeRepo:     routeRepo,
-		serviceRepo:   serviceRepo,
-		stackRepo:     stackRepo,
-		authRepo:      authRepo,
-		wordGenerator: wordGenerator,
-		actor:         actor,
-		zipper:        zipper,
-		app_files:     app_files,
+		ui:             ui,
+		config:         config,
+		manifestRepo:   manifestRepo,
+		appStarter:     starter,
+		appStopper:     stopper,
+		serviceBinder:  binder,
+		serviceCreator: creator,
+		appRepo:        appRepo,
+		domainRepo:     domainRepo,
+		routeRepo:      routeRepo,
+		serviceRepo:    serviceRepo,
+		stackRepo:      stackRepo,
+		authRepo:       authRepo,
+		wordGenerator:  wordGenerator,
+		actor:          actor,
+		zipper:         zipper,
+		app_files:      app_files,
+
+		ServicePollInterval: service.DefaultServiceOperationPollInterval,
 	}
 }
 
@@ -129,6 +137,7 @@ func (cmd *Push) Run(c *cli.Context) {
 
 	for _, appParams := range appSet {
 		cmd.fetchStackGuid(&appParams)
+		cmd.dropUnsupportedHealthCheckParams(&appParams)
 		app := cmd.createOrUpdateApp(appParams)
 
 		cmd.updateRoutes(routeActor, app, appParams, noHostname)
@@ -144,6 +153,10 @@ func (cmd *Push) Run(c *cli.Context) {
 		}
 		cmd.ui.Ok()
 
+		if appParams.ServicesToCreate != nil {
+			cmd.createMissingServices(*appParams.ServicesToCreate)
+		}
+
 		if appParams.ServicesToBind != nil {
 			cmd.bindAppToServices(*appParams.ServicesToBind, app)
 		}
@@ -153,17 +166,123 @@ func (cmd *Push) Run(c *cli.Context) {
 }
 
 func (cmd *Push) updateRoutes(routeActor actors.RouteActor, app models.Application, appParams models.AppParams, noHostName bool) {
-	defaultRouteAcceptable := len(app.Routes) == 0
-	routeDefined := appParams.Domain != nil || appParams.Host != nil || noHostName
-
-	domain := cmd.findDomain(appParams.Domain)
-	hostname := cmd.hostnameForApp(appParams.Host, appParams.UseRandomHostname, app.Name, noHostName)
-
 	if appParams.NoRoute {
 		cmd.removeRoutes(app, routeActor)
-	} else if routeDefined || defaultRouteAcceptable {
-		route := routeActor.FindOrCreateRoute(hostname, domain)
-		routeActor.BindRoute(app, route)
+		return
+	}
+
+	var boundRoutes []models.Route
+	if appParams.Hosts != nil || appParams.Domains != nil || appParams.Routes != nil {
+		boundRoutes = cmd.bindListedRoutes(routeActor, app, appParams, noHostName)
+	} else {
+		defaultRouteAcceptable := len(app.Routes) == 0
+		routeDefined := appParams.Domain != nil || appParams.Host != nil || noHostName
+
+		if routeDefined || defaultRouteAcceptable {
+			domain := cmd.findDomain(appParams.Domain)
+			hostname := cmd.hostnameForApp(appParams.Host, appParams.UseRandomHostname, app.Name, noHostName)
+
+			route := routeActor.FindOrCreateRoute(hostname, domain)
+			routeActor.BindRoute(app, route)
+			boundRoutes = append(boundRoutes, route)
+		}
+	}
+
+	if appParams.PruneRoutes {
+		cmd.pruneRoutes(routeActor, app, boundRoutes)
+	}
+}
+
+func (cmd *Push) bindListedRoutes(routeActor actors.RouteActor, app models.Application, appParams models.AppParams, noHostName bool) (boundRoutes []models.Route) {
+	hostDefined := appParams.Host != nil || appParams.Hosts != nil || noHostName
+	domainDefined := appParams.Domain != nil || appParams.Domains != nil
+
+	if hostDefined || domainDefined || appParams.Routes == nil {
+		hostnames := []string{}
+		if appParams.Host != nil || appParams.Hosts == nil || noHostName {
+			hostnames = append(hostnames, cmd.hostnameForApp(appParams.Host, appParams.UseRandomHostname, app.Name, noHostName))
+		}
+		if appParams.Hosts != nil {
+			hostnames = append(hostnames, *appParams.Hosts...)
+		}
+
+		domains := []models.DomainFields{}
+		if appParams.Domain != nil || appParams.Domains == nil {
+			domains = append(domains, cmd.findDomain(appParams.Domain))
+		}
+		if appParams.Domains != nil {
+			for _, domainName := range *appParams.Domains {
+				name := domainName
+				domains = append(domains, cmd.findDomain(&name))
+			}
+		}
+
+		for _, domain := range domains {
+			for _, hostname := range hostnames {
+				route := routeActor.FindOrCreateRoute(hostname, domain)
+				routeActor.BindRoute(app, route)
+				boundRoutes = append(boundRoutes, route)
+			}
+		}
+	}
+
+	if appParams.Routes != nil {
+		for _, url := range *appParams.Routes {
+			hostname, domain := cmd.splitRouteURL(url)
+			route := routeActor.FindOrCreateRoute(hostname, domain)
+			routeActor.BindRoute(app, route)
+			boundRoutes = append(boundRoutes, route)
+		}
+	}
+
+	return
+}
+
+func (cmd *Push) splitRouteURL(url string) (hostname string, domain models.DomainFields) {
+	found := false
+	err := cmd.domainRepo.ListDomainsForOrg(cmd.config.OrganizationFields().Guid, func(orgDomain models.DomainFields) bool {
+		if len(orgDomain.Name) <= len(domain.Name) {
+			return true
+		}
+
+		if url == orgDomain.Name {
+			hostname = ""
+		} else if strings.HasSuffix(url, "."+orgDomain.Name) {
+			hostname = strings.TrimSuffix(url, "."+orgDomain.Name)
+		} else {
+			return true
+		}
+
+		domain = orgDomain
+		found = true
+		return true
+	})
+
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	if !found {
+		cmd.ui.Failed(T("The route {{.URL}} does not match any domain available in org {{.OrgName}}",
+			map[string]interface{}{"URL": url, "OrgName": cmd.config.OrganizationFields().Name}))
+	}
+
+	return
+}
+
+func (cmd *Push) pruneRoutes(routeActor actors.RouteActor, app models.Application, boundRoutes []models.Route) {
+	for _, route := range app.Routes {
+		listed := false
+		for _, boundRoute := range boundRoutes {
+			if boundRoute.Guid == route.Guid {
+				listed = true
+				break
+			}
+		}
+
+		if !listed {
+			routeActor.UnbindRoute(app, route)
+		}
 	}
 }
 
@@ -227,7 +346,7 @@ func (cmd *Push) bindAppToServices(services []string, app models.Application) {
 				"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
 				"Username":    terminal.EntityNameColor(cmd.config.Username())}))
 
-		err = cmd.serviceBinder.BindApplication(app, serviceInstance)
+		err = cmd.serviceBinder.BindApplication(app, serviceInstance, nil)
 
 		switch httpErr := err.(type) {
 		case errors.HttpError:
@@ -245,6 +364,73 @@ func (cmd *Push) bindAppToServices(services []string, app models.Application) {
 	}
 }
 
+func (cmd *Push) createMissingServices(services []models.ServiceParams) {
+	for _, params := range services {
+		_, err := cmd.serviceRepo.FindInstanceByName(params.Name)
+		switch err.(type) {
+		case nil:
+			continue
+		case *errors.ModelNotFoundError:
+		default:
+			cmd.ui.Failed(err.Error())
+		}
+
+		cmd.ui.Say(T("Creating service {{.ServiceName}} from plan {{.PlanName}} of {{.Label}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"ServiceName": terminal.EntityNameColor(params.Name),
+				"PlanName":    terminal.EntityNameColor(params.Plan),
+				"Label":       terminal.EntityNameColor(params.Label),
+				"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":    terminal.EntityNameColor(cmd.config.Username())}))
+
+		_, err = cmd.serviceCreator.CreateService(params.Label, params.Plan, params.Name, params.Parameters, nil)
+		switch err.(type) {
+		case nil, *errors.ModelAlreadyExistsError:
+		default:
+			cmd.ui.Failed(T("Could not create service {{.ServiceName}}\nError: {{.Err}}",
+				map[string]interface{}{"ServiceName": params.Name, "Err": err.Error()}))
+		}
+
+		// brokers may provision asynchronously, and binding has to wait for it
+		err = service.WaitForServiceInstance(cmd.ui, cmd.serviceRepo, params.Name, cmd.ServicePollInterval)
+		if err != nil {
+			cmd.ui.Failed(T("Could not create service {{.ServiceName}}\nError: {{.Err}}",
+				map[string]interface{}{"ServiceName": params.Name, "Err": err.Error()}))
+		}
+
+		cmd.ui.Ok()
+	}
+}
+
+var (
+	healthCheckTypeMinimumApiVersion     = strategy.Version{Major: 2, Minor: 47, Patch: 0}
+	healthCheckEndpointMinimumApiVersion = strategy.Version{Major: 2, Minor: 68, Patch: 0}
+)
+
+func (cmd *Push) dropUnsupportedHealthCheckParams(appParams *models.AppParams) {
+	if appParams.HealthCheckType == nil && appParams.HealthCheckEndpoint == nil {
+		return
+	}
+
+	apiVersion, err := strategy.ParseVersion(cmd.config.ApiVersion())
+	if err != nil {
+		apiVersion = strategy.Version{}
+	}
+
+	if appParams.HealthCheckType != nil && apiVersion.LessThan(healthCheckTypeMinimumApiVersion) {
+		cmd.ui.Warn(T("Ignoring health-check-type: your CC API version {{.ApiVersion}} does not support it. Requires {{.MinimumVersion}} or later.",
+			map[string]interface{}{"ApiVersion": cmd.config.ApiVersion(), "MinimumVersion": "2.47.0"}))
+		appParams.HealthCheckType = nil
+	}
+
+	if appParams.HealthCheckEndpoint != nil && apiVersion.LessThan(healthCheckEndpointMinimumApiVersion) {
+		cmd.ui.Warn(T("Ignoring health-check-http-endpoint: your CC API version {{.ApiVersion}} does not support it. Requires {{.MinimumVersion}} or later.",
+			map[string]interface{}{"ApiVersion": cmd.config.ApiVersion(), "MinimumVersion": "2.68.0"}))
+		appParams.HealthCheckEndpoint = nil
+	}
+}
+
 func (cmd *Push) fetchStackGuid(appParams *models.AppParams) {
 	if appParams.StackName == nil {
 		return
@@ -384,6 +570,19 @@ func (cmd *Push) getAppParamsFromManifest(c *cli.Context) []models.AppParams {
 		}
 	}
 
+	validationErrs, err := cmd.manifestRepo.ValidateManifest(m.Path)
+	if err != nil {
+		cmd.ui.Failed(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
+	if len(validationErrs) > 0 {
+		messages := []string{}
+		for _, validationErr := range validationErrs {
+			messages = append(messages, validationErr.Error())
+		}
+		cmd.ui.Failed(T("Manifest file is invalid:\n{{.Errors}}", map[string]interface{}{"Errors": strings.Join(messages, "\n")}))
+	}
+
 	apps, err := m.Applications()
 	if err != nil {
 		cmd.ui.Failed("Error reading manifest file:\n%s", err)
@@ -39,6 +39,7 @@ var _ = Describe("Push Command", func() {
 		starter             *testcmd.FakeApplicationStarter
 		stopper             *testcmd.FakeApplicationStopper
 		serviceBinder       *testcmd.FakeAppBinder
+		serviceCreator      *testcmd.FakeServiceCreator
 		appRepo             *testApplication.FakeApplicationRepository
 		domainRepo          *testapi.FakeDomainRepository
 		routeRepo           *testapi.FakeRouteRepository
@@ -57,6 +58,7 @@ var _ = Describe("Push Command", func() {
 		starter = &testcmd.FakeApplicationStarter{}
 		stopper = &testcmd.FakeApplicationStopper{}
 		serviceBinder = &testcmd.FakeAppBinder{}
+		serviceCreator = &testcmd.FakeServiceCreator{}
 		appRepo = &testApplication.FakeApplicationRepository{}
 
 		domainRepo = &testapi.FakeDomainRepository{}
@@ -79,7 +81,7 @@ var _ = Describe("Push Command", func() {
 		app_files = &fakeappfiles.FakeAppFiles{}
 		actor = &fakeactors.FakePushActor{}
 
-		cmd = NewPush(ui, configRepo, manifestRepo, starter, stopper, serviceBinder,
+		cmd = NewPush(ui, configRepo, manifestRepo, starter, stopper, serviceBinder, serviceCreator,
 			appRepo,
 			domainRepo,
 			routeRepo,
@@ -90,6 +92,7 @@ var _ = Describe("Push Command", func() {
 			actor,
 			zipper,
 			app_files)
+		cmd.ServicePollInterval = 0
 	})
 
 	callPush := func(args ...string) bool {
@@ -500,6 +503,30 @@ var _ = Describe("Push Command", func() {
 				))
 			})
 
+			It("validates the manifest it reads", func() {
+				manifestRepo.ReadManifestReturns.Manifest = singleAppManifest()
+
+				callPush()
+
+				Expect(manifestRepo.ValidateManifestArgs.Path).To(Equal("manifest.yml"))
+			})
+
+			It("fails when the manifest does not pass validation", func() {
+				manifestRepo.ReadManifestReturns.Manifest = singleAppManifest()
+				manifestRepo.ValidateManifestReturns.ValidationErrors = []manifest.ValidationError{
+					{File: "manifest.yml", Line: 4, Message: "Unknown property 'instnaces', did you mean 'instances'?"},
+				}
+
+				callPush()
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Manifest file is invalid"},
+					[]string{"manifest.yml:4", "instnaces", "instances"},
+				))
+				Expect(appRepo.CreateAppParams).To(BeEmpty())
+			})
+
 			It("does not create a route when provided the --no-route flag", func() {
 				domainRepo.FindByNameInOrgDomain = models.DomainFields{
 					Name: "bar.cf-app.com",
@@ -592,6 +619,58 @@ var _ = Describe("Push Command", func() {
 					Expect(len(appRepo.CreateAppParams)).To(Equal(0))
 				})
 			})
+
+			Context("when the manifest sets a health check", func() {
+				BeforeEach(func() {
+					manifestRepo.ReadManifestReturns.Manifest = &manifest.Manifest{
+						Path: "manifest.yml",
+						Data: generic.NewMap(map[interface{}]interface{}{
+							"applications": []interface{}{
+								generic.NewMap(map[interface{}]interface{}{
+									"name":                       "app-with-health-check",
+									"health-check-type":          "http",
+									"health-check-http-endpoint": "/health",
+								}),
+							},
+						}),
+					}
+				})
+
+				It("sends the health check when the CC API supports it", func() {
+					configRepo.SetApiVersion("2.68.0")
+
+					callPush()
+
+					Expect(*appRepo.CreatedAppParams().HealthCheckType).To(Equal("http"))
+					Expect(*appRepo.CreatedAppParams().HealthCheckEndpoint).To(Equal("/health"))
+					Expect(ui.WarnOutputs).ToNot(ContainSubstrings([]string{"Ignoring"}))
+				})
+
+				It("drops only the endpoint when the CC API supports the type but not the endpoint", func() {
+					configRepo.SetApiVersion("2.50.0")
+
+					callPush()
+
+					Expect(*appRepo.CreatedAppParams().HealthCheckType).To(Equal("http"))
+					Expect(appRepo.CreatedAppParams().HealthCheckEndpoint).To(BeNil())
+					Expect(ui.WarnOutputs).To(ContainSubstrings(
+						[]string{"Ignoring health-check-http-endpoint", "2.50.0", "2.68.0"},
+					))
+				})
+
+				It("warns and drops the health check on older CC APIs", func() {
+					configRepo.SetApiVersion("2.22.0")
+
+					callPush()
+
+					Expect(appRepo.CreatedAppParams().HealthCheckType).To(BeNil())
+					Expect(appRepo.CreatedAppParams().HealthCheckEndpoint).To(BeNil())
+					Expect(ui.WarnOutputs).To(ContainSubstrings(
+						[]string{"Ignoring health-check-type", "2.22.0", "2.47.0"},
+						[]string{"Ignoring health-check-http-endpoint", "2.22.0", "2.68.0"},
+					))
+				})
+			})
 		})
 	})
 
@@ -844,6 +923,84 @@ var _ = Describe("Push Command", func() {
 				Expect(routeRepo.CreatedHost).To(Equal(""))
 				Expect(routeRepo.CreatedDomainGuid).To(Equal("domain-guid"))
 			})
+
+			Describe("when the manifest lists several routes", func() {
+				var manifestApp generic.Map
+
+				BeforeEach(func() {
+					routeRepo.FindByHostAndDomainReturns.Error = errors.NewModelNotFoundError("Route", "not found")
+
+					manifestApp = generic.NewMap(map[interface{}]interface{}{
+						"name": "existing-app",
+					})
+					manifestRepo.ReadManifestReturns.Manifest = &manifest.Manifest{
+						Path: "manifest.yml",
+						Data: generic.NewMap(map[interface{}]interface{}{
+							"applications": []interface{}{manifestApp},
+						}),
+					}
+				})
+
+				It("creates and binds a route for each host", func() {
+					manifestApp.Set("hosts", []interface{}{"api", "www"})
+
+					callPush()
+
+					Expect(routeRepo.BoundRouteGuids).To(Equal([]string{"api-route-guid", "www-route-guid"}))
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"Creating route", "api.example.com"},
+						[]string{"Binding", "api.example.com"},
+						[]string{"Creating route", "www.example.com"},
+						[]string{"Binding", "www.example.com"},
+					))
+				})
+
+				It("creates and binds each full route, splitting it on the longest matching domain", func() {
+					domainRepo.ListDomainsForOrgDomains = []models.DomainFields{
+						{Name: "example.com", Guid: "domain-guid", Shared: true},
+						{Name: "apps.example.com", Guid: "apps-domain-guid"},
+					}
+					manifestApp.Set("routes", []interface{}{"api.apps.example.com", "example.com"})
+
+					callPush()
+
+					Expect(routeRepo.BoundRouteGuids).To(Equal([]string{"api-route-guid", "-route-guid"}))
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"Binding", "api.apps.example.com"},
+						[]string{"Binding", "example.com"},
+					))
+				})
+
+				It("fails when a route does not match any domain in the org", func() {
+					manifestApp.Set("routes", []interface{}{"api.unknown.com"})
+
+					callPush()
+
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"api.unknown.com", "does not match any domain"},
+					))
+				})
+
+				It("leaves routes that are no longer listed bound by default", func() {
+					manifestApp.Set("hosts", []interface{}{"api"})
+
+					callPush()
+
+					Expect(routeRepo.UnboundRouteGuids).To(BeEmpty())
+				})
+
+				It("unbinds routes that are no longer listed when prune-routes is set", func() {
+					manifestApp.Set("hosts", []interface{}{"api"})
+					manifestApp.Set("prune-routes", true)
+
+					callPush()
+
+					Expect(routeRepo.BoundRouteGuids).To(Equal([]string{"api-route-guid"}))
+					Expect(routeRepo.UnboundRouteGuids).To(Equal([]string{"existing-route-guid"}))
+					Expect(ui.Outputs).To(ContainSubstrings([]string{"Removing route", "existing-app.example.com"}))
+				})
+			})
 		})
 	})
 
@@ -922,6 +1079,112 @@ var _ = Describe("Push Command", func() {
 			})
 		})
 
+		Context("when the manifest defines services that do not exist yet", func() {
+			BeforeEach(func() {
+				appRepo.ReadReturns.Error = errors.NewModelNotFoundError("App", "the-app")
+				serviceRepo.FindInstanceByNameNotFound = true
+				manifestRepo.ReadManifestReturns.Manifest = manifestWithServiceDefinitions()
+				serviceCreator.CreateServiceHook = func(name string) {
+					serviceRepo.FindInstanceByNameMap.Set(name, maker.NewServiceInstance(name))
+				}
+			})
+
+			It("creates the missing service instances before binding them", func() {
+				callPush()
+
+				Expect(serviceCreator.CreateServiceArgs).To(Equal([]models.ServiceParams{
+					{
+						Name:       "new-db",
+						Label:      "cleardb",
+						Plan:       "spark",
+						Parameters: map[string]interface{}{"storage": 10},
+					},
+				}))
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Creating service", "new-db", "spark", "cleardb", "my-org", "my-space", "my-user"},
+					[]string{"OK"},
+					[]string{"Binding service", "global-service", "app1"},
+				))
+			})
+
+			It("fails when the service instance cannot be created", func() {
+				serviceCreator.CreateServiceReturns.Error = errors.New("plan not found")
+
+				callPush()
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Could not create service new-db"},
+					[]string{"plan not found"},
+				))
+				Expect(serviceBinder.AppsToBind).To(BeEmpty())
+			})
+
+			Context("when the broker provisions the instance asynchronously", func() {
+				var (
+					inProgress models.ServiceInstance
+					succeeded  models.ServiceInstance
+				)
+
+				BeforeEach(func() {
+					inProgress = maker.NewServiceInstance("new-db")
+					inProgress.LastOperation.State = models.LastOperationInProgress
+					succeeded = maker.NewServiceInstance("new-db")
+					succeeded.LastOperation.State = models.LastOperationSucceeded
+				})
+
+				It("waits for the instance to be provisioned before binding it", func() {
+					serviceCreator.CreateServiceHook = func(name string) {
+						serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{inProgress, inProgress, succeeded}
+						serviceRepo.FindInstanceByNameMap.Set(name, succeeded)
+					}
+
+					callPush()
+
+					Expect(serviceRepo.FindInstanceByNameSequence).To(BeEmpty())
+					Expect(serviceBinder.InstancesToBindTo[1].Name).To(Equal("new-db"))
+					Expect(serviceBinder.InstancesToBindTo[1].LastOperation.State).To(Equal(models.LastOperationSucceeded))
+					Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"FAILED"}))
+				})
+
+				It("fails without binding when provisioning fails", func() {
+					failed := inProgress
+					failed.LastOperation.State = models.LastOperationFailed
+					failed.LastOperation.Type = "create"
+					failed.LastOperation.Description = "out of capacity"
+					serviceCreator.CreateServiceHook = func(name string) {
+						serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{inProgress, failed}
+					}
+
+					callPush()
+
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Could not create service new-db"},
+						[]string{"Create failed", "out of capacity"},
+					))
+					Expect(serviceBinder.AppsToBind).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the manifest defines a service that already exists", func() {
+			BeforeEach(func() {
+				appRepo.ReadReturns.Error = errors.NewModelNotFoundError("App", "the-app")
+				serviceRepo.FindInstanceByNameMap.Set("new-db", maker.NewServiceInstance("new-db"))
+				manifestRepo.ReadManifestReturns.Manifest = manifestWithServiceDefinitions()
+			})
+
+			It("binds it without creating it", func() {
+				callPush()
+
+				Expect(serviceCreator.CreateServiceArgs).To(BeEmpty())
+				Expect(serviceBinder.InstancesToBindTo[0].Name).To(Equal("global-service"))
+				Expect(serviceBinder.InstancesToBindTo[1].Name).To(Equal("new-db"))
+			})
+		})
+
 	})
 
 	Describe("checking for bad flags", func() {
@@ -1065,6 +1328,27 @@ func singleAppManifest() *manifest.Manifest {
 	}
 }
 
+func manifestWithServiceDefinitions() *manifest.Manifest {
+	return &manifest.Manifest{
+		Data: generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				generic.NewMap(map[interface{}]interface{}{
+					"name": "app1",
+					"services": []interface{}{
+						"global-service",
+						map[interface{}]interface{}{
+							"name":       "new-db",
+							"service":    "cleardb",
+							"plan":       "spark",
+							"parameters": map[interface{}]interface{}{"storage": 10},
+						},
+					},
+				}),
+			},
+		}),
+	}
+}
+
 func manifestWithServicesAndEnv() *manifest.Manifest {
 	return &manifest.Manifest{
 		Data: generic.NewMap(map[interface{}]interface{}{
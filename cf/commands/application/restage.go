@@ -51,15 +51,22 @@ func (cmd *Restage) Run(c *cli.Context) {
 		cmd.ui.Failed(notFound.Error())
 	}
 
+	_, err = cmd.ApplicationRestage(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+}
+
+func (cmd *Restage) ApplicationRestage(app models.Application, orgName, spaceName string) (models.Application, error) {
 	cmd.ui.Say(T("Restaging app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 		map[string]interface{}{
 			"AppName":     terminal.EntityNameColor(app.Name),
-			"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-			"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"OrgName":     terminal.EntityNameColor(orgName),
+			"SpaceName":   terminal.EntityNameColor(spaceName),
 			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	cmd.appStagingWatcher.ApplicationWatchStaging(app, cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name, func(app models.Application) (models.Application, error) {
+	return cmd.appStagingWatcher.ApplicationWatchStaging(app, orgName, spaceName, func(app models.Application) (models.Application, error) {
 		return app, cmd.appRepo.CreateRestageRequest(app.Guid)
 	})
 }
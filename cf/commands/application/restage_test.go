@@ -90,6 +90,16 @@ var _ = Describe("restage command", func() {
 			Expect(stagingWatcher.orgName).To(Equal(configRepo.OrganizationFields().Name))
 			Expect(stagingWatcher.spaceName).To(Equal(configRepo.SpaceFields().Name))
 		})
+
+		It("fails when staging fails", func() {
+			stagingWatcher.err = errors.New("Start unsuccessful")
+			runCommand("my-app")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Start unsuccessful"},
+			))
+		})
 	})
 })
 
@@ -97,11 +107,15 @@ type fakeStagingWatcher struct {
 	watched   models.Application
 	orgName   string
 	spaceName string
+	err       error
 }
 
 func (f *fakeStagingWatcher) ApplicationWatchStaging(app models.Application, orgName, spaceName string, start func(models.Application) (models.Application, error)) (updatedApp models.Application, err error) {
 	f.watched = app
 	f.orgName = orgName
 	f.spaceName = spaceName
+	if f.err != nil {
+		return app, f.err
+	}
 	return start(app)
 }
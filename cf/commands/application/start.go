@@ -121,7 +121,7 @@ func (cmd *Start) ApplicationStart(app models.Application, orgName, spaceName st
 		return
 	}
 
-	return cmd.ApplicationWatchStaging(app, orgName, spaceName, func(app models.Application) (models.Application, error) {
+	updatedApp, err = cmd.ApplicationWatchStaging(app, orgName, spaceName, func(app models.Application) (models.Application, error) {
 		cmd.ui.Say(T("Starting app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 			map[string]interface{}{
 				"AppName":     terminal.EntityNameColor(app.Name),
@@ -132,8 +132,15 @@ func (cmd *Start) ApplicationStart(app models.Application, orgName, spaceName st
 		state := "STARTED"
 		return cmd.appRepo.Update(app.Guid, models.AppParams{State: &state})
 	})
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+	return
 }
 
+// ApplicationWatchStaging runs start while streaming the staging logs, then
+// waits for an instance to run. It returns an error instead of failing, so
+// that callers working through several apps can carry on with the others.
 func (cmd *Start) ApplicationWatchStaging(app models.Application, orgName, spaceName string, start func(app models.Application) (models.Application, error)) (updatedApp models.Application, err error) {
 	stopLoggingChan := make(chan bool, 1)
 	loggingStartedChan := make(chan bool)
@@ -146,27 +153,33 @@ func (cmd *Start) ApplicationWatchStaging(app models.Application, orgName, space
 	}()
 	<-loggingStartedChan // block until we have established connection to Loggregator
 
-	updatedApp, apiErr := start(app)
-	if apiErr != nil {
-		cmd.ui.Failed(apiErr.Error())
+	updatedApp, err = start(app)
+	if err != nil {
+		stopLoggingChan <- true
+		<-doneLoggingChan
 		return
 	}
 
-	cmd.waitForInstancesToStage(updatedApp)
+	err = cmd.waitForInstancesToStage(updatedApp)
 	stopLoggingChan <- true
 	<-doneLoggingChan
+	if err != nil {
+		return
+	}
 
 	cmd.ui.Say("")
 
-	cmd.waitForOneRunningInstance(updatedApp)
+	err = cmd.waitForOneRunningInstance(updatedApp)
+	if err != nil {
+		return
+	}
 	cmd.ui.Say(terminal.HeaderColor(T("\nApp started\n")))
 	cmd.ui.Say("")
 	cmd.ui.Ok()
 
 	//detectedstartcommand on first push is not present until starting completes
-	startedApp, apiErr := cmd.appRepo.Read(updatedApp.Name)
+	startedApp, err := cmd.appRepo.Read(updatedApp.Name)
 	if err != nil {
-		cmd.ui.Failed(apiErr.Error())
 		return
 	}
 
@@ -226,7 +239,7 @@ func isStagingError(err error) bool {
 	return ok && httpError.ErrorCode() == errors.APP_NOT_STAGED
 }
 
-func (cmd Start) waitForInstancesToStage(app models.Application) {
+func (cmd Start) waitForInstancesToStage(app models.Application) error {
 	stagingStartTime := time.Now()
 	_, err := cmd.appInstancesRepo.GetInstances(app.Guid)
 
@@ -237,24 +250,23 @@ func (cmd Start) waitForInstancesToStage(app models.Application) {
 
 	if err != nil && !isStagingError(err) {
 		cmd.ui.Say("")
-		cmd.ui.Failed(T("{{.Err}}\n\nTIP: use '{{.Command}}' for more information",
+		return errors.New(T("{{.Err}}\n\nTIP: use '{{.Command}}' for more information",
 			map[string]interface{}{
 				"Err":     err.Error(),
 				"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))}))
 	}
 
-	return
+	return nil
 }
 
-func (cmd Start) waitForOneRunningInstance(app models.Application) {
+func (cmd Start) waitForOneRunningInstance(app models.Application) error {
 	startupStartTime := time.Now()
 
 	for {
 		if time.Since(startupStartTime) > cmd.StartupTimeout {
-			cmd.ui.Failed(fmt.Sprintf(T("Start app timeout\n\nTIP: use '{{.Command}}' for more information",
+			return errors.New(T("Start app timeout\n\nTIP: use '{{.Command}}' for more information",
 				map[string]interface{}{
-					"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))})))
-			return
+					"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))}))
 		}
 
 		count, err := cmd.fetchInstanceCount(app.Guid)
@@ -266,13 +278,12 @@ func (cmd Start) waitForOneRunningInstance(app models.Application) {
 		cmd.ui.Say(instancesDetails(count))
 
 		if count.running > 0 {
-			return
+			return nil
 		}
 
 		if count.flapping > 0 {
-			cmd.ui.Failed(fmt.Sprintf(T("Start unsuccessful\n\nTIP: use '{{.Command}}' for more information",
-				map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))})))
-			return
+			return errors.New(T("Start unsuccessful\n\nTIP: use '{{.Command}}' for more information",
+				map[string]interface{}{"Command": terminal.CommandColor(fmt.Sprintf("%s logs %s --recent", cf.Name(), app.Name))}))
 		}
 
 		cmd.ui.Wait(cmd.PingerThrottle)
@@ -201,6 +201,16 @@ var _ = Describe("start command", func() {
 					[]string{"Error staging app"},
 				))
 			})
+
+			It("returns staging failures to callers watching the staging themselves", func() {
+				_, err := cmd.ApplicationWatchStaging(app, "my-org", "my-space", func(app models.Application) (models.Application, error) {
+					return app, nil
+				})
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("Error staging app"))
+				Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"FAILED"}))
+			})
 		})
 	})
 
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
@@ -25,12 +26,20 @@ func (cmd ConfigCommands) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "config",
 		Description: T("write default values to the config"),
-		Usage:       T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace true | false | path/to/file] [--color true | false] [--locale (LOCALE | CLEAR)]"),
+		Usage: T(`CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace true | false | path/to/file] [--color true | false] [--locale (LOCALE | CLEAR)] [--protect ORG[/SPACE]] [--unprotect ORG[/SPACE]]
+
+   Deleting from a protected org or space with delete, delete-service, delete-space, delete-org or delete-orphaned-routes requires typing the name back, and so does purge-service-offering once any target is protected. Protected targets cannot be deleted from with -f unless {{.EnvVar}}=true is set. ORG and SPACE may contain * and ? wildcards.
+
+EXAMPLE:
+   CF_NAME config --protect production
+   CF_NAME config --protect "staging/db-*"`, map[string]interface{}{"EnvVar": core_config.ProtectedTargetsOverrideEnvVar}),
 		Flags: []cli.Flag{
 			flag_helpers.NewIntFlag("async-timeout", T("Timeout for async HTTP requests")),
 			flag_helpers.NewStringFlag("trace", T("Trace HTTP requests")),
 			flag_helpers.NewStringFlag("color", T("Enable or disable color")),
 			flag_helpers.NewStringFlag("locale", "Set default locale. If LOCALE is CLEAR, previous locale is deleted."),
+			flag_helpers.NewStringFlag("protect", T("Protect an org, or a space within it, from destructive commands")),
+			flag_helpers.NewStringFlag("unprotect", T("Remove a protected org or space")),
 		},
 	}
 }
@@ -40,7 +49,7 @@ func (cmd ConfigCommands) GetRequirements(_ requirements.Factory, _ *cli.Context
 }
 
 func (cmd ConfigCommands) Run(context *cli.Context) {
-	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") {
+	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") && !context.IsSet("protect") && !context.IsSet("unprotect") {
 		cmd.ui.FailWithUsage(context)
 		return
 	}
@@ -70,6 +79,10 @@ func (cmd ConfigCommands) Run(context *cli.Context) {
 		}
 	}
 
+	if context.IsSet("protect") || context.IsSet("unprotect") {
+		cmd.updateProtectedTargets(context)
+	}
+
 	if context.IsSet("locale") {
 		locale := context.String("locale")
 
@@ -97,3 +110,46 @@ func (cmd ConfigCommands) Run(context *cli.Context) {
 		}
 	}
 }
+
+func (cmd ConfigCommands) updateProtectedTargets(context *cli.Context) {
+	patterns := cmd.config.ProtectedTargets()
+
+	if context.IsSet("protect") {
+		pattern := context.String("protect")
+		if !core_config.IsValidProtectedTarget(pattern) {
+			cmd.ui.Failed(T("Invalid protected target {{.Pattern}}. Use ORG or ORG/SPACE.", map[string]interface{}{"Pattern": pattern}))
+			return
+		}
+
+		if !containsPattern(patterns, pattern) {
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	if context.IsSet("unprotect") {
+		pattern := context.String("unprotect")
+		if !containsPattern(patterns, pattern) {
+			cmd.ui.Warn(T("{{.Pattern}} is not a protected target", map[string]interface{}{"Pattern": pattern}))
+		}
+
+		remaining := []string{}
+		for _, existing := range patterns {
+			if existing != pattern {
+				remaining = append(remaining, existing)
+			}
+		}
+		patterns = remaining
+	}
+
+	cmd.config.SetProtectedTargets(patterns)
+	cmd.ui.Say(T("Protected targets: {{.Patterns}}", map[string]interface{}{"Patterns": strings.Join(patterns, ", ")}))
+}
+
+func containsPattern(patterns []string, pattern string) bool {
+	for _, existing := range patterns {
+		if existing == pattern {
+			return true
+		}
+	}
+	return false
+}
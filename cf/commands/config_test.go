@@ -109,4 +109,38 @@ var _ = Describe("config command", func() {
 			})
 		})
 	})
+	Context("--protect and --unprotect flags", func() {
+		It("adds a protected target", func() {
+			runCommand("--protect", "prod")
+			runCommand("--protect", "staging/db-*")
+			runCommand("--protect", "prod")
+
+			Expect(configRepo.ProtectedTargets()).To(Equal([]string{"prod", "staging/db-*"}))
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"Protected targets", "prod, staging/db-*"}))
+		})
+
+		It("fails when the target is not ORG or ORG/SPACE", func() {
+			runCommand("--protect", "prod/db/extra")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Invalid protected target", "prod/db/extra"},
+			))
+			Expect(configRepo.ProtectedTargets()).To(BeEmpty())
+		})
+
+		It("removes a protected target", func() {
+			configRepo.SetProtectedTargets([]string{"prod", "staging/db-*"})
+
+			runCommand("--unprotect", "prod")
+
+			Expect(configRepo.ProtectedTargets()).To(Equal([]string{"staging/db-*"}))
+		})
+
+		It("warns when removing a target that is not protected", func() {
+			runCommand("--unprotect", "prod")
+
+			Expect(ui.WarnOutputs).To(ContainSubstrings([]string{"prod", "is not a protected target"}))
+		})
+	})
 })
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/api/app_instances"
+	"github.com/cloudfoundry/cli/cf/api/stacks"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/manifest"
@@ -24,15 +25,18 @@ type CreateAppManifest struct {
 	config           core_config.Reader
 	appSummaryRepo   api.AppSummaryRepository
 	appInstancesRepo app_instances.AppInstancesRepository
+	stackRepo        stacks.StackRepository
 	appReq           requirements.ApplicationRequirement
 	manifest         manifest.AppManifest
+	stackNames       map[string]string
 }
 
-func NewCreateAppManifest(ui terminal.UI, config core_config.Reader, appSummaryRepo api.AppSummaryRepository, manifestGenerator manifest.AppManifest) (cmd *CreateAppManifest) {
+func NewCreateAppManifest(ui terminal.UI, config core_config.Reader, appSummaryRepo api.AppSummaryRepository, stackRepo stacks.StackRepository, manifestGenerator manifest.AppManifest) (cmd *CreateAppManifest) {
 	cmd = new(CreateAppManifest)
 	cmd.ui = ui
 	cmd.config = config
 	cmd.appSummaryRepo = appSummaryRepo
+	cmd.stackRepo = stackRepo
 	cmd.manifest = manifestGenerator
 	return
 }
@@ -41,29 +45,50 @@ func (cmd *CreateAppManifest) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "create-app-manifest",
 		Description: T("Create an app manifest for an app that has been pushed successfully."),
-		Usage:       T("CF_NAME create-app-manifest [-p /path/to/<app-name>-manifest.yml ]"),
+		Usage:       T("CF_NAME create-app-manifest APP [-p /path/to/<app-name>-manifest.yml ]\n   CF_NAME create-app-manifest --space [-p /path/to/manifest.yml ]"),
 		Flags: []cli.Flag{
 			flag_helpers.NewStringFlag("p", T("Specify a path for file creation.  If path not specified, file is create in root directory of the application source code.")),
+			cli.BoolFlag{Name: "space", Usage: T("Create a single manifest for every app in the targeted space")},
 		},
 	}
 }
 
 func (cmd *CreateAppManifest) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	if c.Bool("space") {
+		if len(c.Args()) != 0 {
+			cmd.ui.FailWithUsage(c)
+		}
+		return
+	}
+
 	if len(c.Args()) != 1 {
 		cmd.ui.FailWithUsage(c)
 	}
 
 	cmd.appReq = requirementsFactory.NewApplicationRequirement(c.Args()[0])
-
-	reqs = []requirements.Requirement{
-		requirementsFactory.NewLoginRequirement(),
-		requirementsFactory.NewTargetedSpaceRequirement(),
-		cmd.appReq,
-	}
+	reqs = append(reqs, cmd.appReq)
 	return
 }
 
 func (cmd *CreateAppManifest) Run(c *cli.Context) {
+	cmd.stackNames = map[string]string{}
+
+	savePath := "./manifest_generated.yml"
+
+	if c.String("p") != "" {
+		savePath = c.String("p")
+	}
+
+	if c.Bool("space") {
+		cmd.createSpaceManifest(savePath)
+		return
+	}
+
 	app := cmd.appReq.GetApplication()
 
 	application, apiErr := cmd.appSummaryRepo.GetSummary(app.Guid)
@@ -75,40 +100,64 @@ func (cmd *CreateAppManifest) Run(c *cli.Context) {
 	cmd.ui.Say(T("Creating an app manifest from current settings of app ") + application.Name + " ...")
 	cmd.ui.Say("")
 
-	savePath := "./manifest_generated.yml"
+	cmd.addApp(application)
+	cmd.saveManifest(savePath)
+}
 
-	if c.String("p") != "" {
-		savePath = c.String("p")
-	}
+func (cmd *CreateAppManifest) createSpaceManifest(savePath string) {
+	spaceName := cmd.config.SpaceFields().Name
 
-	cmd.createManifest(application, savePath)
-}
+	cmd.ui.Say(T("Creating an app manifest from current settings of all apps in space {{.SpaceName}} ...",
+		map[string]interface{}{"SpaceName": terminal.EntityNameColor(spaceName)}))
+	cmd.ui.Say("")
 
-func (cmd *CreateAppManifest) createManifest(app models.Application, savePath string) error {
-	cmd.manifest.FileSavePath(savePath)
-	cmd.manifest.Memory(app.Name, app.Memory)
-	cmd.manifest.Instances(app.Name, app.InstanceCount)
+	apps, apiErr := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+	if apiErr != nil {
+		cmd.ui.Failed(T("Error getting application summary: ") + apiErr.Error())
+	}
 
-	if len(app.Services) > 0 {
-		for _, service := range app.Services {
-			cmd.manifest.Service(app.Name, service.Name)
+	if len(apps) == 0 {
+		cmd.ui.Failed(T("No apps found in space {{.SpaceName}}", map[string]interface{}{"SpaceName": spaceName}))
+	}
+
+	for _, app := range apps {
+		application, apiErr := cmd.appSummaryRepo.GetSummary(app.Guid)
+		if apiErr != nil {
+			cmd.ui.Failed(T("Error getting application summary: ") + apiErr.Error())
 		}
+
+		cmd.addApp(application)
 	}
 
-	if app.HealthCheckTimeout > 0 {
-		cmd.manifest.HealthCheckTimeout(app.Name, app.HealthCheckTimeout)
+	cmd.saveManifest(savePath)
+}
+
+func (cmd *CreateAppManifest) addApp(app models.Application) {
+	stackName := ""
+	if app.Stack != nil {
+		stackName = cmd.stackName(app.Stack.Guid)
 	}
 
-	if len(app.EnvironmentVars) > 0 {
-		for k, v := range app.EnvironmentVars {
-			cmd.manifest.EnvironmentVars(app.Name, k, v.(string))
-		}
+	manifest.AddApplication(cmd.manifest, app, stackName)
+}
+
+func (cmd *CreateAppManifest) stackName(guid string) string {
+	if name, ok := cmd.stackNames[guid]; ok {
+		return name
 	}
 
-	if len(app.Routes) > 0 {
-		cmd.manifest.Domain(app.Name, app.Routes[0].Host, app.Routes[0].Domain.Name)
+	stack, apiErr := cmd.stackRepo.FindByGUID(guid)
+	if apiErr != nil {
+		cmd.ui.Failed(T("Error retrieving stack: ") + apiErr.Error())
 	}
 
+	cmd.stackNames[guid] = stack.Name
+	return stack.Name
+}
+
+func (cmd *CreateAppManifest) saveManifest(savePath string) {
+	cmd.manifest.FileSavePath(savePath)
+
 	err := cmd.manifest.Save()
 	if err != nil {
 		cmd.ui.Failed(T("Error creating manifest file: ") + err.Error())
@@ -117,6 +166,4 @@ func (cmd *CreateAppManifest) createManifest(app models.Application, savePath st
 	cmd.ui.Ok()
 	cmd.ui.Say(T("Manifest file created successfully at ") + savePath)
 	cmd.ui.Say("")
-
-	return nil
 }
@@ -5,8 +5,10 @@ import (
 
 	testAppInstanaces "github.com/cloudfoundry/cli/cf/api/app_instances/fakes"
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	testStacks "github.com/cloudfoundry/cli/cf/api/stacks/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
 	"github.com/cloudfoundry/cli/cf/formatters"
 	testManifest "github.com/cloudfoundry/cli/cf/manifest/fakes"
 	"github.com/cloudfoundry/cli/cf/models"
@@ -16,6 +18,7 @@ import (
 	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
 	testtime "github.com/cloudfoundry/cli/testhelpers/time"
 
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -28,6 +31,7 @@ var _ = Describe("create-app-manifest Command", func() {
 		appInstancesRepo    *testAppInstanaces.FakeAppInstancesRepository
 		requirementsFactory *testreq.FakeReqFactory
 		fakeManifest        *testManifest.FakeAppManifest
+		stackRepo           *testStacks.FakeStackRepository
 	)
 
 	BeforeEach(func() {
@@ -35,6 +39,7 @@ var _ = Describe("create-app-manifest Command", func() {
 		ui = &testterm.FakeUI{}
 		appSummaryRepo = &testapi.FakeAppSummaryRepo{}
 		appInstancesRepo = &testAppInstanaces.FakeAppInstancesRepository{}
+		stackRepo = &testStacks.FakeStackRepository{}
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		requirementsFactory = &testreq.FakeReqFactory{
 			LoginSuccess:         true,
@@ -43,7 +48,7 @@ var _ = Describe("create-app-manifest Command", func() {
 	})
 
 	runCommand := func(args ...string) bool {
-		cmd := NewCreateAppManifest(ui, configRepo, appSummaryRepo, fakeManifest)
+		cmd := NewCreateAppManifest(ui, configRepo, appSummaryRepo, stackRepo, fakeManifest)
 		return testcmd.RunCommand(cmd, args, requirementsFactory)
 	}
 
@@ -64,6 +69,12 @@ var _ = Describe("create-app-manifest Command", func() {
 			Expect(passed).To(BeFalse())
 		})
 
+		It("fails with usage when given an app name with --space", func() {
+			passed := runCommand("--space", "my-app")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+			Expect(passed).To(BeFalse())
+		})
+
 	})
 
 	Describe("creating app manifest", func() {
@@ -106,9 +117,47 @@ var _ = Describe("create-app-manifest Command", func() {
 				Ω(fakeManifest.EnvironmentVarsCallCount()).To(Equal(1))
 				Ω(fakeManifest.HealthCheckTimeoutCallCount()).To(Equal(1))
 				Ω(fakeManifest.InstancesCallCount()).To(Equal(1))
-				Ω(fakeManifest.DomainCallCount()).To(Equal(1))
+				Ω(fakeManifest.DomainCallCount()).To(Equal(2))
 				Ω(fakeManifest.ServiceCallCount()).To(Equal(1))
 			})
+
+			It("exports every route", func() {
+				runCommand("my-app")
+
+				appName, host, domain := fakeManifest.DomainArgsForCall(0)
+				Ω([]string{appName, host, domain}).To(Equal([]string{"my-app", "foo", "example.com"}))
+				appName, host, domain = fakeManifest.DomainArgsForCall(1)
+				Ω([]string{appName, host, domain}).To(Equal([]string{"my-app", "my-app", "example.com"}))
+			})
+
+			It("exports the disk quota, buildpack, command and stack", func() {
+				stackRepo.FindByGUIDReturns(models.Stack{Guid: "stack-guid", Name: "cflinuxfs2"}, nil)
+
+				runCommand("my-app")
+
+				_, diskQuota := fakeManifest.DiskQuotaArgsForCall(0)
+				Ω(diskQuota).To(Equal(int64(1024)))
+				_, buildpack := fakeManifest.BuildpackUrlArgsForCall(0)
+				Ω(buildpack).To(Equal("ruby_buildpack"))
+				_, command := fakeManifest.StartCommandArgsForCall(0)
+				Ω(command).To(Equal("bundle exec rackup"))
+
+				Ω(stackRepo.FindByGUIDArgsForCall(0)).To(Equal("stack-guid"))
+				_, stackName := fakeManifest.StackArgsForCall(0)
+				Ω(stackName).To(Equal("cflinuxfs2"))
+			})
+
+			It("fails when the stack cannot be found", func() {
+				stackRepo.FindByGUIDReturns(models.Stack{}, errors.New("stack lookup failed"))
+
+				runCommand("my-app")
+
+				Ω(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"stack lookup failed"},
+				))
+				Ω(fakeManifest.SaveCallCount()).To(Equal(0))
+			})
 		})
 
 		Context("app without Services, Routes, Environment Vars", func() {
@@ -126,6 +175,8 @@ var _ = Describe("create-app-manifest Command", func() {
 				Ω(fakeManifest.InstancesCallCount()).To(Equal(1))
 				Ω(fakeManifest.DomainCallCount()).To(Equal(0))
 				Ω(fakeManifest.ServiceCallCount()).To(Equal(0))
+				Ω(fakeManifest.DiskQuotaCallCount()).To(Equal(0))
+				Ω(fakeManifest.StackCallCount()).To(Equal(0))
 			})
 		})
 
@@ -143,6 +194,58 @@ var _ = Describe("create-app-manifest Command", func() {
 			})
 		})
 
+		Context("when the flag --space is supplied", func() {
+			BeforeEach(func() {
+				firstApp := makeAppWithoutOptions("first-app")
+				firstApp.Guid = "first-app-guid"
+				secondApp := makeAppWithOptions("second-app")
+				secondApp.Guid = "second-app-guid"
+
+				appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{firstApp, secondApp}
+				appSummaryRepo.GetSummaryByGuid = map[string]models.Application{
+					"first-app-guid":  firstApp,
+					"second-app-guid": secondApp,
+				}
+				stackRepo.FindByGUIDReturns(models.Stack{Guid: "stack-guid", Name: "cflinuxfs2"}, nil)
+			})
+
+			It("writes every app in the space to a single manifest", func() {
+				runCommand("--space")
+
+				Ω(fakeManifest.MemoryCallCount()).To(Equal(2))
+				appName, _ := fakeManifest.MemoryArgsForCall(0)
+				Ω(appName).To(Equal("first-app"))
+				appName, _ = fakeManifest.MemoryArgsForCall(1)
+				Ω(appName).To(Equal("second-app"))
+
+				Ω(fakeManifest.DomainCallCount()).To(Equal(2))
+				Ω(fakeManifest.SaveCallCount()).To(Equal(1))
+				Ω(fakeManifest.FileSavePathArgsForCall(0)).To(Equal("./manifest_generated.yml"))
+
+				Ω(ui.Outputs).To(ContainSubstrings(
+					[]string{"Creating an app manifest", "all apps in space", "my-space"},
+					[]string{"OK"},
+				))
+			})
+
+			It("looks up each stack only once", func() {
+				runCommand("--space")
+
+				Ω(stackRepo.FindByGUIDCallCount()).To(Equal(1))
+			})
+
+			It("fails when there are no apps in the space", func() {
+				appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{}
+
+				runCommand("--space")
+
+				Ω(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"No apps found in space my-space"},
+				))
+			})
+		})
+
 	})
 })
 
@@ -162,6 +265,10 @@ func makeAppWithOptions(appName string) models.Application {
 	application.InstanceCount = 2
 	application.RunningInstances = 2
 	application.Memory = 256
+	application.DiskQuota = 1024
+	application.BuildpackUrl = "ruby_buildpack"
+	application.Command = "bundle exec rackup"
+	application.Stack = &models.Stack{Guid: "stack-guid"}
 	application.HealthCheckTimeout = 100
 	application.Routes = []models.RouteSummary{route, secondRoute}
 	application.PackageUpdatedAt = &packgeUpdatedAt
@@ -56,7 +56,7 @@ func (cmd *ListDomains) Run(c *cli.Context) {
 			"Username": terminal.EntityNameColor(cmd.config.Username())}))
 
 	domains := cmd.fetchAllDomains(org.Guid)
-	cmd.printDomainsTable(domains)
+	cmd.printDomainsTable(domains, org.Guid)
 
 	if len(domains) == 0 {
 		cmd.ui.Say(T("No domains found"))
@@ -74,7 +74,7 @@ func (cmd *ListDomains) fetchAllDomains(orgGuid string) (domains []models.Domain
 	return
 }
 
-func (cmd *ListDomains) printDomainsTable(domains []models.DomainFields) {
+func (cmd *ListDomains) printDomainsTable(domains []models.DomainFields, orgGuid string) {
 	table := cmd.ui.Table([]string{T("name"), T("status")})
 
 	for _, domain := range domains {
@@ -84,9 +84,19 @@ func (cmd *ListDomains) printDomainsTable(domains []models.DomainFields) {
 	}
 
 	for _, domain := range domains {
-		if !domain.Shared {
+		if !domain.Shared && !isSharedFromAnotherOrg(domain, orgGuid) {
 			table.Add(domain.Name, T("owned"))
 		}
 	}
+
+	for _, domain := range domains {
+		if isSharedFromAnotherOrg(domain, orgGuid) {
+			table.Add(domain.Name, T("shared from another org"))
+		}
+	}
 	table.Print()
 }
+
+func isSharedFromAnotherOrg(domain models.DomainFields, orgGuid string) bool {
+	return !domain.Shared && domain.OwningOrganizationGuid != "" && domain.OwningOrganizationGuid != orgGuid
+}
@@ -79,8 +79,14 @@ var _ = Describe("domains command", func() {
 						Name:   "The-shared-domain",
 					},
 					models.DomainFields{
-						Shared: false,
-						Name:   "Private-domain2",
+						Shared:                 false,
+						Name:                   "Private-domain2",
+						OwningOrganizationGuid: "my-org-guid",
+					},
+					models.DomainFields{
+						Shared:                 false,
+						Name:                   "Corporate-domain",
+						OwningOrganizationGuid: "other-org-guid",
 					},
 				}
 			})
@@ -95,6 +101,7 @@ var _ = Describe("domains command", func() {
 					[]string{"The-shared-domain", "shared"},
 					[]string{"Private-domain1", "owned"},
 					[]string{"Private-domain2", "owned"},
+					[]string{"Corporate-domain", "shared from another org"},
 				))
 			})
 		})
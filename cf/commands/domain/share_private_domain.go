@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type SharePrivateDomain struct {
+	ui            terminal.UI
+	config        core_config.Reader
+	domainRepo    api.DomainRepository
+	owningOrgReq  requirements.TargetedOrgRequirement
+	sharingOrgReq requirements.OrganizationRequirement
+}
+
+func NewSharePrivateDomain(ui terminal.UI, config core_config.Reader, domainRepo api.DomainRepository) (cmd *SharePrivateDomain) {
+	cmd = new(SharePrivateDomain)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *SharePrivateDomain) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "share-private-domain",
+		Description: T("Share a private domain of the target org with another org"),
+		Usage:       T("CF_NAME share-private-domain ORG DOMAIN"),
+	}
+}
+
+func (cmd *SharePrivateDomain) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.owningOrgReq = requirementsFactory.NewTargetedOrgRequirement()
+	cmd.sharingOrgReq = requirementsFactory.NewOrganizationRequirement(c.Args()[0])
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		cmd.owningOrgReq,
+		cmd.sharingOrgReq,
+	}
+	return
+}
+
+func (cmd *SharePrivateDomain) Run(c *cli.Context) {
+	domainName := c.Args()[1]
+	org := cmd.sharingOrgReq.GetOrganization()
+
+	cmd.ui.Say(T("Sharing domain {{.DomainName}} with org {{.OrgName}} as {{.Username}}...",
+		map[string]interface{}{
+			"DomainName": terminal.EntityNameColor(domainName),
+			"OrgName":    terminal.EntityNameColor(org.Name),
+			"Username":   terminal.EntityNameColor(cmd.config.Username())}))
+
+	domain, ok := findPrivateDomain(cmd.ui, cmd.domainRepo, domainName, cmd.owningOrgReq.GetOrganizationFields())
+	if !ok {
+		return
+	}
+
+	if domain.OwningOrganizationGuid == org.Guid {
+		cmd.ui.Failed(T("Domain {{.DomainName}} is owned by org {{.OrgName}} and cannot be shared with it",
+			map[string]interface{}{"DomainName": domainName, "OrgName": org.Name}))
+		return
+	}
+
+	apiErr := cmd.domainRepo.SharePrivateDomain(domain.Guid, org.Guid)
+	if apiErr != nil {
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+}
+
+// findPrivateDomain looks up a private domain owned by the given org and
+// fails when it does not exist or is a shared domain.
+func findPrivateDomain(ui terminal.UI, domainRepo api.DomainRepository, domainName string, owningOrg models.OrganizationFields) (models.DomainFields, bool) {
+	domain, apiErr := domainRepo.FindByNameInOrg(domainName, owningOrg.Guid)
+	if apiErr != nil {
+		ui.Failed(apiErr.Error())
+		return domain, false
+	}
+
+	if domain.Shared {
+		ui.Failed(T("Domain {{.DomainName}} is a shared domain, not a private domain",
+			map[string]interface{}{"DomainName": domainName}))
+		return domain, false
+	}
+
+	if domain.OwningOrganizationGuid != "" && domain.OwningOrganizationGuid != owningOrg.Guid {
+		ui.Failed(T("Domain {{.DomainName}} is not owned by org {{.OrgName}}",
+			map[string]interface{}{"DomainName": domainName, "OrgName": owningOrg.Name}))
+		return domain, false
+	}
+
+	return domain, true
+}
@@ -0,0 +1,124 @@
+package domain_test
+
+import (
+	"errors"
+
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/commands/domain"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("share-private-domain command", func() {
+	var (
+		requirementsFactory *testreq.FakeReqFactory
+		ui                  *testterm.FakeUI
+		domainRepo          *testapi.FakeDomainRepository
+		configRepo          core_config.ReadWriter
+	)
+
+	BeforeEach(func() {
+		ui = new(testterm.FakeUI)
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		domainRepo = &testapi.FakeDomainRepository{}
+		requirementsFactory = &testreq.FakeReqFactory{
+			LoginSuccess:       true,
+			TargetedOrgSuccess: true,
+			OrganizationFields: models.OrganizationFields{Name: "my-org", Guid: "my-org-guid"},
+		}
+		requirementsFactory.Organization.Name = "business-unit"
+		requirementsFactory.Organization.Guid = "business-unit-guid"
+
+		domainRepo.FindByNameInOrgDomain = models.DomainFields{
+			Name:                   "corp.example.com",
+			Guid:                   "corp-domain-guid",
+			OwningOrganizationGuid: "my-org-guid",
+		}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCommand(domain.NewSharePrivateDomain(ui, configRepo, domainRepo), args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given an org and a domain", func() {
+			runCommand("business-unit")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand("business-unit", "corp.example.com")).To(BeFalse())
+		})
+
+		It("fails when an org is not targeted", func() {
+			requirementsFactory.TargetedOrgSuccess = false
+			Expect(runCommand("business-unit", "corp.example.com")).To(BeFalse())
+		})
+	})
+
+	It("shares a domain owned by the targeted org with another org", func() {
+		runCommand("business-unit", "corp.example.com")
+
+		Expect(requirementsFactory.OrganizationName).To(Equal("business-unit"))
+		Expect(domainRepo.FindByNameInOrgName).To(Equal("corp.example.com"))
+		Expect(domainRepo.FindByNameInOrgGuid).To(Equal("my-org-guid"))
+		Expect(domainRepo.SharePrivateDomainGuid).To(Equal("corp-domain-guid"))
+		Expect(domainRepo.SharePrivateDomainOrgGuid).To(Equal("business-unit-guid"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Sharing domain", "corp.example.com", "business-unit", "my-user"},
+			[]string{"OK"},
+		))
+	})
+
+	It("fails for shared domains", func() {
+		domainRepo.FindByNameInOrgDomain = models.DomainFields{Name: "cfapps.io", Shared: true}
+
+		runCommand("business-unit", "cfapps.io")
+
+		Expect(domainRepo.SharePrivateDomainGuid).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"cfapps.io", "is a shared domain"}))
+	})
+
+	It("fails for private domains owned by another org", func() {
+		domainRepo.FindByNameInOrgDomain.OwningOrganizationGuid = "someone-else-guid"
+
+		runCommand("business-unit", "corp.example.com")
+
+		Expect(domainRepo.SharePrivateDomainGuid).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"is not owned by org my-org"}))
+	})
+
+	It("fails when sharing with the owning org", func() {
+		requirementsFactory.Organization.Guid = "my-org-guid"
+
+		runCommand("my-org", "corp.example.com")
+
+		Expect(domainRepo.SharePrivateDomainGuid).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"cannot be shared"}))
+	})
+
+	It("fails when the domain cannot be found", func() {
+		domainRepo.FindByNameInOrgApiResponse = errors.New("domain not found")
+
+		runCommand("business-unit", "corp.example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"domain not found"}))
+	})
+
+	It("fails when sharing fails", func() {
+		domainRepo.SharePrivateDomainErr = errors.New("not authorized")
+
+		runCommand("business-unit", "corp.example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"not authorized"}))
+	})
+})
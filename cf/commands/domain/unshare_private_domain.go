@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type UnsharePrivateDomain struct {
+	ui            terminal.UI
+	config        core_config.Reader
+	domainRepo    api.DomainRepository
+	owningOrgReq  requirements.TargetedOrgRequirement
+	sharingOrgReq requirements.OrganizationRequirement
+}
+
+func NewUnsharePrivateDomain(ui terminal.UI, config core_config.Reader, domainRepo api.DomainRepository) (cmd *UnsharePrivateDomain) {
+	cmd = new(UnsharePrivateDomain)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.domainRepo = domainRepo
+	return
+}
+
+func (cmd *UnsharePrivateDomain) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "unshare-private-domain",
+		Description: T("Stop sharing a private domain of the target org with another org"),
+		Usage:       T("CF_NAME unshare-private-domain ORG DOMAIN"),
+	}
+}
+
+func (cmd *UnsharePrivateDomain) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.owningOrgReq = requirementsFactory.NewTargetedOrgRequirement()
+	cmd.sharingOrgReq = requirementsFactory.NewOrganizationRequirement(c.Args()[0])
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		cmd.owningOrgReq,
+		cmd.sharingOrgReq,
+	}
+	return
+}
+
+func (cmd *UnsharePrivateDomain) Run(c *cli.Context) {
+	domainName := c.Args()[1]
+	org := cmd.sharingOrgReq.GetOrganization()
+
+	cmd.ui.Say(T("Unsharing domain {{.DomainName}} from org {{.OrgName}} as {{.Username}}...",
+		map[string]interface{}{
+			"DomainName": terminal.EntityNameColor(domainName),
+			"OrgName":    terminal.EntityNameColor(org.Name),
+			"Username":   terminal.EntityNameColor(cmd.config.Username())}))
+
+	domain, ok := findPrivateDomain(cmd.ui, cmd.domainRepo, domainName, cmd.owningOrgReq.GetOrganizationFields())
+	if !ok {
+		return
+	}
+
+	if domain.OwningOrganizationGuid == org.Guid {
+		cmd.ui.Failed(T("Domain {{.DomainName}} is owned by org {{.OrgName}} and cannot be unshared from it",
+			map[string]interface{}{"DomainName": domainName, "OrgName": org.Name}))
+		return
+	}
+
+	apiErr := cmd.domainRepo.UnsharePrivateDomain(domain.Guid, org.Guid)
+	if apiErr != nil {
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+}
@@ -0,0 +1,90 @@
+package domain_test
+
+import (
+	"errors"
+
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/commands/domain"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("unshare-private-domain command", func() {
+	var (
+		requirementsFactory *testreq.FakeReqFactory
+		ui                  *testterm.FakeUI
+		domainRepo          *testapi.FakeDomainRepository
+		configRepo          core_config.ReadWriter
+	)
+
+	BeforeEach(func() {
+		ui = new(testterm.FakeUI)
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		domainRepo = &testapi.FakeDomainRepository{}
+		requirementsFactory = &testreq.FakeReqFactory{
+			LoginSuccess:       true,
+			TargetedOrgSuccess: true,
+			OrganizationFields: models.OrganizationFields{Name: "my-org", Guid: "my-org-guid"},
+		}
+		requirementsFactory.Organization.Name = "business-unit"
+		requirementsFactory.Organization.Guid = "business-unit-guid"
+
+		domainRepo.FindByNameInOrgDomain = models.DomainFields{
+			Name:                   "corp.example.com",
+			Guid:                   "corp-domain-guid",
+			OwningOrganizationGuid: "my-org-guid",
+		}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCommand(domain.NewUnsharePrivateDomain(ui, configRepo, domainRepo), args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given an org and a domain", func() {
+			runCommand("business-unit")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand("business-unit", "corp.example.com")).To(BeFalse())
+		})
+	})
+
+	It("stops sharing the domain with the org", func() {
+		runCommand("business-unit", "corp.example.com")
+
+		Expect(domainRepo.UnsharePrivateDomainGuid).To(Equal("corp-domain-guid"))
+		Expect(domainRepo.UnsharePrivateDomainOrgGuid).To(Equal("business-unit-guid"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Unsharing domain", "corp.example.com", "business-unit", "my-user"},
+			[]string{"OK"},
+		))
+	})
+
+	It("fails when unsharing from the owning org", func() {
+		requirementsFactory.Organization.Guid = "my-org-guid"
+
+		runCommand("my-org", "corp.example.com")
+
+		Expect(domainRepo.UnsharePrivateDomainGuid).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"cannot be unshared"}))
+	})
+
+	It("fails when unsharing fails", func() {
+		domainRepo.UnsharePrivateDomainErr = errors.New("not authorized")
+
+		runCommand("business-unit", "corp.example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"not authorized"}))
+	})
+})
@@ -1,8 +1,6 @@
 package commands
 
 import (
-	"strconv"
-
 	. "github.com/cloudfoundry/cli/cf/i18n"
 
 	"github.com/cloudfoundry/cli/cf/api"
@@ -19,7 +17,6 @@ import (
 )
 
 const maxLoginTries = 3
-const maxChoices = 50
 
 type Login struct {
 	ui            terminal.UI
@@ -220,7 +217,7 @@ func (cmd Login) setOrganization(c *cli.Context) (isOrgSet bool) {
 				map[string]interface{}{"ApiErr": apiErr.Error()}))
 		}
 		for _, org := range orgs {
-			if len(availableOrgs) < maxChoices {
+			if len(availableOrgs) < terminal.MaxChoices {
 				availableOrgs = append(availableOrgs, org)
 			}
 		}
@@ -271,7 +268,7 @@ func (cmd Login) setSpace(c *cli.Context) {
 		var availableSpaces []models.Space
 		err := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
 			availableSpaces = append(availableSpaces, space)
-			return (len(availableSpaces) < maxChoices)
+			return (len(availableSpaces) < terminal.MaxChoices)
 		})
 		if err != nil {
 			cmd.ui.Failed(T("Error finding available spaces\n{{.Err}}",
@@ -317,35 +314,5 @@ func (cmd Login) targetSpace(space models.Space) {
 }
 
 func (cmd Login) promptForName(names []string, listPrompt, itemPrompt string) string {
-	nameIndex := 0
-	var nameString string
-	for nameIndex < 1 || nameIndex > len(names) {
-		var err error
-
-		// list header
-		cmd.ui.Say(listPrompt)
-
-		// only display list if it is shorter than maxChoices
-		if len(names) < maxChoices {
-			for i, name := range names {
-				cmd.ui.Say("%d. %s", i+1, name)
-			}
-		} else {
-			cmd.ui.Say(T("There are too many options to display, please type in the name."))
-		}
-
-		nameString = cmd.ui.Ask("%s", itemPrompt)
-		if nameString == "" {
-			return ""
-		}
-
-		nameIndex, err = strconv.Atoi(nameString)
-
-		if err != nil {
-			nameIndex = 1
-			return nameString
-		}
-	}
-
-	return names[nameIndex-1]
+	return terminal.PromptForChoice(cmd.ui, names, listPrompt, itemPrompt)
 }
@@ -0,0 +1,95 @@
+package organization
+
+import (
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ApplyConfig struct {
+	ui     terminal.UI
+	config core_config.Reader
+	actor  org_config.OrgConfigActor
+}
+
+func NewApplyConfig(ui terminal.UI, config core_config.Reader, actor org_config.OrgConfigActor) (cmd *ApplyConfig) {
+	return &ApplyConfig{
+		ui:     ui,
+		config: config,
+		actor:  actor,
+	}
+}
+
+func (cmd *ApplyConfig) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "apply-config",
+		Description: T("Converge orgs and spaces with a configuration file"),
+		Usage: T(`CF_NAME apply-config CONFIG_FILE
+
+   Makes the changes shown by plan-config. See 'CF_NAME help plan-config' for the format of the configuration file.`),
+	}
+}
+
+func (cmd *ApplyConfig) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ApplyConfig) Run(c *cli.Context) {
+	configFile := c.Args()[0]
+	orgConfig, err := readOrgConfig(configFile)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Say(T("Applying org configuration {{.ConfigFile}} as {{.Username}}...",
+		map[string]interface{}{
+			"ConfigFile": terminal.EntityNameColor(configFile),
+			"Username":   terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	changes, err := cmd.actor.Plan(orgConfig)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if len(changes) == 0 {
+		cmd.ui.Ok()
+		cmd.ui.Say(T("Orgs and spaces already match the configuration"))
+		return
+	}
+
+	cmd.ui.Say("")
+	for _, change := range changes {
+		target := terminal.EntityNameColor(change.OrgName)
+		if change.SpaceName != "" {
+			target = target + " / " + terminal.EntityNameColor(change.SpaceName)
+		}
+		cmd.ui.Say(T("{{.Target}}: {{.Change}}", map[string]interface{}{
+			"Target": target,
+			"Change": describeOrgConfigChange(change),
+		}))
+
+		err = cmd.actor.Apply(change)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+	}
+	cmd.ui.Say("")
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("{{.ChangeCount}} changes made", map[string]interface{}{"ChangeCount": len(changes)}))
+}
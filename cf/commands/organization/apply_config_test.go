@@ -0,0 +1,125 @@
+package organization_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+	"github.com/cloudfoundry/cli/cf/actors/org_config/fakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/organization"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("apply-config command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		actor               *fakes.FakeOrgConfigActor
+		requirementsFactory *testreq.FakeReqFactory
+		configFile          *os.File
+		changes             []org_config.Change
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		actor = &fakes.FakeOrgConfigActor{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+
+		var err error
+		configFile, err = ioutil.TempFile("", "org-config")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = configFile.WriteString(`
+orgs:
+- name: new-org
+  spaces:
+  - name: dev
+    managers:
+    - alice
+`)
+		Expect(err).NotTo(HaveOccurred())
+		configFile.Close()
+
+		changes = []org_config.Change{
+			{Type: org_config.CreateOrg, OrgName: "new-org"},
+			{Type: org_config.CreateSpace, OrgName: "new-org", SpaceName: "dev"},
+			{Type: org_config.SetSpaceRole, OrgName: "new-org", SpaceName: "dev", Username: "alice", Role: models.SPACE_MANAGER},
+		}
+		actor.PlanReturns(changes, nil)
+	})
+
+	AfterEach(func() {
+		os.Remove(configFile.Name())
+	})
+
+	runCommand := func(args ...string) bool {
+		cmd := NewApplyConfig(ui, testconfig.NewRepositoryWithDefaults(), actor)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand(configFile.Name())).To(BeFalse())
+		})
+
+		It("fails with usage without a config file", func() {
+			runCommand()
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
+	It("applies and reports each planned change in order", func() {
+		runCommand(configFile.Name())
+
+		Expect(actor.PlanArgsForCall(0).Orgs[0].Name).To(Equal("new-org"))
+		Expect(actor.ApplyCallCount()).To(Equal(3))
+		for i, change := range changes {
+			Expect(actor.ApplyArgsForCall(i)).To(Equal(change))
+		}
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Applying org configuration", configFile.Name(), "my-user"},
+			[]string{"new-org: create org"},
+			[]string{"new-org / dev: create space"},
+			[]string{"new-org / dev: give alice role SpaceManager"},
+			[]string{"OK"},
+			[]string{"3 changes made"},
+		))
+	})
+
+	It("stops at the first change that fails", func() {
+		actor.ApplyStub = func(change org_config.Change) error {
+			if change.Type == org_config.CreateSpace {
+				return errors.New("space quota exceeded")
+			}
+			return nil
+		}
+
+		runCommand(configFile.Name())
+
+		Expect(actor.ApplyCallCount()).To(Equal(2))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"new-org / dev: create space"},
+			[]string{"FAILED"},
+			[]string{"space quota exceeded"},
+		))
+		Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"give alice role"}))
+	})
+
+	It("does nothing when the orgs and spaces already match", func() {
+		actor.PlanReturns([]org_config.Change{}, nil)
+
+		runCommand(configFile.Name())
+
+		Expect(actor.ApplyCallCount()).To(Equal(0))
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"Orgs and spaces already match the configuration"}))
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/ui_helpers"
 	"github.com/codegangsta/cli"
 )
 
@@ -50,7 +51,11 @@ func (cmd *DeleteOrg) GetRequirements(requirementsFactory requirements.Factory,
 func (cmd *DeleteOrg) Run(c *cli.Context) {
 	orgName := c.Args()[0]
 
-	if !c.Bool("f") {
+	if core_config.IsProtectedOrg(cmd.config.ProtectedTargets(), orgName) {
+		if !ui_helpers.ConfirmProtectedDelete(cmd.ui, T("org"), orgName, c.Bool("f")) {
+			return
+		}
+	} else if !c.Bool("f") {
 		if !cmd.ui.ConfirmDeleteWithAssociations(T("org"), orgName) {
 			return
 		}
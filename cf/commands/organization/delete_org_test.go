@@ -1,6 +1,8 @@
 package organization_test
 
 import (
+	"os"
+
 	"github.com/cloudfoundry/cli/cf/errors"
 
 	test_org "github.com/cloudfoundry/cli/cf/api/organizations/fakes"
@@ -129,5 +131,45 @@ var _ = Describe("delete-org command", func() {
 			))
 			Expect(ui.WarnOutputs).To(ContainSubstrings([]string{"org-to-delete", "does not exist."}))
 		})
+
+		Context("when the org holds a protected space", func() {
+			BeforeEach(func() {
+				config.SetProtectedTargets([]string{"org-to-delete/production"})
+			})
+
+			AfterEach(func() {
+				os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "")
+			})
+
+			It("requires the org name to be typed back", func() {
+				runCommand("org-to-delete")
+				Expect(orgRepo.DeleteCallCount()).To(Equal(0))
+
+				ui.Inputs = []string{"org-to-delete"}
+				runCommand("org-to-delete")
+				Expect(ui.Prompts).To(ContainSubstrings([]string{"protected target", "org", "org-to-delete"}))
+				Expect(orgRepo.DeleteArgsForCall(0)).To(Equal("org-to-delete-guid"))
+			})
+
+			It("protects the org when its name is given in another case", func() {
+				runCommand("-f", "ORG-TO-DELETE")
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+				Expect(orgRepo.DeleteCallCount()).To(Equal(0))
+
+				ui.Inputs = []string{"y"}
+				runCommand("ORG-TO-DELETE")
+				Expect(orgRepo.DeleteCallCount()).To(Equal(0))
+			})
+
+			It("refuses the -f flag unless the override is set", func() {
+				runCommand("-f", "org-to-delete")
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+				Expect(orgRepo.DeleteCallCount()).To(Equal(0))
+
+				os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "true")
+				runCommand("-f", "org-to-delete")
+				Expect(orgRepo.DeleteArgsForCall(0)).To(Equal("org-to-delete-guid"))
+			})
+		})
 	})
 })
@@ -0,0 +1,359 @@
+package organization
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudfoundry-incubator/candiedyaml"
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/application_bits"
+	"github.com/cloudfoundry/cli/cf/api/spaces"
+	"github.com/cloudfoundry/cli/cf/api/stacks"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+// An org archive is a directory holding org.yml, in the format read by
+// plan-config, and a directory per space under spaces/. Each space directory
+// holds a manifest.yml of its apps, a resources.yml of its service instances
+// and routes, and optionally the apps' packages under packages/.
+const (
+	orgArchiveOrgFile       = "org.yml"
+	orgArchiveSpacesDir     = "spaces"
+	orgArchiveManifestFile  = "manifest.yml"
+	orgArchiveResourcesFile = "resources.yml"
+	orgArchivePackagesDir   = "packages"
+)
+
+type orgArchiveResources struct {
+	Services []orgArchiveService `yaml:"services,omitempty"`
+	Routes   []orgArchiveRoute   `yaml:"routes,omitempty"`
+}
+
+// orgArchiveService is a service instance. User-provided instances keep
+// their credentials as a JSON object so that their types survive the trip.
+type orgArchiveService struct {
+	Name           string   `yaml:"name"`
+	Service        string   `yaml:"service,omitempty"`
+	Plan           string   `yaml:"plan,omitempty"`
+	UserProvided   bool     `yaml:"user_provided,omitempty"`
+	Credentials    string   `yaml:"credentials,omitempty"`
+	SyslogDrainUrl string   `yaml:"syslog_drain_url,omitempty"`
+	Tags           []string `yaml:"tags,omitempty"`
+	Apps           []string `yaml:"apps,omitempty"`
+}
+
+type orgArchiveRoute struct {
+	Host   string   `yaml:"host,omitempty"`
+	Domain string   `yaml:"domain"`
+	Apps   []string `yaml:"apps,omitempty"`
+}
+
+type ExportOrg struct {
+	ui                      terminal.UI
+	config                  core_config.Reader
+	actor                   org_config.OrgConfigActor
+	spaceRepo               spaces.SpaceRepository
+	appSummaryRepo          api.AppSummaryRepository
+	stackRepo               stacks.StackRepository
+	serviceSummaryRepo      api.ServiceSummaryRepository
+	userProvidedServiceRepo api.UserProvidedServiceInstanceRepository
+	routeRepo               api.RouteRepository
+	appBitsRepo             application_bits.ApplicationBitsRepository
+	orgReq                  requirements.OrganizationRequirement
+	stackNames              map[string]string
+}
+
+func NewExportOrg(ui terminal.UI, config core_config.Reader, actor org_config.OrgConfigActor, spaceRepo spaces.SpaceRepository, appSummaryRepo api.AppSummaryRepository, stackRepo stacks.StackRepository, serviceSummaryRepo api.ServiceSummaryRepository, userProvidedServiceRepo api.UserProvidedServiceInstanceRepository, routeRepo api.RouteRepository, appBitsRepo application_bits.ApplicationBitsRepository) (cmd *ExportOrg) {
+	return &ExportOrg{
+		ui:                      ui,
+		config:                  config,
+		actor:                   actor,
+		spaceRepo:               spaceRepo,
+		appSummaryRepo:          appSummaryRepo,
+		stackRepo:               stackRepo,
+		serviceSummaryRepo:      serviceSummaryRepo,
+		userProvidedServiceRepo: userProvidedServiceRepo,
+		routeRepo:               routeRepo,
+		appBitsRepo:             appBitsRepo,
+	}
+}
+
+func (cmd *ExportOrg) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "export-org",
+		Description: T("Write an org's spaces, apps, services, routes, roles and security groups to a directory"),
+		Usage: T(`CF_NAME export-org ORG DIR [--include-packages]
+
+   Writes DIR/org.yml in the format read by plan-config, and for each space a manifest.yml of its apps, including their environment variables, and a resources.yml of its service instances and routes. Use import-org to recreate the org from DIR.
+
+   With --include-packages, the last uploaded package of each app is saved as well. Droplets are not exported, so imported apps are staged again when they are started.
+
+   WARNING: The export includes environment variables and the credentials of user-provided services. Keep it somewhere safe.
+
+EXAMPLE:
+   CF_NAME export-org my-org ./my-org-export --include-packages`),
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "include-packages", Usage: T("Also download the package of each app")},
+		},
+	}
+}
+
+func (cmd *ExportOrg) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.orgReq = requirementsFactory.NewOrganizationRequirement(c.Args()[0])
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		cmd.orgReq,
+	}
+	return
+}
+
+func (cmd *ExportOrg) Run(c *cli.Context) {
+	org := cmd.orgReq.GetOrganization()
+	dir := c.Args()[1]
+	cmd.stackNames = map[string]string{}
+
+	cmd.ui.Say(T("Exporting org {{.OrgName}} to {{.Dir}} as {{.Username}}...",
+		map[string]interface{}{
+			"OrgName":  terminal.EntityNameColor(org.Name),
+			"Dir":      terminal.EntityNameColor(dir),
+			"Username": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	orgDef, err := cmd.actor.Export(org.Name)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	err = os.MkdirAll(dir, os.ModePerm)
+	if err == nil {
+		err = writeOrgArchiveFile(filepath.Join(dir, orgArchiveOrgFile), org_config.OrgConfig{Orgs: []org_config.OrgDefinition{orgDef}})
+	}
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	routesBySpaceGuid := map[string][]models.Route{}
+	err = cmd.routeRepo.ListRoutesInOrg(org.Guid, func(route models.Route) bool {
+		routesBySpaceGuid[route.Space.Guid] = append(routesBySpaceGuid[route.Space.Guid], route)
+		return true
+	})
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	spacesByName := map[string]models.Space{}
+	err = cmd.spaceRepo.ListSpacesFromOrg(org.Guid, func(space models.Space) bool {
+		spacesByName[space.Name] = space
+		return true
+	})
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	for _, spaceDef := range orgDef.Spaces {
+		cmd.ui.Say(T("Exporting space {{.SpaceName}}...", map[string]interface{}{"SpaceName": terminal.EntityNameColor(spaceDef.Name)}))
+
+		space, found := spacesByName[spaceDef.Name]
+		if !found {
+			cmd.ui.Failed(errors.NewModelNotFoundError("Space", spaceDef.Name).Error())
+			return
+		}
+
+		spaceDir, err := orgArchivePath(filepath.Join(dir, orgArchiveSpacesDir), space.Name)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+
+		err = cmd.exportSpace(space, spaceDir, routesBySpaceGuid[space.Guid], c.Bool("include-packages"))
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Exported {{.SpaceCount}} spaces to {{.Dir}}",
+		map[string]interface{}{"SpaceCount": len(orgDef.Spaces), "Dir": dir}))
+}
+
+func (cmd *ExportOrg) exportSpace(space models.Space, spaceDir string, routes []models.Route, includePackages bool) error {
+	err := os.MkdirAll(spaceDir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	apps, err := cmd.appSummaryRepo.GetSummariesInSpace(space.Guid)
+	if err != nil {
+		return err
+	}
+
+	if len(apps) > 0 {
+		appManifest := manifest.NewGenerator()
+		for _, app := range apps {
+			summary, err := cmd.appSummaryRepo.GetSummary(app.Guid)
+			if err != nil {
+				return err
+			}
+
+			stackName := ""
+			if summary.Stack != nil {
+				stackName, err = cmd.stackName(summary.Stack.Guid)
+				if err != nil {
+					return err
+				}
+			}
+			manifest.AddApplication(appManifest, summary, stackName)
+
+			if includePackages {
+				err = cmd.downloadPackage(app.ApplicationFields, filepath.Join(spaceDir, orgArchivePackagesDir))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		appManifest.FileSavePath(filepath.Join(spaceDir, orgArchiveManifestFile))
+		err = appManifest.Save()
+		if err != nil {
+			return err
+		}
+	}
+
+	resources := orgArchiveResources{}
+
+	instances, err := cmd.serviceSummaryRepo.GetSummariesInSpace(space.Guid)
+	if err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		service := orgArchiveService{
+			Name: instance.Name,
+			Tags: instance.Tags,
+			Apps: instance.ApplicationNames,
+		}
+
+		if instance.IsUserProvided() {
+			fields, err := cmd.userProvidedServiceRepo.FindByGuid(instance.Guid)
+			if err != nil {
+				return err
+			}
+
+			credentials, err := json.Marshal(fields.Params)
+			if err != nil {
+				return err
+			}
+
+			service.UserProvided = true
+			service.Credentials = string(credentials)
+			service.SyslogDrainUrl = fields.SysLogDrainUrl
+		} else {
+			service.Service = instance.ServiceOffering.Label
+			service.Plan = instance.ServicePlan.Name
+		}
+
+		resources.Services = append(resources.Services, service)
+	}
+
+	for _, route := range routes {
+		archived := orgArchiveRoute{Host: route.Host, Domain: route.Domain.Name}
+		for _, app := range route.Apps {
+			archived.Apps = append(archived.Apps, app.Name)
+		}
+		resources.Routes = append(resources.Routes, archived)
+	}
+
+	return writeOrgArchiveFile(filepath.Join(spaceDir, orgArchiveResourcesFile), resources)
+}
+
+// downloadPackage saves the app's package as packages/APP.zip. An app that
+// has never had bits uploaded is skipped with a warning.
+func (cmd *ExportOrg) downloadPackage(app models.ApplicationFields, packagesDir string) error {
+	err := os.MkdirAll(packagesDir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	path, err := orgArchivePath(packagesDir, app.Name)
+	if err != nil {
+		return err
+	}
+	path += ".zip"
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.appBitsRepo.DownloadBits(app.Guid, file)
+	file.Close()
+	if err != nil {
+		os.Remove(path)
+		cmd.ui.Warn(T("Could not download the package of app {{.AppName}}: {{.Error}}",
+			map[string]interface{}{"AppName": app.Name, "Error": err.Error()}))
+	}
+	return nil
+}
+
+func (cmd *ExportOrg) stackName(guid string) (string, error) {
+	if name, ok := cmd.stackNames[guid]; ok {
+		return name, nil
+	}
+
+	stack, err := cmd.stackRepo.FindByGUID(guid)
+	if err != nil {
+		return "", err
+	}
+
+	cmd.stackNames[guid] = stack.Name
+	return stack.Name, nil
+}
+
+// orgArchivePath joins a space or app name onto dir. Names come from the API
+// or from the archive itself, so any that would lead out of dir are refused.
+func orgArchivePath(dir, name string) (string, error) {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) || filepath.Base(name) != name {
+		return "", errors.New(T("{{.Name}} cannot be used as a file name in an org archive",
+			map[string]interface{}{"Name": name}))
+	}
+	return filepath.Join(dir, name), nil
+}
+
+func writeOrgArchiveFile(path string, value interface{}) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return candiedyaml.NewEncoder(file).Encode(value)
+}
+
+func readOrgArchiveFile(path string, value interface{}) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return candiedyaml.NewDecoder(file).Decode(value)
+}
@@ -0,0 +1,279 @@
+package organization_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+	orgconfigfakes "github.com/cloudfoundry/cli/cf/actors/org_config/fakes"
+	bitsfakes "github.com/cloudfoundry/cli/cf/api/application_bits/fakes"
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	stackfakes "github.com/cloudfoundry/cli/cf/api/stacks/fakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/organization"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("export-org command", func() {
+	var (
+		ui                      *testterm.FakeUI
+		requirementsFactory     *testreq.FakeReqFactory
+		actor                   *orgconfigfakes.FakeOrgConfigActor
+		spaceRepo               *testapi.FakeSpaceRepository
+		appSummaryRepo          *testapi.FakeAppSummaryRepo
+		stackRepo               *stackfakes.FakeStackRepository
+		serviceSummaryRepo      *testapi.FakeServiceSummaryRepo
+		userProvidedServiceRepo *testapi.FakeUserProvidedServiceInstanceRepo
+		routeRepo               *testapi.FakeRouteRepository
+		appBitsRepo             *bitsfakes.FakeApplicationBitsRepository
+		dir                     string
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		actor = &orgconfigfakes.FakeOrgConfigActor{}
+		spaceRepo = &testapi.FakeSpaceRepository{}
+		appSummaryRepo = &testapi.FakeAppSummaryRepo{}
+		stackRepo = &stackfakes.FakeStackRepository{}
+		serviceSummaryRepo = &testapi.FakeServiceSummaryRepo{}
+		userProvidedServiceRepo = &testapi.FakeUserProvidedServiceInstanceRepo{}
+		routeRepo = &testapi.FakeRouteRepository{}
+		appBitsRepo = &bitsfakes.FakeApplicationBitsRepository{}
+
+		org := models.Organization{}
+		org.Name = "my-org"
+		org.Guid = "my-org-guid"
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true, Organization: org}
+
+		var err error
+		dir, err = ioutil.TempDir("", "export-org")
+		Expect(err).NotTo(HaveOccurred())
+
+		actor.ExportReturns(org_config.OrgDefinition{
+			Name:     "my-org",
+			Quota:    "big",
+			Managers: []string{"alice"},
+			Spaces:   []org_config.SpaceDefinition{{Name: "dev", Developers: []string{"bob"}}},
+		}, nil)
+
+		space := models.Space{}
+		space.Name = "dev"
+		space.Guid = "dev-guid"
+		spaceRepo.Spaces = []models.Space{space}
+
+		app := models.Application{}
+		app.Name = "web"
+		app.Guid = "web-guid"
+		app.Memory = 256
+		app.InstanceCount = 2
+		app.Stack = &models.Stack{Guid: "stack-guid"}
+		app.EnvironmentVars = map[string]interface{}{"DEBUG": "true"}
+		appSummaryRepo.GetSummariesInSpaceApps = []models.Application{app}
+		appSummaryRepo.GetSummarySummary = app
+		stackRepo.FindByGUIDReturns(models.Stack{Name: "cflinuxfs2", Guid: "stack-guid"}, nil)
+
+		db := models.ServiceInstance{}
+		db.Name = "db"
+		db.ApplicationNames = []string{"web"}
+		db.ServicePlan = models.ServicePlanFields{Name: "small", Guid: "small-guid"}
+		db.ServiceOffering = models.ServiceOfferingFields{Label: "mysql"}
+		logs := models.ServiceInstance{}
+		logs.Name = "logs"
+		logs.Guid = "logs-guid"
+		serviceSummaryRepo.GetSummariesInSpaceInstances = []models.ServiceInstance{db, logs}
+		userProvidedServiceRepo.FindByGuidServiceInstanceFields = models.ServiceInstanceFields{
+			Params:         map[string]interface{}{"port": float64(514)},
+			SysLogDrainUrl: "syslog://example.com",
+		}
+
+		routeRepo.OrgRoutes = []models.Route{
+			{
+				Host:   "web",
+				Domain: models.DomainFields{Name: "example.com"},
+				Space:  models.SpaceFields{Guid: "dev-guid"},
+				Apps:   []models.ApplicationFields{{Name: "web"}},
+			},
+			{
+				Host:   "other",
+				Domain: models.DomainFields{Name: "example.com"},
+				Space:  models.SpaceFields{Guid: "other-guid"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	runCommand := func(args ...string) bool {
+		cmd := NewExportOrg(ui, testconfig.NewRepositoryWithDefaults(), actor, spaceRepo, appSummaryRepo, stackRepo, serviceSummaryRepo, userProvidedServiceRepo, routeRepo, appBitsRepo)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	readFile := func(path ...string) string {
+		contents, err := ioutil.ReadFile(filepath.Join(append([]string{dir}, path...)...))
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	Describe("requirements", func() {
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand("my-org", dir)).To(BeFalse())
+		})
+
+		It("fails with usage without an org and a directory", func() {
+			runCommand("my-org")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
+	It("writes the org configuration", func() {
+		runCommand("my-org", dir)
+
+		Expect(actor.ExportArgsForCall(0)).To(Equal("my-org"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Exporting org", "my-org", "my-user"},
+			[]string{"Exporting space", "dev"},
+			[]string{"OK"},
+			[]string{"Exported 1 spaces"},
+		))
+
+		orgConfig, err := org_config.ParseOrgConfig(mustOpen(filepath.Join(dir, "org.yml")))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(orgConfig.Orgs).To(HaveLen(1))
+		Expect(orgConfig.Orgs[0].Quota).To(Equal("big"))
+		Expect(orgConfig.Orgs[0].Managers).To(Equal([]string{"alice"}))
+		Expect(orgConfig.Orgs[0].Spaces[0].Developers).To(Equal([]string{"bob"}))
+	})
+
+	It("writes a manifest of the apps in each space", func() {
+		runCommand("my-org", dir)
+
+		Expect(spaceRepo.ListSpacesFromOrgGuid).To(Equal("my-org-guid"))
+		Expect(appSummaryRepo.GetSummariesInSpaceGuid).To(Equal("dev-guid"))
+		Expect(appSummaryRepo.GetSummaryAppGuid).To(Equal("web-guid"))
+
+		manifest := readFile("spaces", "dev", "manifest.yml")
+		Expect(manifest).To(ContainSubstring("- name: web"))
+		Expect(manifest).To(ContainSubstring("memory: 256M"))
+		Expect(manifest).To(ContainSubstring("stack: cflinuxfs2"))
+		Expect(manifest).To(ContainSubstring("DEBUG:"))
+	})
+
+	It("writes the service instances and routes of each space", func() {
+		runCommand("my-org", dir)
+
+		Expect(serviceSummaryRepo.GetSummariesInSpaceGuid).To(Equal("dev-guid"))
+		Expect(userProvidedServiceRepo.FindByGuidGuid).To(Equal("logs-guid"))
+		Expect(routeRepo.ListRoutesInOrgGuid).To(Equal("my-org-guid"))
+
+		resources := readFile("spaces", "dev", "resources.yml")
+		Expect(resources).To(ContainSubstring("name: db"))
+		Expect(resources).To(ContainSubstring("service: mysql"))
+		Expect(resources).To(ContainSubstring("plan: small"))
+		Expect(resources).To(ContainSubstring("name: logs"))
+		Expect(resources).To(ContainSubstring("user_provided: true"))
+		Expect(resources).To(ContainSubstring(`{"port":514}`))
+		Expect(resources).To(ContainSubstring("syslog_drain_url: syslog://example.com"))
+		Expect(resources).To(ContainSubstring("host: web"))
+		Expect(resources).To(ContainSubstring("domain: example.com"))
+		Expect(resources).NotTo(ContainSubstring("host: other"))
+	})
+
+	It("does not download packages unless asked to", func() {
+		runCommand("my-org", dir)
+
+		Expect(appBitsRepo.DownloadBitsCallCount()).To(Equal(0))
+	})
+
+	It("downloads the package of each app with --include-packages", func() {
+		appBitsRepo.DownloadBitsStub = func(appGuid string, zipFile *os.File) error {
+			_, err := zipFile.WriteString("package of " + appGuid)
+			return err
+		}
+
+		runCommand("--include-packages", "my-org", dir)
+
+		Expect(readFile("spaces", "dev", "packages", "web.zip")).To(Equal("package of web-guid"))
+	})
+
+	It("warns and continues when an app has no package", func() {
+		appBitsRepo.DownloadBitsReturns(errors.New("no package"))
+
+		runCommand("--include-packages", "my-org", dir)
+
+		Expect(ui.WarnOutputs).To(ContainSubstrings([]string{"Could not download the package of app web", "no package"}))
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+		_, err := os.Stat(filepath.Join(dir, "spaces", "dev", "packages", "web.zip"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("refuses space names that would lead outside the directory", func() {
+		actor.ExportReturns(org_config.OrgDefinition{
+			Name:     "my-org",
+			Quota:    "big",
+			Managers: []string{"alice"},
+			Spaces:   []org_config.SpaceDefinition{{Name: "../evil", Developers: []string{"bob"}}},
+		}, nil)
+		spaceRepo.Spaces[0].Name = "../evil"
+
+		runCommand("my-org", dir)
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"../evil", "cannot be used as a file name"},
+		))
+		_, err := os.Stat(filepath.Join(dir, "evil", "resources.yml"))
+		Expect(os.IsNotExist(err)).To(BeTrue())
+	})
+
+	It("refuses app names that would lead outside the directory", func() {
+		appSummaryRepo.GetSummariesInSpaceApps[0].Name = "../../evil"
+
+		runCommand("--include-packages", "my-org", dir)
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"../../evil", "cannot be used as a file name"},
+		))
+		Expect(appBitsRepo.DownloadBitsCallCount()).To(Equal(0))
+	})
+
+	It("fails when a space of the org is not listed", func() {
+		spaceRepo.Spaces = []models.Space{}
+
+		runCommand("my-org", dir)
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Space", "dev", "not found"},
+		))
+	})
+
+	It("fails when the org cannot be exported", func() {
+		actor.ExportReturns(org_config.OrgDefinition{}, errors.New("boom"))
+
+		runCommand("my-org", dir)
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"boom"},
+		))
+	})
+})
+
+func mustOpen(path string) *os.File {
+	file, err := os.Open(path)
+	Expect(err).NotTo(HaveOccurred())
+	return file
+}
@@ -0,0 +1,419 @@
+package organization
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/application_bits"
+	"github.com/cloudfoundry/cli/cf/api/applications"
+	"github.com/cloudfoundry/cli/cf/api/organizations"
+	"github.com/cloudfoundry/cli/cf/api/resources"
+	"github.com/cloudfoundry/cli/cf/api/spaces"
+	"github.com/cloudfoundry/cli/cf/api/stacks"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ImportOrg struct {
+	ui                      terminal.UI
+	config                  core_config.Reader
+	actor                   org_config.OrgConfigActor
+	manifestRepo            manifest.ManifestRepository
+	orgRepo                 organizations.OrganizationRepository
+	spaceRepo               spaces.SpaceRepository
+	appRepo                 applications.ApplicationRepository
+	stackRepo               stacks.StackRepository
+	serviceRepo             api.ServiceRepository
+	serviceSummaryRepo      api.ServiceSummaryRepository
+	userProvidedServiceRepo api.UserProvidedServiceInstanceRepository
+	bindingRepo             api.ServiceBindingRepository
+	domainRepo              api.DomainRepository
+	routeRepo               api.RouteRepository
+	appBitsRepo             application_bits.ApplicationBitsRepository
+}
+
+func NewImportOrg(ui terminal.UI, config core_config.Reader, actor org_config.OrgConfigActor, manifestRepo manifest.ManifestRepository, orgRepo organizations.OrganizationRepository, spaceRepo spaces.SpaceRepository, appRepo applications.ApplicationRepository, stackRepo stacks.StackRepository, serviceRepo api.ServiceRepository, serviceSummaryRepo api.ServiceSummaryRepository, userProvidedServiceRepo api.UserProvidedServiceInstanceRepository, bindingRepo api.ServiceBindingRepository, domainRepo api.DomainRepository, routeRepo api.RouteRepository, appBitsRepo application_bits.ApplicationBitsRepository) (cmd *ImportOrg) {
+	return &ImportOrg{
+		ui:                      ui,
+		config:                  config,
+		actor:                   actor,
+		manifestRepo:            manifestRepo,
+		orgRepo:                 orgRepo,
+		spaceRepo:               spaceRepo,
+		appRepo:                 appRepo,
+		stackRepo:               stackRepo,
+		serviceRepo:             serviceRepo,
+		serviceSummaryRepo:      serviceSummaryRepo,
+		userProvidedServiceRepo: userProvidedServiceRepo,
+		bindingRepo:             bindingRepo,
+		domainRepo:              domainRepo,
+		routeRepo:               routeRepo,
+		appBitsRepo:             appBitsRepo,
+	}
+}
+
+func (cmd *ImportOrg) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "import-org",
+		Description: T("Recreate an org from a directory written by export-org"),
+		Usage: T(`CF_NAME import-org DIR
+
+   Creates the org and its spaces, sets their quota, roles and security groups, then creates the apps, service instances and routes of each space and binds them. Anything that already exists is left as it is, so an import can be run again after fixing a failure.
+
+   Quotas, users, security groups, services, plans and domains are not created and must already exist. Apps are created stopped, with their packages uploaded when the export included them.
+
+EXAMPLE:
+   CF_NAME import-org ./my-org-export`),
+	}
+}
+
+func (cmd *ImportOrg) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ImportOrg) Run(c *cli.Context) {
+	dir := c.Args()[0]
+
+	orgConfig, err := readOrgConfig(filepath.Join(dir, orgArchiveOrgFile))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+	if len(orgConfig.Orgs) != 1 {
+		cmd.ui.Failed(T("{{.Dir}} does not contain an org exported by export-org", map[string]interface{}{"Dir": dir}))
+		return
+	}
+	orgDef := orgConfig.Orgs[0]
+
+	cmd.ui.Say(T("Importing org {{.OrgName}} from {{.Dir}} as {{.Username}}...",
+		map[string]interface{}{
+			"OrgName":  terminal.EntityNameColor(orgDef.Name),
+			"Dir":      terminal.EntityNameColor(dir),
+			"Username": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	changes, err := cmd.actor.Plan(orgConfig)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	for _, change := range changes {
+		target := terminal.EntityNameColor(change.OrgName)
+		if change.SpaceName != "" {
+			target = target + " / " + terminal.EntityNameColor(change.SpaceName)
+		}
+		cmd.ui.Say(T("{{.Target}}: {{.Change}}", map[string]interface{}{
+			"Target": target,
+			"Change": describeOrgConfigChange(change),
+		}))
+
+		err = cmd.actor.Apply(change)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+	}
+
+	org, err := cmd.orgRepo.FindByName(orgDef.Name)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	for _, spaceDef := range orgDef.Spaces {
+		space, err := cmd.spaceRepo.FindByNameInOrg(spaceDef.Name, org.Guid)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+
+		spaceDir, err := orgArchivePath(filepath.Join(dir, orgArchiveSpacesDir), space.Name)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+
+		err = cmd.importSpace(org, space, spaceDir)
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Imported {{.SpaceCount}} spaces into org {{.OrgName}}",
+		map[string]interface{}{"SpaceCount": len(orgDef.Spaces), "OrgName": orgDef.Name}))
+}
+
+func (cmd *ImportOrg) importSpace(org models.Organization, space models.Space, spaceDir string) error {
+	archived := orgArchiveResources{}
+	resourcesPath := filepath.Join(spaceDir, orgArchiveResourcesFile)
+	if _, err := os.Stat(resourcesPath); err == nil {
+		err = readOrgArchiveFile(resourcesPath, &archived)
+		if err != nil {
+			return errors.NewWithError(T("Error reading {{.Path}}", map[string]interface{}{"Path": resourcesPath}), err)
+		}
+	}
+
+	appGuids, err := cmd.importApps(space, spaceDir)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.importServices(space, archived.Services, appGuids)
+	if err != nil {
+		return err
+	}
+
+	return cmd.importRoutes(org, space, archived.Routes, appGuids)
+}
+
+// importApps creates the apps of the space's manifest that do not exist yet
+// and returns the guids of all of them by name.
+func (cmd *ImportOrg) importApps(space models.Space, spaceDir string) (map[string]string, error) {
+	appGuids := map[string]string{}
+
+	manifestPath := filepath.Join(spaceDir, orgArchiveManifestFile)
+	if _, err := os.Stat(manifestPath); err != nil {
+		return appGuids, nil
+	}
+
+	appManifest, err := cmd.manifestRepo.ReadManifest(manifestPath)
+	if err != nil {
+		return nil, err
+	}
+
+	apps, err := appManifest.Applications()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, params := range apps {
+		name := *params.Name
+		packagePath, err := orgArchivePath(filepath.Join(spaceDir, orgArchivePackagesDir), name)
+		if err != nil {
+			return nil, err
+		}
+
+		app, err := cmd.appRepo.ReadFromSpace(name, space.Guid)
+		if err == nil {
+			appGuids[name] = app.Guid
+			continue
+		}
+		if _, ok := err.(*errors.ModelNotFoundError); !ok {
+			return nil, err
+		}
+
+		cmd.ui.Say(T("{{.SpaceName}}: create app {{.AppName}}", map[string]interface{}{
+			"SpaceName": terminal.EntityNameColor(space.Name),
+			"AppName":   terminal.EntityNameColor(name),
+		}))
+
+		params.SpaceGuid = &space.Guid
+		if params.StackName != nil {
+			stack, err := cmd.stackRepo.FindByName(*params.StackName)
+			if err != nil {
+				return nil, err
+			}
+			params.StackGuid = &stack.Guid
+		}
+
+		app, err = cmd.appRepo.Create(params)
+		if err != nil {
+			return nil, err
+		}
+		appGuids[name] = app.Guid
+
+		err = cmd.uploadPackage(app, packagePath+".zip")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return appGuids, nil
+}
+
+func (cmd *ImportOrg) uploadPackage(app models.Application, path string) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return cmd.appBitsRepo.UploadBits(app.Guid, file, []resources.AppFileResource{})
+}
+
+func (cmd *ImportOrg) importServices(space models.Space, services []orgArchiveService, appGuids map[string]string) error {
+	if len(services) == 0 {
+		return nil
+	}
+
+	instances, err := cmd.serviceSummaryRepo.GetSummariesInSpace(space.Guid)
+	if err != nil {
+		return err
+	}
+
+	created := false
+	for _, service := range services {
+		if _, found := findServiceInstance(instances, service.Name); found {
+			continue
+		}
+
+		cmd.ui.Say(T("{{.SpaceName}}: create service {{.ServiceName}}", map[string]interface{}{
+			"SpaceName":   terminal.EntityNameColor(space.Name),
+			"ServiceName": terminal.EntityNameColor(service.Name),
+		}))
+
+		if service.UserProvided {
+			credentials := map[string]interface{}{}
+			if service.Credentials != "" {
+				err = json.Unmarshal([]byte(service.Credentials), &credentials)
+				if err != nil {
+					return errors.NewWithError(T("Error reading the credentials of service {{.ServiceName}}", map[string]interface{}{"ServiceName": service.Name}), err)
+				}
+			}
+			err = cmd.userProvidedServiceRepo.CreateInSpace(space.Guid, service.Name, service.SyslogDrainUrl, credentials, service.Tags)
+		} else {
+			var planGuid string
+			planGuid, err = cmd.serviceRepo.FindServicePlanByDescription(resources.ServicePlanDescription{
+				ServiceLabel:    service.Service,
+				ServicePlanName: service.Plan,
+			})
+			if err != nil {
+				return err
+			}
+			err = cmd.serviceRepo.CreateServiceInstanceInSpace(space.Guid, service.Name, planGuid, nil, service.Tags)
+		}
+		if err != nil {
+			return err
+		}
+		created = true
+	}
+
+	if created {
+		instances, err = cmd.serviceSummaryRepo.GetSummariesInSpace(space.Guid)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, service := range services {
+		instance, _ := findServiceInstance(instances, service.Name)
+		for _, appName := range service.Apps {
+			if containsName(instance.ApplicationNames, appName) {
+				continue
+			}
+
+			appGuid, ok := appGuids[appName]
+			if !ok {
+				cmd.ui.Warn(T("App {{.AppName}} was not found, so service {{.ServiceName}} was not bound to it",
+					map[string]interface{}{"AppName": appName, "ServiceName": service.Name}))
+				continue
+			}
+
+			if instance.LastOperation.State == models.LastOperationInProgress {
+				cmd.ui.Warn(T("Service {{.ServiceName}} is still being created. Bind it to {{.AppName}} once it is ready, or run import-org again.",
+					map[string]interface{}{"AppName": appName, "ServiceName": service.Name}))
+				continue
+			}
+
+			cmd.ui.Say(T("{{.SpaceName}}: bind service {{.ServiceName}} to {{.AppName}}", map[string]interface{}{
+				"SpaceName":   terminal.EntityNameColor(space.Name),
+				"ServiceName": terminal.EntityNameColor(service.Name),
+				"AppName":     terminal.EntityNameColor(appName),
+			}))
+
+			err = cmd.bindingRepo.Create(instance.Guid, appGuid, nil)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (cmd *ImportOrg) importRoutes(org models.Organization, space models.Space, routes []orgArchiveRoute, appGuids map[string]string) error {
+	for _, archived := range routes {
+		domain, err := cmd.domainRepo.FindByNameInOrg(archived.Domain, org.Guid)
+		if err != nil {
+			return err
+		}
+
+		route, err := cmd.routeRepo.FindByHostAndDomain(archived.Host, domain)
+		if _, ok := err.(*errors.ModelNotFoundError); ok {
+			cmd.ui.Say(T("{{.SpaceName}}: create route {{.URL}}", map[string]interface{}{
+				"SpaceName": terminal.EntityNameColor(space.Name),
+				"URL":       terminal.EntityNameColor(models.RouteSummary{Host: archived.Host, Domain: domain}.URL()),
+			}))
+			route, err = cmd.routeRepo.CreateInSpace(archived.Host, domain.Guid, space.Guid)
+		}
+		if err != nil {
+			return err
+		}
+
+		boundApps := []string{}
+		for _, app := range route.Apps {
+			boundApps = append(boundApps, app.Name)
+		}
+
+		for _, appName := range archived.Apps {
+			if containsName(boundApps, appName) {
+				continue
+			}
+
+			appGuid, ok := appGuids[appName]
+			if !ok {
+				cmd.ui.Warn(T("App {{.AppName}} was not found, so route {{.URL}} was not mapped to it",
+					map[string]interface{}{"AppName": appName, "URL": route.URL()}))
+				continue
+			}
+
+			err = cmd.routeRepo.Bind(route.Guid, appGuid)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func findServiceInstance(instances []models.ServiceInstance, name string) (models.ServiceInstance, bool) {
+	for _, instance := range instances {
+		if instance.Name == name {
+			return instance, true
+		}
+	}
+	return models.ServiceInstance{}, false
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
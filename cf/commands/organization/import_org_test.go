@@ -0,0 +1,337 @@
+package organization_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+	orgconfigfakes "github.com/cloudfoundry/cli/cf/actors/org_config/fakes"
+	bitsfakes "github.com/cloudfoundry/cli/cf/api/application_bits/fakes"
+	appfakes "github.com/cloudfoundry/cli/cf/api/applications/fakes"
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	orgfakes "github.com/cloudfoundry/cli/cf/api/organizations/fakes"
+	"github.com/cloudfoundry/cli/cf/api/resources"
+	stackfakes "github.com/cloudfoundry/cli/cf/api/stacks/fakes"
+	cferrors "github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/organization"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("import-org command", func() {
+	var (
+		ui                      *testterm.FakeUI
+		requirementsFactory     *testreq.FakeReqFactory
+		actor                   *orgconfigfakes.FakeOrgConfigActor
+		orgRepo                 *orgfakes.FakeOrganizationRepository
+		spaceRepo               *testapi.FakeSpaceRepository
+		appRepo                 *appfakes.FakeApplicationRepository
+		stackRepo               *stackfakes.FakeStackRepository
+		serviceRepo             *testapi.FakeServiceRepo
+		serviceSummaryRepo      *testapi.FakeServiceSummaryRepo
+		userProvidedServiceRepo *testapi.FakeUserProvidedServiceInstanceRepo
+		bindingRepo             *testapi.FakeServiceBindingRepo
+		domainRepo              *testapi.FakeDomainRepository
+		routeRepo               *testapi.FakeRouteRepository
+		appBitsRepo             *bitsfakes.FakeApplicationBitsRepository
+		dir                     string
+	)
+
+	writeFile := func(contents string, path ...string) {
+		fullPath := filepath.Join(append([]string{dir}, path...)...)
+		Expect(os.MkdirAll(filepath.Dir(fullPath), os.ModePerm)).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(fullPath, []byte(contents), 0644)).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		actor = &orgconfigfakes.FakeOrgConfigActor{}
+		orgRepo = &orgfakes.FakeOrganizationRepository{}
+		spaceRepo = &testapi.FakeSpaceRepository{}
+		appRepo = &appfakes.FakeApplicationRepository{}
+		stackRepo = &stackfakes.FakeStackRepository{}
+		serviceRepo = &testapi.FakeServiceRepo{}
+		serviceSummaryRepo = &testapi.FakeServiceSummaryRepo{}
+		userProvidedServiceRepo = &testapi.FakeUserProvidedServiceInstanceRepo{}
+		bindingRepo = &testapi.FakeServiceBindingRepo{}
+		domainRepo = &testapi.FakeDomainRepository{}
+		routeRepo = &testapi.FakeRouteRepository{}
+		appBitsRepo = &bitsfakes.FakeApplicationBitsRepository{}
+
+		var err error
+		dir, err = ioutil.TempDir("", "import-org")
+		Expect(err).NotTo(HaveOccurred())
+
+		writeFile(`orgs:
+- name: my-org
+  quota: big
+  spaces:
+  - name: dev
+`, "org.yml")
+		writeFile(`---
+applications:
+- name: web
+  memory: 256M
+  instances: 2
+  stack: cflinuxfs2
+  host: web
+  domain: example.com
+  services:
+  - db
+`, "spaces", "dev", "manifest.yml")
+		writeFile(`services:
+- name: db
+  service: mysql
+  plan: small
+  apps:
+  - web
+- name: logs
+  user_provided: true
+  credentials: '{"port":514}'
+  syslog_drain_url: syslog://example.com
+routes:
+- host: web
+  domain: example.com
+  apps:
+  - web
+`, "spaces", "dev", "resources.yml")
+		writeFile("zip contents", "spaces", "dev", "packages", "web.zip")
+
+		actor.PlanReturns([]org_config.Change{
+			{Type: org_config.CreateOrg, OrgName: "my-org", QuotaName: "big"},
+			{Type: org_config.CreateSpace, OrgName: "my-org", SpaceName: "dev"},
+		}, nil)
+
+		org := models.Organization{}
+		org.Name = "my-org"
+		org.Guid = "my-org-guid"
+		orgRepo.FindByNameReturns(org, nil)
+
+		space := models.Space{}
+		space.Name = "dev"
+		space.Guid = "dev-guid"
+		spaceRepo.FindByNameInOrgSpace = space
+
+		appRepo.ReadFromSpaceReturns(models.Application{}, cferrors.NewModelNotFoundError("App", "web"))
+		stackRepo.FindByNameReturns(models.Stack{Name: "cflinuxfs2", Guid: "stack-guid"}, nil)
+
+		serviceRepo.FindServicePlanByDescriptionResultGuids = []string{"small-guid"}
+		db := models.ServiceInstance{}
+		db.Name = "db"
+		db.Guid = "db-guid"
+		logs := models.ServiceInstance{}
+		logs.Name = "logs"
+		serviceSummaryRepo.GetSummariesInSpaceInstances = []models.ServiceInstance{db, logs}
+
+		domainRepo.FindByNameInOrgDomain = models.DomainFields{Name: "example.com", Guid: "example-guid"}
+		routeRepo.FindByHostAndDomainReturns.Error = cferrors.NewModelNotFoundError("Route", "web")
+		routeRepo.CreateInSpaceCreatedRoute = models.Route{Guid: "web-route-guid", Host: "web"}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	runCommand := func(args ...string) bool {
+		cmd := NewImportOrg(ui, testconfig.NewRepositoryWithDefaults(), actor, manifest.NewManifestDiskRepository(), orgRepo, spaceRepo, appRepo, stackRepo, serviceRepo, serviceSummaryRepo, userProvidedServiceRepo, bindingRepo, domainRepo, routeRepo, appBitsRepo)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand(dir)).To(BeFalse())
+		})
+
+		It("fails with usage without a directory", func() {
+			runCommand()
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
+	It("creates the org and its spaces", func() {
+		runCommand(dir)
+
+		Expect(actor.PlanArgsForCall(0).Orgs[0].Name).To(Equal("my-org"))
+		Expect(actor.ApplyCallCount()).To(Equal(2))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Importing org", "my-org", "my-user"},
+			[]string{"my-org", "create org with quota big"},
+			[]string{"my-org / dev", "create space"},
+			[]string{"OK"},
+			[]string{"Imported 1 spaces into org my-org"},
+		))
+	})
+
+	It("creates the apps stopped, without routes, and uploads their packages", func() {
+		runCommand(dir)
+
+		Expect(appRepo.CreateAppParams).To(HaveLen(1))
+		params := appRepo.CreateAppParams[0]
+		Expect(*params.Name).To(Equal("web"))
+		Expect(*params.Memory).To(Equal(int64(256)))
+		Expect(*params.SpaceGuid).To(Equal("dev-guid"))
+		Expect(*params.StackGuid).To(Equal("stack-guid"))
+		Expect(params.State).To(BeNil())
+
+		Expect(appBitsRepo.UploadBitsCallCount()).To(Equal(1))
+		appGuid, file, presentFiles := appBitsRepo.UploadBitsArgsForCall(0)
+		Expect(appGuid).To(Equal("web-guid"))
+		Expect(file.Name()).To(Equal(filepath.Join(dir, "spaces", "dev", "packages", "web.zip")))
+		Expect(presentFiles).To(Equal([]resources.AppFileResource{}))
+	})
+
+	It("leaves existing apps alone", func() {
+		appRepo.ReadFromSpaceReturns(models.Application{ApplicationFields: models.ApplicationFields{Name: "web", Guid: "existing-web-guid"}}, nil)
+
+		runCommand(dir)
+
+		Expect(appRepo.CreateAppParams).To(BeEmpty())
+		Expect(appBitsRepo.UploadBitsCallCount()).To(Equal(0))
+		Expect(routeRepo.BoundAppGuids).To(Equal([]string{"existing-web-guid"}))
+	})
+
+	It("creates missing service instances and binds them", func() {
+		db := models.ServiceInstance{}
+		db.Name = "db"
+		db.Guid = "db-guid"
+		serviceSummaryRepo.GetSummariesInSpaceSequence = [][]models.ServiceInstance{{}, {db}}
+
+		runCommand(dir)
+
+		Expect(serviceRepo.FindServicePlanByDescriptionArguments).To(Equal([]resources.ServicePlanDescription{
+			{ServiceLabel: "mysql", ServicePlanName: "small"},
+		}))
+		Expect(serviceRepo.CreateServiceInstanceInSpaceArgs).To(Equal([]testapi.CreateServiceInstanceInSpaceArgs{
+			{SpaceGuid: "dev-guid", Name: "db", PlanGuid: "small-guid"},
+		}))
+
+		Expect(userProvidedServiceRepo.CreateInSpaceSpaceGuid).To(Equal("dev-guid"))
+		Expect(userProvidedServiceRepo.CreateInSpaceName).To(Equal("logs"))
+		Expect(userProvidedServiceRepo.CreateInSpaceDrainUrl).To(Equal("syslog://example.com"))
+		Expect(userProvidedServiceRepo.CreateInSpaceParams).To(Equal(map[string]interface{}{"port": float64(514)}))
+
+		Expect(bindingRepo.CreateServiceInstanceGuid).To(Equal("db-guid"))
+		Expect(bindingRepo.CreateApplicationGuids).To(Equal([]string{"web-guid"}))
+	})
+
+	It("does not recreate existing service instances or bindings", func() {
+		db := models.ServiceInstance{}
+		db.Name = "db"
+		db.Guid = "db-guid"
+		db.ApplicationNames = []string{"web"}
+		logs := models.ServiceInstance{}
+		logs.Name = "logs"
+		serviceSummaryRepo.GetSummariesInSpaceInstances = []models.ServiceInstance{db, logs}
+
+		runCommand(dir)
+
+		Expect(serviceRepo.CreateServiceInstanceInSpaceArgs).To(BeEmpty())
+		Expect(userProvidedServiceRepo.CreateInSpaceName).To(BeEmpty())
+		Expect(bindingRepo.CreateApplicationGuids).To(BeEmpty())
+	})
+
+	It("warns instead of binding a service that is still being created", func() {
+		db := models.ServiceInstance{}
+		db.Name = "db"
+		db.Guid = "db-guid"
+		db.LastOperation.State = models.LastOperationInProgress
+		logs := models.ServiceInstance{}
+		logs.Name = "logs"
+		serviceSummaryRepo.GetSummariesInSpaceInstances = []models.ServiceInstance{db, logs}
+
+		runCommand(dir)
+
+		Expect(bindingRepo.CreateApplicationGuids).To(BeEmpty())
+		Expect(ui.WarnOutputs).To(ContainSubstrings([]string{"Service db is still being created"}))
+	})
+
+	It("creates missing routes and maps them to the apps", func() {
+		runCommand(dir)
+
+		Expect(domainRepo.FindByNameInOrgName).To(Equal("example.com"))
+		Expect(domainRepo.FindByNameInOrgGuid).To(Equal("my-org-guid"))
+		Expect(routeRepo.CreateInSpaceHost).To(Equal("web"))
+		Expect(routeRepo.CreateInSpaceDomainGuid).To(Equal("example-guid"))
+		Expect(routeRepo.CreateInSpaceSpaceGuid).To(Equal("dev-guid"))
+		Expect(routeRepo.BoundRouteGuids).To(Equal([]string{"web-route-guid"}))
+		Expect(routeRepo.BoundAppGuids).To(Equal([]string{"web-guid"}))
+	})
+
+	It("does not remap routes that are already mapped", func() {
+		routeRepo.FindByHostAndDomainReturns.Error = nil
+		routeRepo.FindByHostAndDomainReturns.Route = models.Route{
+			Guid: "web-route-guid",
+			Host: "web",
+			Apps: []models.ApplicationFields{{Name: "web"}},
+		}
+
+		runCommand(dir)
+
+		Expect(routeRepo.CreateInSpaceSpaceGuids).To(BeEmpty())
+		Expect(routeRepo.BoundRouteGuids).To(BeEmpty())
+	})
+
+	It("fails when the directory has no org configuration", func() {
+		os.Remove(filepath.Join(dir, "org.yml"))
+
+		runCommand(dir)
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+		Expect(actor.PlanCallCount()).To(Equal(0))
+	})
+
+	It("refuses space names that would lead outside the directory", func() {
+		space := spaceRepo.FindByNameInOrgSpace
+		space.Name = "../dev"
+		spaceRepo.FindByNameInOrgSpace = space
+
+		runCommand(dir)
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"../dev", "cannot be used as a file name"},
+		))
+		Expect(appRepo.CreateAppParams).To(BeEmpty())
+	})
+
+	It("refuses app names that would lead outside the directory", func() {
+		writeFile(`---
+applications:
+- name: ../../../web
+`, "spaces", "dev", "manifest.yml")
+
+		runCommand(dir)
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"../../../web", "cannot be used as a file name"},
+		))
+		Expect(appRepo.CreateAppParams).To(BeEmpty())
+		Expect(appBitsRepo.UploadBitsCallCount()).To(Equal(0))
+	})
+
+	It("stops at the first change that fails", func() {
+		actor.ApplyReturns(errors.New("quota not found"))
+
+		runCommand(dir)
+
+		Expect(actor.ApplyCallCount()).To(Equal(1))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"quota not found"},
+		))
+		Expect(appRepo.CreateAppParams).To(BeEmpty())
+	})
+})
@@ -4,25 +4,29 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/formatters"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/codegangsta/cli"
 )
 
 type ShowOrg struct {
-	ui     terminal.UI
-	config core_config.Reader
-	orgReq requirements.OrganizationRequirement
+	ui       terminal.UI
+	config   core_config.Reader
+	userRepo api.UserRepository
+	orgReq   requirements.OrganizationRequirement
 }
 
-func NewShowOrg(ui terminal.UI, config core_config.Reader) (cmd *ShowOrg) {
+func NewShowOrg(ui terminal.UI, config core_config.Reader, userRepo api.UserRepository) (cmd *ShowOrg) {
 	cmd = new(ShowOrg)
 	cmd.ui = ui
 	cmd.config = config
+	cmd.userRepo = userRepo
 	return
 }
 
@@ -30,9 +34,10 @@ func (cmd *ShowOrg) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "org",
 		Description: T("Show org info"),
-		Usage:       T("CF_NAME org ORG"),
+		Usage:       T("CF_NAME org ORG [--guid | --full]"),
 		Flags: []cli.Flag{
 			cli.BoolFlag{Name: "guid", Usage: T("Retrieve and display the given org's guid.  All other output for the org is suppressed.")},
+			cli.BoolFlag{Name: "full", Usage: T("Also show who holds each org role")},
 		},
 	}
 }
@@ -96,6 +101,33 @@ func (cmd *ShowOrg) Run(c *cli.Context) {
 		table.Add("", T("spaces:"), terminal.EntityNameColor(strings.Join(spaces, ", ")))
 		table.Add("", T("space quotas:"), terminal.EntityNameColor(strings.Join(spaceQuotas, ", ")))
 
+		if c.Bool("full") {
+			roles := []struct {
+				role  string
+				label string
+			}{
+				{models.ORG_MANAGER, T("managers:")},
+				{models.BILLING_MANAGER, T("billing managers:")},
+				{models.ORG_AUDITOR, T("auditors:")},
+			}
+			for _, r := range roles {
+				users, err := cmd.userRepo.ListUsersInOrgForRole(org.Guid, r.role)
+				if err != nil {
+					cmd.ui.Failed(err.Error())
+					return
+				}
+				table.Add("", r.label, terminal.EntityNameColor(strings.Join(usernamesOf(users), ", ")))
+			}
+		}
+
 		table.Print()
 	}
 }
+
+func usernamesOf(users []models.UserFields) []string {
+	names := []string{}
+	for _, user := range users {
+		names = append(names, user.Username)
+	}
+	return names
+}
@@ -8,6 +8,8 @@ import (
 	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
 	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
 
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+
 	. "github.com/cloudfoundry/cli/cf/commands/organization"
 	. "github.com/cloudfoundry/cli/testhelpers/matchers"
 	. "github.com/onsi/ginkgo"
@@ -29,7 +31,7 @@ func callShowOrg(args []string, requirementsFactory *testreq.FakeReqFactory) (ui
 	configRepo.SetSpaceFields(spaceFields)
 	configRepo.SetOrganizationFields(orgFields)
 
-	cmd := NewShowOrg(ui, configRepo)
+	cmd := NewShowOrg(ui, configRepo, &testapi.FakeUserRepository{})
 	testcmd.RunCommand(cmd, args, requirementsFactory)
 	return
 }
@@ -39,16 +41,18 @@ var _ = Describe("org command", func() {
 		ui                  *testterm.FakeUI
 		configRepo          core_config.ReadWriter
 		requirementsFactory *testreq.FakeReqFactory
+		userRepo            *testapi.FakeUserRepository
 	)
 
 	BeforeEach(func() {
 		ui = &testterm.FakeUI{}
 		requirementsFactory = &testreq.FakeReqFactory{}
 		configRepo = testconfig.NewRepositoryWithDefaults()
+		userRepo = &testapi.FakeUserRepository{}
 	})
 
 	runCommand := func(args ...string) bool {
-		return testcmd.RunCommand(NewShowOrg(ui, configRepo), args, requirementsFactory)
+		return testcmd.RunCommand(NewShowOrg(ui, configRepo, userRepo), args, requirementsFactory)
 	}
 
 	Describe("requirements", func() {
@@ -102,6 +106,27 @@ var _ = Describe("org command", func() {
 				[]string{"spaces:", "development", "staging"},
 				[]string{"space quotas:", "space-quota-1", "space-quota-2"},
 			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"managers:"}))
+		})
+
+		Context("when the full flag is provided", func() {
+			It("shows who holds each org role", func() {
+				userRepo.ListUsersByRole = map[string][]models.UserFields{
+					models.ORG_MANAGER:     []models.UserFields{{Username: "alice"}, {Username: "bob"}},
+					models.BILLING_MANAGER: []models.UserFields{{Username: "carol"}},
+					models.ORG_AUDITOR:     []models.UserFields{},
+				}
+
+				runCommand("--full", "my-org")
+
+				Expect(userRepo.ListUsersOrganizationGuid).To(Equal("my-org-guid"))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"spaces:", "development", "staging"},
+					[]string{"managers:", "alice, bob"},
+					[]string{"billing managers:", "carol"},
+					[]string{"auditors:"},
+				))
+			})
 		})
 
 		Context("when the guid flag is provided", func() {
@@ -0,0 +1,135 @@
+package organization
+
+import (
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type PlanConfig struct {
+	ui     terminal.UI
+	config core_config.Reader
+	actor  org_config.OrgConfigActor
+}
+
+func NewPlanConfig(ui terminal.UI, config core_config.Reader, actor org_config.OrgConfigActor) (cmd *PlanConfig) {
+	return &PlanConfig{
+		ui:     ui,
+		config: config,
+		actor:  actor,
+	}
+}
+
+func (cmd *PlanConfig) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "plan-config",
+		Description: T("Show the changes needed to make orgs and spaces match a configuration file"),
+		Usage: T(`CF_NAME plan-config CONFIG_FILE
+
+   The configuration file lists orgs with their quota and spaces, who holds each org and space role, and which security groups are bound to each space.
+   A role or security group list that is left out is not managed; an empty list removes everyone. Orgs and spaces the file does not mention are left alone.
+
+EXAMPLE CONFIG FILE:
+   orgs:
+   - name: my-org
+     quota: default
+     managers:
+     - alice
+     auditors: []
+     spaces:
+     - name: development
+       managers:
+       - alice
+       developers:
+       - bob
+       security_groups:
+       - public_networks`),
+	}
+}
+
+func (cmd *PlanConfig) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *PlanConfig) Run(c *cli.Context) {
+	configFile := c.Args()[0]
+	orgConfig, err := readOrgConfig(configFile)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Say(T("Planning org configuration {{.ConfigFile}} as {{.Username}}...",
+		map[string]interface{}{
+			"ConfigFile": terminal.EntityNameColor(configFile),
+			"Username":   terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	changes, err := cmd.actor.Plan(orgConfig)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	if len(changes) == 0 {
+		cmd.ui.Say(T("Orgs and spaces already match the configuration"))
+		return
+	}
+
+	cmd.ui.Say("")
+	table := terminal.NewTable(cmd.ui, []string{T("org"), T("space"), T("change")})
+	for _, change := range changes {
+		table.Add(change.OrgName, change.SpaceName, describeOrgConfigChange(change))
+	}
+	table.Print()
+	cmd.ui.Say("")
+
+	cmd.ui.Say(T("{{.ChangeCount}} changes would be made", map[string]interface{}{"ChangeCount": len(changes)}))
+}
+
+func readOrgConfig(configFile string) (org_config.OrgConfig, error) {
+	file, err := os.Open(configFile)
+	if err != nil {
+		return org_config.OrgConfig{}, err
+	}
+	defer file.Close()
+
+	return org_config.ParseOrgConfig(file)
+}
+
+func describeOrgConfigChange(change org_config.Change) string {
+	switch change.Type {
+	case org_config.CreateOrg:
+		if change.QuotaName != "" {
+			return T("create org with quota {{.QuotaName}}", map[string]interface{}{"QuotaName": change.QuotaName})
+		}
+		return T("create org")
+	case org_config.AssignQuota:
+		return T("assign quota {{.QuotaName}}", map[string]interface{}{"QuotaName": change.QuotaName})
+	case org_config.CreateSpace:
+		return T("create space")
+	case org_config.SetOrgRole, org_config.SetSpaceRole:
+		return T("give {{.Username}} role {{.Role}}", map[string]interface{}{"Username": change.Username, "Role": change.Role})
+	case org_config.UnsetOrgRole, org_config.UnsetSpaceRole:
+		return T("remove role {{.Role}} from {{.Username}}", map[string]interface{}{"Username": change.Username, "Role": change.Role})
+	case org_config.BindSecurityGroup:
+		return T("bind security group {{.SecurityGroupName}}", map[string]interface{}{"SecurityGroupName": change.SecurityGroupName})
+	case org_config.UnbindSecurityGroup:
+		return T("unbind security group {{.SecurityGroupName}}", map[string]interface{}{"SecurityGroupName": change.SecurityGroupName})
+	}
+	return ""
+}
@@ -0,0 +1,125 @@
+package organization_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/actors/org_config"
+	"github.com/cloudfoundry/cli/cf/actors/org_config/fakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/organization"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("plan-config command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		actor               *fakes.FakeOrgConfigActor
+		requirementsFactory *testreq.FakeReqFactory
+		configFile          *os.File
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		actor = &fakes.FakeOrgConfigActor{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+
+		var err error
+		configFile, err = ioutil.TempFile("", "org-config")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = configFile.WriteString(`
+orgs:
+- name: my-org
+  quota: big
+  spaces:
+  - name: dev
+    developers:
+    - bob
+`)
+		Expect(err).NotTo(HaveOccurred())
+		configFile.Close()
+	})
+
+	AfterEach(func() {
+		os.Remove(configFile.Name())
+	})
+
+	runCommand := func(args ...string) bool {
+		cmd := NewPlanConfig(ui, testconfig.NewRepositoryWithDefaults(), actor)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand(configFile.Name())).To(BeFalse())
+		})
+
+		It("fails with usage without a config file", func() {
+			runCommand()
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
+	It("shows the planned changes without making them", func() {
+		actor.PlanReturns([]org_config.Change{
+			{Type: org_config.AssignQuota, OrgName: "my-org", QuotaName: "big"},
+			{Type: org_config.SetSpaceRole, OrgName: "my-org", SpaceName: "dev", Username: "bob", Role: models.SPACE_DEVELOPER},
+			{Type: org_config.UnbindSecurityGroup, OrgName: "my-org", SpaceName: "dev", SecurityGroupName: "old-group"},
+		}, nil)
+
+		runCommand(configFile.Name())
+
+		orgConfig := actor.PlanArgsForCall(0)
+		Expect(orgConfig.Orgs[0].Name).To(Equal("my-org"))
+		Expect(orgConfig.Orgs[0].Spaces[0].Developers).To(Equal([]string{"bob"}))
+		Expect(actor.ApplyCallCount()).To(Equal(0))
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Planning org configuration", configFile.Name(), "my-user"},
+			[]string{"OK"},
+			[]string{"org", "space", "change"},
+			[]string{"my-org", "assign quota big"},
+			[]string{"my-org", "dev", "give bob role SpaceDeveloper"},
+			[]string{"my-org", "dev", "unbind security group old-group"},
+			[]string{"3 changes would be made"},
+		))
+	})
+
+	It("says when nothing needs to change", func() {
+		actor.PlanReturns([]org_config.Change{}, nil)
+
+		runCommand(configFile.Name())
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"OK"},
+			[]string{"Orgs and spaces already match the configuration"},
+		))
+	})
+
+	It("fails when the changes cannot be planned", func() {
+		actor.PlanReturns(nil, errors.New("Quota big not found"))
+
+		runCommand(configFile.Name())
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Quota big not found"},
+		))
+	})
+
+	It("fails when the config file cannot be read", func() {
+		runCommand("/does/not/exist.yml")
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+		Expect(actor.PlanCallCount()).To(Equal(0))
+	})
+})
@@ -0,0 +1,185 @@
+package organization
+
+import (
+	"fmt"
+
+	"github.com/cloudfoundry/cli/cf/api/space_quotas"
+	"github.com/cloudfoundry/cli/cf/api/space_usage"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
+	"github.com/cloudfoundry/cli/cf/formatters"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+const defaultUsageThreshold = 80
+
+type ShowUsage struct {
+	ui             terminal.UI
+	config         core_config.Reader
+	usageRepo      space_usage.SpaceUsageRepository
+	spaceQuotaRepo space_quotas.SpaceQuotaRepository
+	orgReq         requirements.OrganizationRequirement
+}
+
+func NewShowUsage(ui terminal.UI, config core_config.Reader, usageRepo space_usage.SpaceUsageRepository, spaceQuotaRepo space_quotas.SpaceQuotaRepository) (cmd *ShowUsage) {
+	return &ShowUsage{
+		ui:             ui,
+		config:         config,
+		usageRepo:      usageRepo,
+		spaceQuotaRepo: spaceQuotaRepo,
+	}
+}
+
+func (cmd *ShowUsage) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "usage",
+		Description: T("Show memory, instance, service and route usage of each space in an org against its quotas"),
+		Usage: T(`CF_NAME usage [ORG] [--threshold PERCENT]
+
+   Defaults to the targeted org. Memory is the instances times memory of every started app.
+
+EXAMPLE:
+   CF_NAME usage
+   CF_NAME usage my-org --threshold 90`),
+		Flags: []cli.Flag{
+			flag_helpers.NewIntFlagWithValue("threshold", T("Highlight usage at or above this percentage of a quota (Default: 80)"), defaultUsageThreshold),
+		},
+	}
+}
+
+func (cmd *ShowUsage) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) > 1 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+
+	orgName := cmd.config.OrganizationFields().Name
+	if len(c.Args()) == 1 {
+		orgName = c.Args()[0]
+	} else {
+		reqs = append(reqs, requirementsFactory.NewTargetedOrgRequirement())
+	}
+
+	cmd.orgReq = requirementsFactory.NewOrganizationRequirement(orgName)
+	reqs = append(reqs, cmd.orgReq)
+	return
+}
+
+func (cmd *ShowUsage) Run(c *cli.Context) {
+	org := cmd.orgReq.GetOrganization()
+	threshold := c.Int("threshold")
+
+	cmd.ui.Say(T("Getting usage for org {{.OrgName}} as {{.Username}}...",
+		map[string]interface{}{
+			"OrgName":  terminal.EntityNameColor(org.Name),
+			"Username": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	// an org without a quota definition is not limited
+	orgQuota := org.QuotaDefinition
+	if orgQuota.Name == "" {
+		orgQuota.MemoryLimit = -1
+		orgQuota.ServicesLimit = -1
+		orgQuota.RoutesLimit = -1
+	}
+
+	spaceQuotas, err := cmd.spaceQuotaRepo.FindByOrg(org.Guid)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+	spaceQuotasByGuid := map[string]models.SpaceQuota{}
+	for _, quota := range spaceQuotas {
+		spaceQuotasByGuid[quota.Guid] = quota
+	}
+
+	usages, err := cmd.usageRepo.ListSpaceUsage(org.Guid)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	table := terminal.NewTable(cmd.ui, []string{T("space"), T("quota"), T("memory"), T("instances"), T("services"), T("routes")})
+
+	total := models.SpaceUsage{}
+	for _, usage := range usages {
+		total.Memory += usage.Memory
+		total.RunningInstances += usage.RunningInstances
+		total.ServiceInstances += usage.ServiceInstances
+		total.Routes += usage.Routes
+
+		quota, hasQuota := spaceQuotasByGuid[usage.SpaceQuotaGuid]
+		if !hasQuota {
+			table.Add(
+				usage.Space.Name,
+				"",
+				formatters.ByteSize(usage.Memory*formatters.MEGABYTE),
+				fmt.Sprintf("%d", usage.RunningInstances),
+				fmt.Sprintf("%d", usage.ServiceInstances),
+				fmt.Sprintf("%d", usage.Routes),
+			)
+			continue
+		}
+
+		table.Add(
+			usage.Space.Name,
+			quota.Name,
+			formatMemoryUsage(usage.Memory, quota.MemoryLimit, threshold),
+			fmt.Sprintf("%d", usage.RunningInstances),
+			formatUsage(int64(usage.ServiceInstances), int64(quota.ServicesLimit), fmt.Sprintf("%d", usage.ServiceInstances), fmt.Sprintf("%d", quota.ServicesLimit), threshold),
+			formatUsage(int64(usage.Routes), int64(quota.RoutesLimit), fmt.Sprintf("%d", usage.Routes), fmt.Sprintf("%d", quota.RoutesLimit), threshold),
+		)
+	}
+
+	table.Add(
+		T("total"),
+		orgQuota.Name,
+		formatMemoryUsage(total.Memory, orgQuota.MemoryLimit, threshold),
+		fmt.Sprintf("%d", total.RunningInstances),
+		formatUsage(int64(total.ServiceInstances), int64(orgQuota.ServicesLimit), fmt.Sprintf("%d", total.ServiceInstances), fmt.Sprintf("%d", orgQuota.ServicesLimit), threshold),
+		formatUsage(int64(total.Routes), int64(orgQuota.RoutesLimit), fmt.Sprintf("%d", total.Routes), fmt.Sprintf("%d", orgQuota.RoutesLimit), threshold),
+	)
+
+	table.Print()
+}
+
+func formatMemoryUsage(used, limit int64, threshold int) string {
+	return formatUsage(used, limit, formatters.ByteSize(used*formatters.MEGABYTE), formatters.ByteSize(limit*formatters.MEGABYTE), threshold)
+}
+
+// formatUsage shows a figure against its limit. A negative limit is
+// unlimited, so only the figure is shown.
+func formatUsage(used, limit int64, usedString, limitString string, threshold int) string {
+	if limit < 0 {
+		return usedString
+	}
+
+	if limit == 0 {
+		usage := fmt.Sprintf("%s/%s", usedString, limitString)
+		if used > 0 {
+			return terminal.FailureColor(usage)
+		}
+		return usage
+	}
+
+	percent := used * 100 / limit
+	usage := fmt.Sprintf("%s/%s (%d%%)", usedString, limitString, percent)
+	switch {
+	case percent > 100:
+		return terminal.FailureColor(usage)
+	case percent >= int64(threshold):
+		return terminal.WarningColor(usage)
+	}
+	return usage
+}
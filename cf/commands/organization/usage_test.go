@@ -0,0 +1,159 @@
+package organization_test
+
+import (
+	"errors"
+	"strings"
+
+	spacequotafakes "github.com/cloudfoundry/cli/cf/api/space_quotas/fakes"
+	usagefakes "github.com/cloudfoundry/cli/cf/api/space_usage/fakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/organization"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("usage command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		configRepo          core_config.ReadWriter
+		requirementsFactory *testreq.FakeReqFactory
+		usageRepo           *usagefakes.FakeSpaceUsageRepository
+		spaceQuotaRepo      *spacequotafakes.FakeSpaceQuotaRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = &testreq.FakeReqFactory{}
+		usageRepo = &usagefakes.FakeSpaceUsageRepository{}
+		spaceQuotaRepo = &spacequotafakes.FakeSpaceQuotaRepository{}
+	})
+
+	runCommand := func(args ...string) bool {
+		cmd := NewShowUsage(ui, configRepo, usageRepo, spaceQuotaRepo)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails when not logged in", func() {
+			Expect(runCommand("my-org")).To(BeFalse())
+		})
+
+		It("fails when no org is given and none is targeted", func() {
+			requirementsFactory.LoginSuccess = true
+			Expect(runCommand()).To(BeFalse())
+		})
+
+		It("fails with usage when given too many args", func() {
+			requirementsFactory.LoginSuccess = true
+			runCommand("my-org", "extra")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
+	Context("when logged in", func() {
+		BeforeEach(func() {
+			requirementsFactory.LoginSuccess = true
+			requirementsFactory.TargetedOrgSuccess = true
+
+			org := models.Organization{}
+			org.Name = "my-org"
+			org.Guid = "my-org-guid"
+			org.QuotaDefinition = models.NewQuotaFields("org-quota", 2048, -1, 10, -1, true)
+			requirementsFactory.Organization = org
+
+			spaceQuotaRepo.FindByOrgReturns([]models.SpaceQuota{
+				{Guid: "small-guid", Name: "small", MemoryLimit: 1024, RoutesLimit: 2, ServicesLimit: 10},
+			}, nil)
+
+			usageRepo.ListSpaceUsageReturns([]models.SpaceUsage{
+				{
+					Space:            models.SpaceFields{Name: "dev", Guid: "dev-guid"},
+					SpaceQuotaGuid:   "small-guid",
+					Memory:           512,
+					RunningInstances: 4,
+					ServiceInstances: 3,
+					Routes:           2,
+				},
+				{
+					Space:            models.SpaceFields{Name: "staging", Guid: "staging-guid"},
+					Memory:           1024,
+					RunningInstances: 2,
+					ServiceInstances: 1,
+					Routes:           7,
+				},
+			}, nil)
+		})
+
+		It("uses the targeted org when no org is given", func() {
+			runCommand()
+
+			Expect(requirementsFactory.OrganizationName).To(Equal("my-org"))
+		})
+
+		It("shows each space against its space quota and the totals against the org quota", func() {
+			runCommand("my-org")
+
+			Expect(requirementsFactory.OrganizationName).To(Equal("my-org"))
+			Expect(spaceQuotaRepo.FindByOrgArgsForCall(0)).To(Equal("my-org-guid"))
+			Expect(usageRepo.ListSpaceUsageArgsForCall(0)).To(Equal("my-org-guid"))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Getting usage for org", "my-org", "my-user"},
+				[]string{"OK"},
+				[]string{"space", "quota", "memory", "instances", "services", "routes"},
+				[]string{"dev", "small", "512M/1G (50%)", "4", "3/10 (30%)", "2/2 (100%)"},
+				[]string{"staging", "1G", "2", "1", "7"},
+				[]string{"total", "org-quota", "1.5G/2G (75%)", "6", "4", "9/10 (90%)"},
+			))
+		})
+
+		Context("when colors are enabled", func() {
+			BeforeEach(func() {
+				terminal.UserAskedForColors = "true"
+				terminal.InitColorSupport()
+			})
+
+			AfterEach(func() {
+				terminal.UserAskedForColors = ""
+				terminal.InitColorSupport()
+			})
+
+			It("highlights usage at or above the threshold", func() {
+				runCommand("--threshold", "60", "my-org")
+
+				Expect(strings.Join(ui.Outputs, "\n")).To(ContainSubstring(terminal.WarningColor("1.5G/2G (75%)")))
+				Expect(strings.Join(ui.Outputs, "\n")).NotTo(ContainSubstring(terminal.WarningColor("512M/1G (50%)")))
+			})
+		})
+
+		It("shows the totals without limits when the org has no quota", func() {
+			requirementsFactory.Organization.QuotaDefinition = models.QuotaFields{}
+
+			runCommand("my-org")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"total", "1.5G", "6", "4", "9"},
+			))
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"total", "/"}))
+		})
+
+		It("fails when the space usage cannot be fetched", func() {
+			usageRepo.ListSpaceUsageReturns(nil, errors.New("boom"))
+			runCommand("my-org")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"boom"},
+			))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package route
 
 import (
 	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/spaces"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/flag_helpers"
@@ -17,18 +18,23 @@ type RouteCreator interface {
 }
 
 type CreateRoute struct {
-	ui        terminal.UI
-	config    core_config.Reader
-	routeRepo api.RouteRepository
-	spaceReq  requirements.SpaceRequirement
-	domainReq requirements.DomainRequirement
+	ui            terminal.UI
+	config        core_config.Reader
+	routeRepo     api.RouteRepository
+	spaceRepo     spaces.SpaceRepository
+	domainRepo    api.DomainRepository
+	spaceReq      requirements.SpaceRequirement
+	domainReq     requirements.DomainRequirement
+	promptForArgs bool
 }
 
-func NewCreateRoute(ui terminal.UI, config core_config.Reader, routeRepo api.RouteRepository) (cmd *CreateRoute) {
+func NewCreateRoute(ui terminal.UI, config core_config.Reader, routeRepo api.RouteRepository, spaceRepo spaces.SpaceRepository, domainRepo api.DomainRepository) (cmd *CreateRoute) {
 	cmd = new(CreateRoute)
 	cmd.ui = ui
 	cmd.config = config
 	cmd.routeRepo = routeRepo
+	cmd.spaceRepo = spaceRepo
+	cmd.domainRepo = domainRepo
 	return
 }
 
@@ -36,7 +42,9 @@ func (cmd *CreateRoute) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "create-route",
 		Description: T("Create a url route in a space for later use"),
-		Usage:       T("CF_NAME create-route SPACE DOMAIN [-n HOSTNAME]"),
+		Usage: T(`CF_NAME create-route SPACE DOMAIN [-n HOSTNAME]
+
+   When run in a terminal without SPACE or DOMAIN, the missing arguments are picked from the spaces and domains of the org.`),
 		Flags: []cli.Flag{
 			flag_helpers.NewStringFlag("n", T("Hostname")),
 		},
@@ -44,6 +52,14 @@ func (cmd *CreateRoute) Metadata() command_metadata.CommandMetadata {
 }
 
 func (cmd *CreateRoute) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) < 2 && cmd.ui.IsInteractive() {
+		cmd.promptForArgs = true
+		reqs = []requirements.Requirement{
+			requirementsFactory.NewLoginRequirement(),
+			requirementsFactory.NewTargetedOrgRequirement(),
+		}
+		return
+	}
 
 	if len(c.Args()) != 2 {
 		cmd.ui.FailWithUsage(c)
@@ -66,10 +82,18 @@ func (cmd *CreateRoute) GetRequirements(requirementsFactory requirements.Factory
 
 func (cmd *CreateRoute) Run(c *cli.Context) {
 	hostName := c.String("n")
-	space := cmd.spaceReq.GetSpace()
-	domain := cmd.domainReq.GetDomain()
 
-	_, apiErr := cmd.CreateRoute(hostName, domain, space.SpaceFields)
+	var space models.SpaceFields
+	var domain models.DomainFields
+	if cmd.promptForArgs {
+		space = cmd.promptForSpace(c.Args())
+		domain = promptForDomain(cmd.ui, cmd.config, cmd.domainRepo)
+	} else {
+		space = cmd.spaceReq.GetSpace().SpaceFields
+		domain = cmd.domainReq.GetDomain()
+	}
+
+	_, apiErr := cmd.CreateRoute(hostName, domain, space)
 	if apiErr != nil {
 		cmd.ui.Failed(apiErr.Error())
 		return
@@ -105,3 +129,75 @@ func (cmd *CreateRoute) CreateRoute(hostName string, domain models.DomainFields,
 	cmd.ui.Ok()
 	return
 }
+
+// promptForSpace lets the user pick a space from the targeted org, unless it
+// was given as an argument.
+func (cmd *CreateRoute) promptForSpace(args []string) (space models.SpaceFields) {
+	availableSpaces := []models.SpaceFields{}
+	err := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
+		availableSpaces = append(availableSpaces, space.SpaceFields)
+		return true
+	})
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	var spaceName string
+	if len(args) > 0 {
+		spaceName = args[0]
+	} else {
+		spaceNames := []string{}
+		for _, space := range availableSpaces {
+			spaceNames = append(spaceNames, space.Name)
+		}
+
+		spaceName = terminal.PromptForChoice(cmd.ui, spaceNames, T("Select a space:"), T("Space"))
+		if spaceName == "" {
+			cmd.ui.Failed(T("No space was selected"))
+			return
+		}
+	}
+
+	for _, space := range availableSpaces {
+		if space.Name == spaceName {
+			return space
+		}
+	}
+
+	cmd.ui.Failed(T("Space {{.SpaceName}} not found", map[string]interface{}{"SpaceName": spaceName}))
+	return
+}
+
+// promptForDomain lets the user pick one of the domains of the targeted org.
+func promptForDomain(ui terminal.UI, config core_config.Reader, domainRepo api.DomainRepository) (domain models.DomainFields) {
+	domains := []models.DomainFields{}
+	err := domainRepo.ListDomainsForOrg(config.OrganizationFields().Guid, func(domain models.DomainFields) bool {
+		domains = append(domains, domain)
+		return true
+	})
+	if err != nil {
+		ui.Failed(err.Error())
+		return
+	}
+
+	domainNames := []string{}
+	for _, domain := range domains {
+		domainNames = append(domainNames, domain.Name)
+	}
+
+	domainName := terminal.PromptForChoice(ui, domainNames, T("Select a domain:"), T("Domain"))
+	if domainName == "" {
+		ui.Failed(T("No domain was selected"))
+		return
+	}
+
+	for _, domain := range domains {
+		if domain.Name == domainName {
+			return domain
+		}
+	}
+
+	ui.Failed(T("Domain {{.DomainName}} not found", map[string]interface{}{"DomainName": domainName}))
+	return
+}
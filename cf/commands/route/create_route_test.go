@@ -21,6 +21,8 @@ var _ = Describe("create-route command", func() {
 		routeRepo           *testapi.FakeRouteRepository
 		requirementsFactory *testreq.FakeReqFactory
 		config              core_config.ReadWriter
+		spaceRepo           *testapi.FakeSpaceRepository
+		domainRepo          *testapi.FakeDomainRepository
 	)
 
 	BeforeEach(func() {
@@ -28,10 +30,12 @@ var _ = Describe("create-route command", func() {
 		routeRepo = &testapi.FakeRouteRepository{}
 		requirementsFactory = &testreq.FakeReqFactory{}
 		config = testconfig.NewRepositoryWithDefaults()
+		spaceRepo = &testapi.FakeSpaceRepository{}
+		domainRepo = &testapi.FakeDomainRepository{}
 	})
 
 	runCommand := func(args ...string) bool {
-		return testcmd.RunCommand(NewCreateRoute(ui, config, routeRepo), args, requirementsFactory)
+		return testcmd.RunCommand(NewCreateRoute(ui, config, routeRepo, spaceRepo, domainRepo), args, requirementsFactory)
 	}
 
 	Describe("requirements", func() {
@@ -114,7 +118,7 @@ var _ = Describe("create-route command", func() {
 					CreateInSpaceCreatedRoute: createdRoute,
 				}
 
-				cmd := NewCreateRoute(ui, config, routeRepo)
+				cmd := NewCreateRoute(ui, config, routeRepo, spaceRepo, domainRepo)
 				route, apiErr := cmd.CreateRoute("my-host", requirementsFactory.Domain, requirementsFactory.Space.SpaceFields)
 
 				Expect(apiErr).NotTo(HaveOccurred())
@@ -130,4 +134,61 @@ var _ = Describe("create-route command", func() {
 			})
 		})
 	})
+
+	Context("when arguments are missing and the terminal is interactive", func() {
+		BeforeEach(func() {
+			ui.Interactive = true
+			requirementsFactory.LoginSuccess = true
+			requirementsFactory.TargetedOrgSuccess = true
+
+			spaceRepo.Spaces = []models.Space{
+				{SpaceFields: models.SpaceFields{Name: "space-1", Guid: "space-1-guid"}},
+				{SpaceFields: models.SpaceFields{Name: "space-2", Guid: "space-2-guid"}},
+			}
+			domainRepo.ListDomainsForOrgDomains = []models.DomainFields{
+				{Name: "example.com", Guid: "example-domain-guid"},
+				{Name: "example.org", Guid: "other-domain-guid"},
+			}
+		})
+
+		It("prompts for the space and the domain", func() {
+			ui.Inputs = []string{"2", "example.org"}
+
+			Expect(runCommand("-n", "my-host")).To(BeTrue())
+
+			Expect(ui.FailedWithUsage).To(BeFalse())
+			Expect(domainRepo.ListDomainsForOrgGuid).To(Equal(config.OrganizationFields().Guid))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Select a space:"},
+				[]string{"2. space-2"},
+				[]string{"Select a domain:"},
+				[]string{"1. example.com"},
+				[]string{"Creating route", "my-host.example.org"},
+			))
+			Expect(routeRepo.CreateInSpaceHost).To(Equal("my-host"))
+			Expect(routeRepo.CreateInSpaceDomainGuid).To(Equal("other-domain-guid"))
+			Expect(routeRepo.CreateInSpaceSpaceGuid).To(Equal("space-2-guid"))
+		})
+
+		It("only prompts for the domain when the space is given", func() {
+			ui.Inputs = []string{"1"}
+
+			runCommand("space-1")
+
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Select a space:"}))
+			Expect(routeRepo.CreateInSpaceDomainGuid).To(Equal("example-domain-guid"))
+			Expect(routeRepo.CreateInSpaceSpaceGuid).To(Equal("space-1-guid"))
+		})
+
+		It("fails when the given space does not exist", func() {
+			runCommand("no-such-space")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Space no-such-space not found"},
+			))
+			Expect(routeRepo.CreateInSpaceHost).To(BeEmpty())
+			Expect(routeRepo.CreateInSpaceSpaceGuid).To(BeEmpty())
+		})
+	})
 })
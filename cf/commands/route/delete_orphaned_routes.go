@@ -8,6 +8,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/ui_helpers"
 	"github.com/codegangsta/cli"
 )
 
@@ -46,7 +47,11 @@ func (cmd DeleteOrphanedRoutes) GetRequirements(reqFactory requirements.Factory,
 func (cmd DeleteOrphanedRoutes) Run(c *cli.Context) {
 
 	force := c.Bool("f")
-	if !force {
+	if core_config.IsProtectedSpace(cmd.config.ProtectedTargets(), cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name) {
+		if !ui_helpers.ConfirmProtectedDelete(cmd.ui, T("space"), cmd.config.SpaceFields().Name, force) {
+			return
+		}
+	} else if !force {
 		response := cmd.ui.Confirm(T("Really delete orphaned routes?{{.Prompt}}",
 			map[string]interface{}{"Prompt": terminal.PromptColor(">")}))
 
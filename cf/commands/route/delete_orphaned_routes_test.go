@@ -1,7 +1,10 @@
 package route_test
 
 import (
+	"os"
+
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/models"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -106,6 +109,54 @@ var _ = Describe("delete-orphaned-routes command", func() {
 			))
 			Expect(routeRepo.DeletedRouteGuids).To(ContainElement("route2-guid"))
 		})
+
+		Context("when the targeted space is protected", func() {
+			var (
+				ui         *testterm.FakeUI
+				configRepo core_config.ReadWriter
+			)
+
+			BeforeEach(func() {
+				ui = &testterm.FakeUI{}
+				configRepo = testconfig.NewRepositoryWithDefaults()
+				configRepo.SetProtectedTargets([]string{"my-org/my-space"})
+
+				route := models.Route{}
+				route.Guid = "route-guid"
+				route.Host = "hostname"
+				route.Domain = models.DomainFields{Name: "example.com"}
+				routeRepo.Routes = []models.Route{route}
+			})
+
+			AfterEach(func() {
+				os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "")
+			})
+
+			runCommand := func(args ...string) {
+				testcmd.RunCommand(NewDeleteOrphanedRoutes(ui, configRepo, routeRepo), args, reqFactory)
+			}
+
+			It("requires the space name to be typed back", func() {
+				ui.Inputs = []string{"y"}
+				runCommand()
+				Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+
+				ui.Inputs = []string{"my-space"}
+				runCommand()
+				Expect(ui.Prompts).To(ContainSubstrings([]string{"protected target", "space", "my-space"}))
+				Expect(routeRepo.DeletedRouteGuids).To(ContainElement("route-guid"))
+			})
+
+			It("refuses the -f flag unless the override is set", func() {
+				runCommand("-f")
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+				Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+
+				os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "true")
+				runCommand("-f")
+				Expect(routeRepo.DeletedRouteGuids).To(ContainElement("route-guid"))
+			})
+		})
 	})
 })
 
@@ -6,26 +6,32 @@ import (
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/flag_helpers"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/codegangsta/cli"
 )
 
 type MapRoute struct {
-	ui           terminal.UI
-	config       core_config.Reader
-	routeRepo    api.RouteRepository
-	appReq       requirements.ApplicationRequirement
-	domainReq    requirements.DomainRequirement
-	routeCreator RouteCreator
+	ui             terminal.UI
+	config         core_config.Reader
+	routeRepo      api.RouteRepository
+	appSummaryRepo api.AppSummaryRepository
+	domainRepo     api.DomainRepository
+	appReq         requirements.ApplicationRequirement
+	domainReq      requirements.DomainRequirement
+	routeCreator   RouteCreator
+	promptForArgs  bool
 }
 
-func NewMapRoute(ui terminal.UI, config core_config.Reader, routeRepo api.RouteRepository, routeCreator RouteCreator) (cmd *MapRoute) {
+func NewMapRoute(ui terminal.UI, config core_config.Reader, routeRepo api.RouteRepository, routeCreator RouteCreator, appSummaryRepo api.AppSummaryRepository, domainRepo api.DomainRepository) (cmd *MapRoute) {
 	cmd = new(MapRoute)
 	cmd.ui = ui
 	cmd.config = config
 	cmd.routeRepo = routeRepo
 	cmd.routeCreator = routeCreator
+	cmd.appSummaryRepo = appSummaryRepo
+	cmd.domainRepo = domainRepo
 	return
 }
 
@@ -33,7 +39,9 @@ func (cmd *MapRoute) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "map-route",
 		Description: T("Add a url route to an app"),
-		Usage:       T("CF_NAME map-route APP DOMAIN [-n HOSTNAME]"),
+		Usage: T(`CF_NAME map-route APP DOMAIN [-n HOSTNAME]
+
+   When run in a terminal without APP or DOMAIN, the missing arguments are picked from the apps in the space and the domains of the org.`),
 		Flags: []cli.Flag{
 			flag_helpers.NewStringFlag("n", T("Hostname")),
 		},
@@ -41,6 +49,15 @@ func (cmd *MapRoute) Metadata() command_metadata.CommandMetadata {
 }
 
 func (cmd *MapRoute) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) < 2 && cmd.ui.IsInteractive() {
+		cmd.promptForArgs = true
+		reqs = []requirements.Requirement{
+			requirementsFactory.NewLoginRequirement(),
+			requirementsFactory.NewTargetedSpaceRequirement(),
+		}
+		return
+	}
+
 	if len(c.Args()) != 2 {
 		cmd.ui.FailWithUsage(c)
 	}
@@ -61,8 +78,16 @@ func (cmd *MapRoute) GetRequirements(requirementsFactory requirements.Factory, c
 
 func (cmd *MapRoute) Run(c *cli.Context) {
 	hostName := c.String("n")
-	domain := cmd.domainReq.GetDomain()
-	app := cmd.appReq.GetApplication()
+
+	var domain models.DomainFields
+	var app models.Application
+	if cmd.promptForArgs {
+		app = cmd.promptForApp(c.Args())
+		domain = promptForDomain(cmd.ui, cmd.config, cmd.domainRepo)
+	} else {
+		domain = cmd.domainReq.GetDomain()
+		app = cmd.appReq.GetApplication()
+	}
 
 	route, apiErr := cmd.routeCreator.CreateRoute(hostName, domain, cmd.config.SpaceFields())
 	if apiErr != nil {
@@ -84,3 +109,38 @@ func (cmd *MapRoute) Run(c *cli.Context) {
 
 	cmd.ui.Ok()
 }
+
+// promptForApp lets the user pick an app from the targeted space, unless it
+// was given as an argument.
+func (cmd *MapRoute) promptForApp(args []string) (app models.Application) {
+	apps, err := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	var appName string
+	if len(args) > 0 {
+		appName = args[0]
+	} else {
+		appNames := []string{}
+		for _, app := range apps {
+			appNames = append(appNames, app.Name)
+		}
+
+		appName = terminal.PromptForChoice(cmd.ui, appNames, T("Select an app:"), T("App"))
+		if appName == "" {
+			cmd.ui.Failed(T("No app was selected"))
+			return
+		}
+	}
+
+	for _, app := range apps {
+		if app.Name == appName {
+			return app
+		}
+	}
+
+	cmd.ui.Failed(T("App {{.AppName}} not found", map[string]interface{}{"AppName": appName}))
+	return
+}
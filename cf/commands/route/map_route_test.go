@@ -22,6 +22,8 @@ var _ = Describe("map-route command", func() {
 		routeRepo           *testapi.FakeRouteRepository
 		requirementsFactory *testreq.FakeReqFactory
 		routeCreator        *testcmd.FakeRouteCreator
+		appSummaryRepo      *testapi.FakeAppSummaryRepo
+		domainRepo          *testapi.FakeDomainRepository
 	)
 
 	BeforeEach(func() {
@@ -30,10 +32,12 @@ var _ = Describe("map-route command", func() {
 		routeRepo = new(testapi.FakeRouteRepository)
 		routeCreator = &testcmd.FakeRouteCreator{}
 		requirementsFactory = new(testreq.FakeReqFactory)
+		appSummaryRepo = &testapi.FakeAppSummaryRepo{}
+		domainRepo = &testapi.FakeDomainRepository{}
 	})
 
 	runCommand := func(args ...string) bool {
-		return testcmd.RunCommand(NewMapRoute(ui, configRepo, routeRepo, routeCreator), args, requirementsFactory)
+		return testcmd.RunCommand(NewMapRoute(ui, configRepo, routeRepo, routeCreator, appSummaryRepo, domainRepo), args, requirementsFactory)
 	}
 
 	Describe("requirements", func() {
@@ -77,4 +81,58 @@ var _ = Describe("map-route command", func() {
 			Expect(requirementsFactory.DomainName).To(Equal("my-domain.com"))
 		})
 	})
+
+	Context("when arguments are missing and the terminal is interactive", func() {
+		BeforeEach(func() {
+			ui.Interactive = true
+			requirementsFactory.LoginSuccess = true
+			requirementsFactory.TargetedSpaceSuccess = true
+
+			appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{
+				{ApplicationFields: models.ApplicationFields{Name: "app-1", Guid: "app-1-guid"}},
+				{ApplicationFields: models.ApplicationFields{Name: "app-2", Guid: "app-2-guid"}},
+			}
+			domainRepo.ListDomainsForOrgDomains = []models.DomainFields{
+				{Name: "example.com", Guid: "example-domain-guid"},
+			}
+			routeCreator.ReservedRoute = models.Route{Guid: "my-route-guid", Host: "foo", Domain: domainRepo.ListDomainsForOrgDomains[0]}
+		})
+
+		It("requires a targeted space", func() {
+			requirementsFactory.TargetedSpaceSuccess = false
+			Expect(runCommand()).To(BeFalse())
+		})
+
+		It("prompts for the app and the domain", func() {
+			ui.Inputs = []string{"app-2", "1"}
+
+			Expect(runCommand("-n", "foo")).To(BeTrue())
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Select an app:"},
+				[]string{"1. app-1"},
+				[]string{"2. app-2"},
+				[]string{"Select a domain:"},
+				[]string{"1. example.com"},
+				[]string{"Adding route", "foo.example.com", "app-2"},
+				[]string{"OK"},
+			))
+			Expect(routeCreator.CreateRouteHostname).To(Equal("foo"))
+			Expect(routeCreator.CreateRouteDomainFields.Guid).To(Equal("example-domain-guid"))
+			Expect(routeRepo.BoundRouteGuid).To(Equal("my-route-guid"))
+			Expect(routeRepo.BoundAppGuid).To(Equal("app-2-guid"))
+		})
+
+		It("fails when no domain is chosen", func() {
+			ui.Inputs = []string{""}
+
+			runCommand("app-1")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"No domain was selected"},
+			))
+			Expect(routeRepo.BoundAppGuid).To(BeEmpty())
+		})
+	})
 })
@@ -0,0 +1,192 @@
+package route
+
+import (
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/applications"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type MoveRoutes struct {
+	ui        terminal.UI
+	config    core_config.Reader
+	routeRepo api.RouteRepository
+	appRepo   applications.ApplicationRepository
+	appReq    requirements.ApplicationRequirement
+}
+
+func NewMoveRoutes(ui terminal.UI, config core_config.Reader, routeRepo api.RouteRepository, appRepo applications.ApplicationRepository) (cmd *MoveRoutes) {
+	cmd = new(MoveRoutes)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.routeRepo = routeRepo
+	cmd.appRepo = appRepo
+	return
+}
+
+func (cmd *MoveRoutes) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "move-routes",
+		Description: T("Map every route of one app to another app"),
+		Usage:       T("CF_NAME move-routes OLD_APP NEW_APP [--unmap] [--dry-run]"),
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "unmap", Usage: T("Unmap the routes from OLD_APP once they are mapped to NEW_APP")},
+			cli.BoolFlag{Name: "dry-run", Usage: T("Show the routes that would be moved without changing anything")},
+		},
+	}
+}
+
+func (cmd *MoveRoutes) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.appReq = requirementsFactory.NewApplicationRequirement(c.Args()[0])
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.appReq,
+	}
+	return
+}
+
+func (cmd *MoveRoutes) Run(c *cli.Context) {
+	sourceApp := cmd.appReq.GetApplication()
+	unmap := c.Bool("unmap")
+
+	targetApp, apiErr := cmd.appRepo.Read(c.Args()[1])
+	if apiErr != nil {
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	if targetApp.Guid == sourceApp.Guid {
+		cmd.ui.Failed(T("OLD_APP and NEW_APP must be different apps"))
+		return
+	}
+
+	routes := sourceApp.Routes
+	alreadyMapped := routeGuids(targetApp.Routes)
+	if len(routes) == 0 {
+		cmd.ui.Say(T("App {{.AppName}} has no routes to move",
+			map[string]interface{}{"AppName": terminal.EntityNameColor(sourceApp.Name)}))
+		return
+	}
+
+	if c.Bool("dry-run") {
+		cmd.printPlan(sourceApp, targetApp, alreadyMapped, unmap)
+		return
+	}
+
+	cmd.ui.Say(T("Moving routes from app {{.OldApp}} to app {{.NewApp}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+		map[string]interface{}{
+			"OldApp":    terminal.EntityNameColor(sourceApp.Name),
+			"NewApp":    terminal.EntityNameColor(targetApp.Name),
+			"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"Username":  terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	bound := []models.RouteSummary{}
+	for _, route := range routes {
+		if alreadyMapped[route.Guid] {
+			continue
+		}
+
+		cmd.ui.Say(T("Mapping {{.URL}} to {{.AppName}}",
+			map[string]interface{}{"URL": terminal.EntityNameColor(route.URL()), "AppName": terminal.EntityNameColor(targetApp.Name)}))
+
+		apiErr = cmd.routeRepo.Bind(route.Guid, targetApp.Guid)
+		if apiErr != nil {
+			cmd.rollback(bound, targetApp, nil, sourceApp)
+			cmd.ui.Failed(T("Could not map route {{.URL}} to app {{.AppName}}, no routes were moved.\n{{.Err}}",
+				map[string]interface{}{"URL": route.URL(), "AppName": targetApp.Name, "Err": apiErr.Error()}))
+			return
+		}
+		bound = append(bound, route)
+	}
+
+	if unmap {
+		unbound := []models.RouteSummary{}
+		for _, route := range routes {
+			cmd.ui.Say(T("Unmapping {{.URL}} from {{.AppName}}",
+				map[string]interface{}{"URL": terminal.EntityNameColor(route.URL()), "AppName": terminal.EntityNameColor(sourceApp.Name)}))
+
+			apiErr = cmd.routeRepo.Unbind(route.Guid, sourceApp.Guid)
+			if apiErr != nil {
+				cmd.rollback(bound, targetApp, unbound, sourceApp)
+				cmd.ui.Failed(T("Could not unmap route {{.URL}} from app {{.AppName}}, no routes were moved.\n{{.Err}}",
+					map[string]interface{}{"URL": route.URL(), "AppName": sourceApp.Name, "Err": apiErr.Error()}))
+				return
+			}
+			unbound = append(unbound, route)
+		}
+	}
+
+	cmd.ui.Ok()
+}
+
+// rollback restores the routes of both apps after a failed move: routes that
+// were newly mapped to target are unmapped and routes that were unmapped from
+// source are mapped back.
+func (cmd *MoveRoutes) rollback(bound []models.RouteSummary, target models.Application, unbound []models.RouteSummary, source models.Application) {
+	if len(bound) == 0 && len(unbound) == 0 {
+		return
+	}
+
+	cmd.ui.Say(T("Rolling back..."))
+
+	for _, route := range unbound {
+		if err := cmd.routeRepo.Bind(route.Guid, source.Guid); err != nil {
+			cmd.ui.Warn(T("Could not map route {{.URL}} back to app {{.AppName}}: {{.Err}}",
+				map[string]interface{}{"URL": route.URL(), "AppName": source.Name, "Err": err.Error()}))
+		}
+	}
+
+	for _, route := range bound {
+		if err := cmd.routeRepo.Unbind(route.Guid, target.Guid); err != nil {
+			cmd.ui.Warn(T("Could not unmap route {{.URL}} from app {{.AppName}}: {{.Err}}",
+				map[string]interface{}{"URL": route.URL(), "AppName": target.Name, "Err": err.Error()}))
+		}
+	}
+}
+
+func (cmd *MoveRoutes) printPlan(source, target models.Application, alreadyMapped map[string]bool, unmap bool) {
+	cmd.ui.Say(T("Routes that would be moved from app {{.OldApp}} to app {{.NewApp}} (dry run, nothing will be changed):\n",
+		map[string]interface{}{
+			"OldApp": terminal.EntityNameColor(source.Name),
+			"NewApp": terminal.EntityNameColor(target.Name),
+		}))
+
+	table := cmd.ui.Table([]string{T("route"), T("action")})
+	for _, route := range source.Routes {
+		var action string
+		switch {
+		case alreadyMapped[route.Guid] && unmap:
+			action = T("unmap from {{.AppName}}", map[string]interface{}{"AppName": source.Name})
+		case alreadyMapped[route.Guid]:
+			action = T("none, already mapped to {{.AppName}}", map[string]interface{}{"AppName": target.Name})
+		case unmap:
+			action = T("map to {{.NewApp}}, unmap from {{.OldApp}}",
+				map[string]interface{}{"NewApp": target.Name, "OldApp": source.Name})
+		default:
+			action = T("map to {{.AppName}}", map[string]interface{}{"AppName": target.Name})
+		}
+		table.Add(route.URL(), action)
+	}
+	table.Print()
+}
+
+func routeGuids(routes []models.RouteSummary) map[string]bool {
+	guids := map[string]bool{}
+	for _, route := range routes {
+		guids[route.Guid] = true
+	}
+	return guids
+}
@@ -0,0 +1,178 @@
+package route_test
+
+import (
+	"errors"
+
+	testApplication "github.com/cloudfoundry/cli/cf/api/applications/fakes"
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/route"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("move-routes command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		configRepo          core_config.ReadWriter
+		routeRepo           *testapi.FakeRouteRepository
+		appRepo             *testApplication.FakeApplicationRepository
+		requirementsFactory *testreq.FakeReqFactory
+		oldApp              models.Application
+		newApp              models.Application
+	)
+
+	BeforeEach(func() {
+		ui = new(testterm.FakeUI)
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		routeRepo = new(testapi.FakeRouteRepository)
+		appRepo = new(testApplication.FakeApplicationRepository)
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+
+		domain := models.DomainFields{Guid: "domain-guid", Name: "example.com"}
+
+		oldApp = models.Application{}
+		oldApp.Name = "old-app"
+		oldApp.Guid = "old-app-guid"
+		oldApp.Routes = []models.RouteSummary{
+			{Guid: "route-1-guid", Host: "www", Domain: domain},
+			{Guid: "route-2-guid", Host: "api", Domain: domain},
+			{Guid: "route-3-guid", Host: "shared", Domain: domain},
+		}
+
+		newApp = models.Application{}
+		newApp.Name = "new-app"
+		newApp.Guid = "new-app-guid"
+		newApp.Routes = []models.RouteSummary{
+			{Guid: "route-3-guid", Host: "shared", Domain: domain},
+		}
+
+		requirementsFactory.Application = oldApp
+		appRepo.ReadReturns.App = newApp
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCommand(NewMoveRoutes(ui, configRepo, routeRepo, appRepo), args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given two apps", func() {
+			runCommand("old-app")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand("old-app", "new-app")).To(BeFalse())
+		})
+
+		It("fails when a space is not targeted", func() {
+			requirementsFactory.TargetedSpaceSuccess = false
+			Expect(runCommand("old-app", "new-app")).To(BeFalse())
+		})
+	})
+
+	It("maps every route of the old app to the new app", func() {
+		runCommand("old-app", "new-app")
+
+		Expect(requirementsFactory.ApplicationName).To(Equal("old-app"))
+		Expect(appRepo.ReadArgs.Name).To(Equal("new-app"))
+		Expect(routeRepo.BoundRouteGuids).To(Equal([]string{"route-1-guid", "route-2-guid"}))
+		Expect(routeRepo.BoundAppGuids).To(Equal([]string{"new-app-guid", "new-app-guid"}))
+		Expect(routeRepo.UnboundRouteGuids).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Moving routes", "old-app", "new-app", "my-org", "my-space", "my-user"},
+			[]string{"Mapping", "www.example.com"},
+			[]string{"Mapping", "api.example.com"},
+			[]string{"OK"},
+		))
+	})
+
+	It("unmaps every route from the old app with --unmap", func() {
+		runCommand("--unmap", "old-app", "new-app")
+
+		Expect(routeRepo.BoundRouteGuids).To(Equal([]string{"route-1-guid", "route-2-guid"}))
+		Expect(routeRepo.UnboundRouteGuids).To(Equal([]string{"route-1-guid", "route-2-guid", "route-3-guid"}))
+		Expect(routeRepo.UnboundAppGuids).To(Equal([]string{"old-app-guid", "old-app-guid", "old-app-guid"}))
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+	})
+
+	It("only shows what would change with --dry-run", func() {
+		runCommand("--dry-run", "--unmap", "old-app", "new-app")
+
+		Expect(routeRepo.BoundRouteGuids).To(BeEmpty())
+		Expect(routeRepo.UnboundRouteGuids).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"dry run"},
+			[]string{"route", "action"},
+			[]string{"www.example.com", "map to new-app, unmap from old-app"},
+			[]string{"api.example.com", "map to new-app, unmap from old-app"},
+			[]string{"shared.example.com", "unmap from old-app"},
+		))
+	})
+
+	It("unmaps the routes it mapped when a mapping fails", func() {
+		routeRepo.BindErrByRouteGuid = map[string]error{"route-2-guid": errors.New("bind failed")}
+
+		runCommand("--unmap", "old-app", "new-app")
+
+		Expect(routeRepo.UnboundRouteGuids).To(Equal([]string{"route-1-guid"}))
+		Expect(routeRepo.UnboundAppGuids).To(Equal([]string{"new-app-guid"}))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Rolling back"},
+			[]string{"FAILED"},
+			[]string{"api.example.com", "no routes were moved"},
+			[]string{"bind failed"},
+		))
+	})
+
+	It("restores both apps when unmapping from the old app fails", func() {
+		routeRepo.UnbindErrByRouteGuid = map[string]error{"route-2-guid": errors.New("unbind failed")}
+
+		runCommand("--unmap", "old-app", "new-app")
+
+		Expect(routeRepo.BoundRouteGuids).To(Equal([]string{"route-1-guid", "route-2-guid", "route-1-guid"}))
+		Expect(routeRepo.BoundAppGuids).To(Equal([]string{"new-app-guid", "new-app-guid", "old-app-guid"}))
+		Expect(routeRepo.UnboundRouteGuids).To(Equal([]string{"route-1-guid", "route-2-guid", "route-1-guid", "route-2-guid"}))
+		Expect(routeRepo.UnboundAppGuids).To(Equal([]string{"old-app-guid", "old-app-guid", "new-app-guid", "new-app-guid"}))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Rolling back"},
+			[]string{"FAILED"},
+			[]string{"unbind failed"},
+		))
+	})
+
+	It("fails when the new app cannot be found", func() {
+		appRepo.ReadReturns.Error = errors.New("app not found")
+
+		runCommand("old-app", "new-app")
+
+		Expect(routeRepo.BoundRouteGuids).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"app not found"}))
+	})
+
+	It("fails when both apps are the same", func() {
+		appRepo.ReadReturns.App = oldApp
+
+		runCommand("old-app", "old-app")
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"must be different"}))
+	})
+
+	It("says when the old app has no routes", func() {
+		oldApp.Routes = nil
+		requirementsFactory.Application = oldApp
+
+		runCommand("old-app", "new-app")
+
+		Expect(routeRepo.BoundRouteGuids).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"old-app", "has no routes to move"}))
+	})
+})
@@ -0,0 +1,120 @@
+package route
+
+import (
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/spaces"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type RouteOwner struct {
+	ui        terminal.UI
+	config    core_config.Reader
+	routeRepo api.RouteRepository
+	spaceRepo spaces.SpaceRepository
+	domainReq requirements.DomainRequirement
+}
+
+func NewRouteOwner(ui terminal.UI, config core_config.Reader, routeRepo api.RouteRepository, spaceRepo spaces.SpaceRepository) (cmd *RouteOwner) {
+	cmd = new(RouteOwner)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.routeRepo = routeRepo
+	cmd.spaceRepo = spaceRepo
+	return
+}
+
+func (cmd *RouteOwner) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "route-owner",
+		Description: T("Show the org and space that own a route"),
+		Usage:       T("CF_NAME route-owner HOST DOMAIN"),
+	}
+}
+
+func (cmd *RouteOwner) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.domainReq = requirementsFactory.NewDomainRequirement(c.Args()[1])
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedOrgRequirement(),
+		cmd.domainReq,
+	}
+	return
+}
+
+func (cmd *RouteOwner) Run(c *cli.Context) {
+	host := c.Args()[0]
+	domain := cmd.domainReq.GetDomain()
+	url := (models.Route{Host: host, Domain: domain}).URL()
+
+	cmd.ui.Say(T("Getting owner of route {{.URL}} as {{.Username}}...",
+		map[string]interface{}{
+			"URL":      terminal.EntityNameColor(url),
+			"Username": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	route, apiErr := cmd.routeRepo.FindByHostAndDomain(host, domain)
+	switch apiErr.(type) {
+	case nil:
+	case *errors.ModelNotFoundError:
+		cmd.reportInvisibleRoute(host, domain, url)
+		return
+	default:
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	orgName := T("unknown")
+	space, apiErr := cmd.spaceRepo.FindByGuid(route.Space.Guid)
+	if apiErr == nil {
+		orgName = space.Organization.Name
+	} else {
+		space.SpaceFields = route.Space
+	}
+
+	apps := []string{}
+	for _, app := range route.Apps {
+		apps = append(apps, terminal.EntityNameColor(app.Name))
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	table := terminal.NewTable(cmd.ui, []string{terminal.EntityNameColor(url), ""})
+	table.Add(T("Org:"), terminal.EntityNameColor(orgName))
+	table.Add(T("Space:"), terminal.EntityNameColor(space.Name))
+	table.Add(T("Apps:"), strings.Join(apps, ", "))
+	table.Print()
+}
+
+func (cmd *RouteOwner) reportInvisibleRoute(host string, domain models.DomainFields, url string) {
+	reserved, apiErr := cmd.routeRepo.CheckIfExists(host, domain)
+	if apiErr != nil {
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say("")
+
+	if reserved {
+		cmd.ui.Say(T("Route {{.URL}} is reserved by a space you do not have access to",
+			map[string]interface{}{"URL": terminal.EntityNameColor(url)}))
+	} else {
+		cmd.ui.Say(T("Route {{.URL}} does not exist",
+			map[string]interface{}{"URL": terminal.EntityNameColor(url)}))
+	}
+}
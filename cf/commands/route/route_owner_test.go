@@ -0,0 +1,133 @@
+package route_test
+
+import (
+	"errors"
+
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	cferrors "github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/route"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("route-owner command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		configRepo          core_config.ReadWriter
+		routeRepo           *testapi.FakeRouteRepository
+		spaceRepo           *testapi.FakeSpaceRepository
+		requirementsFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = new(testterm.FakeUI)
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		routeRepo = new(testapi.FakeRouteRepository)
+		spaceRepo = new(testapi.FakeSpaceRepository)
+		requirementsFactory = &testreq.FakeReqFactory{
+			LoginSuccess:       true,
+			TargetedOrgSuccess: true,
+			Domain:             models.DomainFields{Guid: "domain-guid", Name: "example.com"},
+		}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCommand(NewRouteOwner(ui, configRepo, routeRepo, spaceRepo), args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given a host and a domain", func() {
+			runCommand("my-host")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand("my-host", "example.com")).To(BeFalse())
+		})
+
+		It("fails when no org is targeted", func() {
+			requirementsFactory.TargetedOrgSuccess = false
+			Expect(runCommand("my-host", "example.com")).To(BeFalse())
+		})
+	})
+
+	It("shows the org and space that own the route", func() {
+		routeRepo.FindByHostAndDomainReturns.Route = models.Route{
+			Guid:   "route-guid",
+			Host:   "my-host",
+			Domain: models.DomainFields{Guid: "domain-guid", Name: "example.com"},
+			Space:  models.SpaceFields{Guid: "owner-space-guid", Name: "owner-space"},
+			Apps:   []models.ApplicationFields{{Name: "app-1"}, {Name: "app-2"}},
+		}
+		spaceRepo.FindByGuidSpace = models.Space{
+			SpaceFields:  models.SpaceFields{Guid: "owner-space-guid", Name: "owner-space"},
+			Organization: models.OrganizationFields{Name: "owner-org"},
+		}
+
+		runCommand("my-host", "example.com")
+
+		Expect(requirementsFactory.DomainName).To(Equal("example.com"))
+		Expect(routeRepo.FindByHostAndDomainCalledWith.Host).To(Equal("my-host"))
+		Expect(spaceRepo.FindByGuidGuid).To(Equal("owner-space-guid"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Getting owner of route", "my-host.example.com", "my-user"},
+			[]string{"OK"},
+			[]string{"Org:", "owner-org"},
+			[]string{"Space:", "owner-space"},
+			[]string{"Apps:", "app-1, app-2"},
+		))
+	})
+
+	It("shows the space from the route when the space cannot be read", func() {
+		routeRepo.FindByHostAndDomainReturns.Route = models.Route{
+			Host:  "my-host",
+			Space: models.SpaceFields{Guid: "owner-space-guid", Name: "owner-space"},
+		}
+		spaceRepo.FindByGuidErr = errors.New("forbidden")
+
+		runCommand("my-host", "example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Org:", "unknown"},
+			[]string{"Space:", "owner-space"},
+		))
+	})
+
+	It("says when the route is reserved by a space the user cannot see", func() {
+		routeRepo.FindByHostAndDomainReturns.Error = cferrors.NewModelNotFoundError("Route", "my-host")
+		routeRepo.CheckIfExistsFound = true
+
+		runCommand("my-host", "example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"my-host.example.com", "is reserved by a space you do not have access to"},
+		))
+	})
+
+	It("says when the route does not exist", func() {
+		routeRepo.FindByHostAndDomainReturns.Error = cferrors.NewModelNotFoundError("Route", "my-host")
+
+		runCommand("my-host", "example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"my-host.example.com", "does not exist"},
+		))
+	})
+
+	It("fails when the route cannot be fetched", func() {
+		routeRepo.FindByHostAndDomainReturns.Error = errors.New("server error")
+
+		runCommand("my-host", "example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}, []string{"server error"}))
+	})
+})
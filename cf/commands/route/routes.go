@@ -1,12 +1,15 @@
 package route
 
 import (
-	. "github.com/cloudfoundry/cli/cf/i18n"
+	"path"
 	"strings"
 
+	. "github.com/cloudfoundry/cli/cf/i18n"
+
 	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
@@ -30,8 +33,15 @@ func (cmd ListRoutes) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "routes",
 		ShortName:   "r",
-		Description: T("List all routes in the current space"),
-		Usage:       "CF_NAME routes",
+		Description: T("List all routes in the current space or the current organization"),
+		Usage:       T("CF_NAME routes [--orglevel] [--domain DOMAIN] [--hostname HOST_PATTERN] [--app APP] [--orphaned]"),
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "orglevel", Usage: T("List all the routes for all spaces of current organization")},
+			flag_helpers.NewStringFlag("domain", T("Only list routes in this domain")),
+			flag_helpers.NewStringFlag("hostname", T("Only list routes whose hostname matches this pattern, e.g. 'api-*'")),
+			flag_helpers.NewStringFlag("app", T("Only list routes bound to this app")),
+			cli.BoolFlag{Name: "orphaned", Usage: T("Only list routes that are not bound to any app")},
+		},
 	}
 }
 
@@ -39,6 +49,14 @@ func (cmd ListRoutes) GetRequirements(requirementsFactory requirements.Factory,
 	if len(c.Args()) != 0 {
 		cmd.ui.FailWithUsage(c)
 	}
+
+	if c.Bool("orglevel") {
+		return []requirements.Requirement{
+			requirementsFactory.NewLoginRequirement(),
+			requirementsFactory.NewTargetedOrgRequirement(),
+		}, nil
+	}
+
 	return []requirements.Requirement{
 		requirementsFactory.NewLoginRequirement(),
 		requirementsFactory.NewTargetedSpaceRequirement(),
@@ -46,22 +64,77 @@ func (cmd ListRoutes) GetRequirements(requirementsFactory requirements.Factory,
 }
 
 func (cmd ListRoutes) Run(c *cli.Context) {
-	cmd.ui.Say(T("Getting routes as {{.Username}} ...\n",
-		map[string]interface{}{"Username": terminal.EntityNameColor(cmd.config.Username())}))
+	orgLevel := c.Bool("orglevel")
+	filter := routeFilter{
+		domain:   c.String("domain"),
+		hostname: c.String("hostname"),
+		app:      c.String("app"),
+		orphaned: c.Bool("orphaned"),
+	}
 
-	table := cmd.ui.Table([]string{T("host"), T("domain"), T("apps")})
+	if filter.orphaned && filter.app != "" {
+		cmd.ui.Failed(T("The --orphaned and --app flags cannot be used together"))
+		return
+	}
+
+	if _, err := path.Match(filter.hostname, ""); err != nil {
+		cmd.ui.Failed(T("Invalid hostname pattern '{{.Pattern}}'", map[string]interface{}{"Pattern": filter.hostname}))
+		return
+	}
+
+	switch {
+	case orgLevel && filter.orphaned:
+		cmd.ui.Say(T("Getting orphaned routes for org {{.OrgName}} as {{.Username}} ...\n",
+			map[string]interface{}{
+				"OrgName":  terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"Username": terminal.EntityNameColor(cmd.config.Username()),
+			}))
+	case orgLevel:
+		cmd.ui.Say(T("Getting routes for org {{.OrgName}} as {{.Username}} ...\n",
+			map[string]interface{}{
+				"OrgName":  terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"Username": terminal.EntityNameColor(cmd.config.Username()),
+			}))
+	case filter.orphaned:
+		cmd.ui.Say(T("Getting orphaned routes as {{.Username}} ...\n",
+			map[string]interface{}{"Username": terminal.EntityNameColor(cmd.config.Username())}))
+	default:
+		cmd.ui.Say(T("Getting routes as {{.Username}} ...\n",
+			map[string]interface{}{"Username": terminal.EntityNameColor(cmd.config.Username())}))
+	}
+
+	headers := []string{T("host"), T("domain"), T("apps")}
+	if orgLevel {
+		headers = append([]string{T("space")}, headers...)
+	}
+	table := cmd.ui.Table(headers)
 
 	noRoutes := true
-	apiErr := cmd.routeRepo.ListRoutes(func(route models.Route) bool {
+	cb := func(route models.Route) bool {
+		if !filter.matches(route) {
+			return true
+		}
+
 		noRoutes = false
 		appNames := []string{}
 		for _, app := range route.Apps {
 			appNames = append(appNames, app.Name)
 		}
 
-		table.Add(route.Host, route.Domain.Name, strings.Join(appNames, ","))
+		row := []string{route.Host, route.Domain.Name, strings.Join(appNames, ",")}
+		if orgLevel {
+			row = append([]string{route.Space.Name}, row...)
+		}
+		table.Add(row...)
 		return true
-	})
+	}
+
+	var apiErr error
+	if orgLevel {
+		apiErr = cmd.routeRepo.ListRoutesInOrg(cmd.config.OrganizationFields().Guid, cb)
+	} else {
+		apiErr = cmd.routeRepo.ListRoutes(cb)
+	}
 	table.Print()
 
 	if apiErr != nil {
@@ -73,3 +146,37 @@ func (cmd ListRoutes) Run(c *cli.Context) {
 		cmd.ui.Say(T("No routes found"))
 	}
 }
+
+type routeFilter struct {
+	domain   string
+	hostname string
+	app      string
+	orphaned bool
+}
+
+func (filter routeFilter) matches(route models.Route) bool {
+	if filter.domain != "" && !strings.EqualFold(route.Domain.Name, filter.domain) {
+		return false
+	}
+
+	if filter.hostname != "" {
+		if matched, _ := path.Match(filter.hostname, route.Host); !matched {
+			return false
+		}
+	}
+
+	if filter.orphaned && len(route.Apps) > 0 {
+		return false
+	}
+
+	if filter.app != "" {
+		for _, app := range route.Apps {
+			if app.Name == filter.app {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
@@ -89,6 +89,134 @@ var _ = Describe("routes command", func() {
 		})
 	})
 
+	Context("when filtering routes", func() {
+		BeforeEach(func() {
+			dora := models.ApplicationFields{Name: "dora"}
+			bora := models.ApplicationFields{Name: "bora"}
+
+			routeRepo.Routes = []models.Route{
+				{Host: "api-v1", Domain: models.DomainFields{Name: "example.com"}, Apps: []models.ApplicationFields{dora}},
+				{Host: "api-v2", Domain: models.DomainFields{Name: "cookieclicker.co"}, Apps: []models.ApplicationFields{bora}},
+				{Host: "www", Domain: models.DomainFields{Name: "example.com"}, Apps: []models.ApplicationFields{dora, bora}},
+				{Host: "stale", Domain: models.DomainFields{Name: "example.com"}},
+			}
+		})
+
+		It("lists only routes in the given domain", func() {
+			runCommand("--domain", "example.com")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"api-v1", "example.com"},
+				[]string{"www", "example.com"},
+				[]string{"stale", "example.com"},
+			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"api-v2"}))
+		})
+
+		It("lists only routes whose hostname matches the pattern", func() {
+			runCommand("--hostname", "api-*")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"api-v1", "example.com", "dora"},
+				[]string{"api-v2", "cookieclicker.co", "bora"},
+			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"www"}))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"stale"}))
+		})
+
+		It("lists only routes bound to the given app", func() {
+			runCommand("--app", "bora")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"api-v2", "cookieclicker.co", "bora"},
+				[]string{"www", "example.com", "dora,bora"},
+			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"api-v1"}))
+		})
+
+		It("lists only orphaned routes without deleting them", func() {
+			runCommand("--orphaned")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Getting orphaned routes", "my-user"},
+				[]string{"stale", "example.com"},
+			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"api-v1"}))
+			Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+		})
+
+		It("tells the user when no routes match", func() {
+			runCommand("--domain", "nowhere.com")
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"No routes found"}))
+		})
+
+		It("fails when --orphaned and --app are both given", func() {
+			runCommand("--orphaned", "--app", "dora")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"--orphaned", "--app"},
+			))
+		})
+
+		It("fails when the hostname pattern is malformed", func() {
+			runCommand("--hostname", "api-[")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Invalid hostname pattern", "api-["},
+			))
+		})
+	})
+
+	Context("when listing routes for the org", func() {
+		BeforeEach(func() {
+			requirementsFactory.TargetedSpaceSuccess = false
+			requirementsFactory.TargetedOrgSuccess = true
+
+			routeRepo.OrgRoutes = []models.Route{
+				{
+					Host:   "hostname-1",
+					Domain: models.DomainFields{Name: "example.com"},
+					Space:  models.SpaceFields{Name: "space-1"},
+					Apps:   []models.ApplicationFields{{Name: "dora"}},
+				},
+				{
+					Host:   "hostname-2",
+					Domain: models.DomainFields{Name: "example.com"},
+					Space:  models.SpaceFields{Name: "space-2"},
+				},
+			}
+		})
+
+		It("only requires a targeted org", func() {
+			Expect(runCommand("--orglevel")).To(BeTrue())
+		})
+
+		It("lists the routes of every space in the org", func() {
+			runCommand("--orglevel")
+
+			Expect(routeRepo.ListRoutesInOrgGuid).To(Equal("my-org-guid"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Getting routes for org", "my-org", "my-user"},
+				[]string{"space", "host", "domain", "apps"},
+				[]string{"space-1", "hostname-1", "example.com", "dora"},
+				[]string{"space-2", "hostname-2", "example.com"},
+			))
+		})
+
+		It("reports orphaned routes across the org", func() {
+			runCommand("--orglevel", "--orphaned")
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Getting orphaned routes for org", "my-org"},
+				[]string{"space-2", "hostname-2", "example.com"},
+			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"hostname-1"}))
+		})
+	})
+
 	Context("when there are not routes", func() {
 		It("tells the user when no routes were found", func() {
 			runCommand()
@@ -0,0 +1,156 @@
+package route
+
+import (
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/spaces"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type TransferRoute struct {
+	ui        terminal.UI
+	config    core_config.Reader
+	routeRepo api.RouteRepository
+	spaceRepo spaces.SpaceRepository
+	domainReq requirements.DomainRequirement
+}
+
+func NewTransferRoute(ui terminal.UI, config core_config.Reader, routeRepo api.RouteRepository, spaceRepo spaces.SpaceRepository) (cmd *TransferRoute) {
+	cmd = new(TransferRoute)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.routeRepo = routeRepo
+	cmd.spaceRepo = spaceRepo
+	return
+}
+
+func (cmd *TransferRoute) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "transfer-route",
+		Description: T("Move a route to another space in the current org"),
+		Usage:       T("CF_NAME transfer-route HOST DOMAIN SPACE [-f]"),
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "f", Usage: T("Force transfer without confirmation")},
+		},
+	}
+}
+
+func (cmd *TransferRoute) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 3 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.domainReq = requirementsFactory.NewDomainRequirement(c.Args()[1])
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedOrgRequirement(),
+		cmd.domainReq,
+	}
+	return
+}
+
+func (cmd *TransferRoute) Run(c *cli.Context) {
+	host := c.Args()[0]
+	domain := cmd.domainReq.GetDomain()
+	url := (models.Route{Host: host, Domain: domain}).URL()
+
+	targetSpace, apiErr := cmd.spaceRepo.FindByName(c.Args()[2])
+	if apiErr != nil {
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	route, apiErr := cmd.routeRepo.FindByHostAndDomain(host, domain)
+	switch apiErr.(type) {
+	case nil:
+	case *errors.ModelNotFoundError:
+		cmd.ui.Failed(T("Route {{.URL}} does not exist or belongs to a space you do not have access to",
+			map[string]interface{}{"URL": url}))
+		return
+	default:
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	routeSpace, apiErr := cmd.spaceRepo.FindByGuid(route.Space.Guid)
+	if apiErr != nil {
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	if routeSpace.Organization.Guid != cmd.config.OrganizationFields().Guid {
+		cmd.ui.Failed(T("Route {{.URL}} belongs to org {{.RouteOrg}}, not the targeted org {{.TargetedOrg}}",
+			map[string]interface{}{
+				"URL":         url,
+				"RouteOrg":    routeSpace.Organization.Name,
+				"TargetedOrg": cmd.config.OrganizationFields().Name,
+			}))
+		return
+	}
+
+	if route.Space.Guid == targetSpace.Guid {
+		cmd.ui.Ok()
+		cmd.ui.Say(T("Route {{.URL}} is already in space {{.SpaceName}}",
+			map[string]interface{}{"URL": terminal.EntityNameColor(url), "SpaceName": terminal.EntityNameColor(targetSpace.Name)}))
+		return
+	}
+
+	if len(route.Apps) > 0 {
+		appNames := []string{}
+		for _, app := range route.Apps {
+			appNames = append(appNames, app.Name)
+		}
+		cmd.ui.Failed(T("Route {{.URL}} is mapped to {{.AppNames}}. Unmap it before transferring it to another space.",
+			map[string]interface{}{"URL": url, "AppNames": strings.Join(appNames, ", ")}))
+		return
+	}
+
+	if !c.Bool("f") {
+		if !cmd.ui.Confirm(T("Really transfer route {{.URL}} from space {{.OldSpace}} to space {{.NewSpace}}?",
+			map[string]interface{}{"URL": url, "OldSpace": route.Space.Name, "NewSpace": targetSpace.Name})) {
+			return
+		}
+	}
+
+	cmd.ui.Say(T("Transferring route {{.URL}} from space {{.OldSpace}} to space {{.NewSpace}} in org {{.OrgName}} as {{.Username}}...",
+		map[string]interface{}{
+			"URL":      terminal.EntityNameColor(url),
+			"OldSpace": terminal.EntityNameColor(route.Space.Name),
+			"NewSpace": terminal.EntityNameColor(targetSpace.Name),
+			"OrgName":  terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"Username": terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	apiErr = cmd.routeRepo.Delete(route.Guid)
+	if apiErr != nil {
+		cmd.ui.Failed(apiErr.Error())
+		return
+	}
+
+	_, apiErr = cmd.routeRepo.CreateInSpace(host, domain.Guid, targetSpace.Guid)
+	if apiErr == nil {
+		cmd.ui.Ok()
+		return
+	}
+
+	// The hostname is free between the delete and the create, so put the route
+	// back where it was if it could not be created in the target space.
+	_, restoreErr := cmd.routeRepo.CreateInSpace(host, domain.Guid, route.Space.Guid)
+	if restoreErr == nil {
+		cmd.ui.Failed(T("Could not create route {{.URL}} in space {{.SpaceName}}, it was restored to space {{.OldSpace}}.\n{{.Err}}",
+			map[string]interface{}{"URL": url, "SpaceName": targetSpace.Name, "OldSpace": route.Space.Name, "Err": apiErr.Error()}))
+		return
+	}
+
+	cmd.ui.Failed(T("Could not create route {{.URL}} in space {{.SpaceName}} or restore it to space {{.OldSpace}}. The hostname may have been reserved by someone else.\n{{.Err}}",
+		map[string]interface{}{"URL": url, "SpaceName": targetSpace.Name, "OldSpace": route.Space.Name, "Err": restoreErr.Error()}))
+}
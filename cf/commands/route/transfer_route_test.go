@@ -0,0 +1,178 @@
+package route_test
+
+import (
+	"errors"
+
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/route"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("transfer-route command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		configRepo          core_config.ReadWriter
+		routeRepo           *testapi.FakeRouteRepository
+		spaceRepo           *testapi.FakeSpaceRepository
+		requirementsFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{Inputs: []string{"y"}}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		routeRepo = new(testapi.FakeRouteRepository)
+		spaceRepo = &testapi.FakeSpaceRepository{
+			Spaces: []models.Space{
+				{SpaceFields: models.SpaceFields{Guid: "new-space-guid", Name: "new-space"}},
+			},
+			FindByGuidSpace: models.Space{
+				SpaceFields:  models.SpaceFields{Guid: "old-space-guid", Name: "old-space"},
+				Organization: models.OrganizationFields{Guid: "my-org-guid", Name: "my-org"},
+			},
+		}
+		requirementsFactory = &testreq.FakeReqFactory{
+			LoginSuccess:       true,
+			TargetedOrgSuccess: true,
+			Domain:             models.DomainFields{Guid: "domain-guid", Name: "example.com"},
+		}
+
+		routeRepo.FindByHostAndDomainReturns.Route = models.Route{
+			Guid:   "route-guid",
+			Host:   "my-host",
+			Domain: models.DomainFields{Guid: "domain-guid", Name: "example.com"},
+			Space:  models.SpaceFields{Guid: "old-space-guid", Name: "old-space"},
+		}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCommand(NewTransferRoute(ui, configRepo, routeRepo, spaceRepo), args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given a host, a domain and a space", func() {
+			runCommand("my-host", "example.com")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand("my-host", "example.com", "new-space")).To(BeFalse())
+		})
+
+		It("fails when an org is not targeted", func() {
+			requirementsFactory.TargetedOrgSuccess = false
+			Expect(runCommand("my-host", "example.com", "new-space")).To(BeFalse())
+		})
+	})
+
+	It("recreates the route in the target space after confirmation", func() {
+		runCommand("my-host", "example.com", "new-space")
+
+		Expect(ui.Prompts).To(ContainSubstrings(
+			[]string{"Really transfer route my-host.example.com from space old-space to space new-space"},
+		))
+		Expect(routeRepo.DeletedRouteGuids).To(Equal([]string{"route-guid"}))
+		Expect(routeRepo.CreateInSpaceHost).To(Equal("my-host"))
+		Expect(routeRepo.CreateInSpaceDomainGuid).To(Equal("domain-guid"))
+		Expect(routeRepo.CreateInSpaceSpaceGuids).To(Equal([]string{"new-space-guid"}))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Transferring route", "my-host.example.com", "old-space", "new-space", "my-org", "my-user"},
+			[]string{"OK"},
+		))
+	})
+
+	It("does nothing when the user does not confirm", func() {
+		ui.Inputs = []string{"n"}
+
+		runCommand("my-host", "example.com", "new-space")
+
+		Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+	})
+
+	It("does not prompt with -f", func() {
+		ui.Inputs = []string{}
+
+		runCommand("-f", "my-host", "example.com", "new-space")
+
+		Expect(ui.Prompts).To(BeEmpty())
+		Expect(routeRepo.CreateInSpaceSpaceGuids).To(Equal([]string{"new-space-guid"}))
+	})
+
+	It("refuses to transfer a route that is mapped to apps", func() {
+		routeRepo.FindByHostAndDomainReturns.Route.Apps = []models.ApplicationFields{{Name: "my-app"}}
+
+		runCommand("-f", "my-host", "example.com", "new-space")
+
+		Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"my-host.example.com", "is mapped to my-app"},
+		))
+	})
+
+	It("does nothing when the route is already in the target space", func() {
+		routeRepo.FindByHostAndDomainReturns.Route.Space = models.SpaceFields{Guid: "new-space-guid", Name: "new-space"}
+
+		runCommand("-f", "my-host", "example.com", "new-space")
+
+		Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"already in space", "new-space"}))
+	})
+
+	It("refuses to transfer a route that belongs to another org", func() {
+		spaceRepo.FindByGuidSpace.Organization = models.OrganizationFields{Guid: "other-org-guid", Name: "other-org"}
+
+		runCommand("-f", "my-host", "example.com", "new-space")
+
+		Expect(spaceRepo.FindByGuidGuid).To(Equal("old-space-guid"))
+		Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"my-host.example.com", "belongs to org other-org", "not the targeted org my-org"},
+		))
+	})
+
+	It("fails when the target space does not exist", func() {
+		runCommand("-f", "my-host", "example.com", "missing-space")
+
+		Expect(routeRepo.DeletedRouteGuids).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+	})
+
+	It("restores the route to its old space when it cannot be created in the new one", func() {
+		routeRepo.CreateInSpaceErrBySpaceGuid = map[string]error{"new-space-guid": errors.New("quota exceeded")}
+
+		runCommand("-f", "my-host", "example.com", "new-space")
+
+		Expect(routeRepo.CreateInSpaceSpaceGuids).To(Equal([]string{"new-space-guid", "old-space-guid"}))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"restored to space old-space"},
+			[]string{"quota exceeded"},
+		))
+	})
+
+	It("reports when the hostname was taken before it could be restored", func() {
+		routeRepo.CreateInSpaceErrBySpaceGuid = map[string]error{
+			"new-space-guid": errors.New("quota exceeded"),
+			"old-space-guid": errors.New("host is taken"),
+		}
+
+		runCommand("-f", "my-host", "example.com", "new-space")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"may have been reserved by someone else"},
+			[]string{"host is taken"},
+		))
+	})
+})
@@ -1,12 +1,17 @@
 package service
 
 import (
+	"strings"
+
 	"github.com/cloudfoundry/cli/cf"
 	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/applications"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/json"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
@@ -17,19 +22,30 @@ type BindService struct {
 	ui                 terminal.UI
 	config             core_config.Reader
 	serviceBindingRepo api.ServiceBindingRepository
+	appRepo            applications.ApplicationRepository
+	serviceRepo        api.ServiceRepository
+	appSummaryRepo     api.AppSummaryRepository
+	serviceSummaryRepo api.ServiceSummaryRepository
 	appReq             requirements.ApplicationRequirement
 	serviceInstanceReq requirements.ServiceInstanceRequirement
+	appNames           []string
+	instanceNames      []string
+	promptForArgs      bool
 }
 
 type ServiceBinder interface {
-	BindApplication(app models.Application, serviceInstance models.ServiceInstance) (apiErr error)
+	BindApplication(app models.Application, serviceInstance models.ServiceInstance, params map[string]interface{}) (apiErr error)
 }
 
-func NewBindService(ui terminal.UI, config core_config.Reader, serviceBindingRepo api.ServiceBindingRepository) (cmd *BindService) {
+func NewBindService(ui terminal.UI, config core_config.Reader, serviceBindingRepo api.ServiceBindingRepository, appRepo applications.ApplicationRepository, serviceRepo api.ServiceRepository, appSummaryRepo api.AppSummaryRepository, serviceSummaryRepo api.ServiceSummaryRepository) (cmd *BindService) {
 	cmd = new(BindService)
 	cmd.ui = ui
 	cmd.config = config
 	cmd.serviceBindingRepo = serviceBindingRepo
+	cmd.appRepo = appRepo
+	cmd.serviceRepo = serviceRepo
+	cmd.appSummaryRepo = appSummaryRepo
+	cmd.serviceSummaryRepo = serviceSummaryRepo
 	return
 }
 
@@ -38,29 +54,104 @@ func (cmd *BindService) Metadata() command_metadata.CommandMetadata {
 		Name:        "bind-service",
 		ShortName:   "bs",
 		Description: T("Bind a service instance to an app"),
-		Usage:       T("CF_NAME bind-service APP SERVICE_INSTANCE"),
+		Usage: T(`CF_NAME bind-service APP_NAME[,APP_NAME...] SERVICE_INSTANCE[,SERVICE_INSTANCE...] [-c PARAMETERS_AS_JSON]
+
+   Bind several apps or service instances in one call by separating their names with commas. Every service instance is bound to every app.
+
+   Optionally provide service-specific configuration parameters in a valid JSON object in-line:
+   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c '{"name":"value","name":"value"}'
+
+   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. The path to the parameters file can be an absolute or relative path to a file:
+   CF_NAME bind-service APP_NAME SERVICE_INSTANCE -c PATH_TO_FILE
+
+   When run in a terminal without APP_NAME or SERVICE_INSTANCE, the missing arguments are picked from the apps and service instances in the space.
+
+EXAMPLE:
+   CF_NAME bind-service myapp mydb
+   CF_NAME bind-service myapp mydb -c '{"permissions":"read-only"}'
+   CF_NAME bind-service myapp mydb -c ~/workspace/tmp/binding_config.json
+   CF_NAME bind-service myapp,myworker mydb,mycache`),
+		Flags: []cli.Flag{
+			flag_helpers.NewStringFlag("c", T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file")),
+		},
 	}
 }
 
 func (cmd *BindService) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) < 2 && cmd.ui.IsInteractive() {
+		if len(c.Args()) == 1 {
+			cmd.appNames = splitNames(c.Args()[0])
+		}
+		cmd.promptForArgs = true
+		reqs = []requirements.Requirement{
+			requirementsFactory.NewLoginRequirement(),
+			requirementsFactory.NewTargetedSpaceRequirement(),
+		}
+		return
+	}
 
 	if len(c.Args()) != 2 {
 		cmd.ui.FailWithUsage(c)
 	}
 
-	appName := c.Args()[0]
-	serviceName := c.Args()[1]
+	cmd.appNames = splitNames(c.Args()[0])
+	cmd.instanceNames = splitNames(c.Args()[1])
+	if len(cmd.appNames) == 0 || len(cmd.instanceNames) == 0 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	if cmd.isBulk() {
+		reqs = []requirements.Requirement{
+			requirementsFactory.NewLoginRequirement(),
+			requirementsFactory.NewTargetedSpaceRequirement(),
+		}
+		return
+	}
 
-	cmd.appReq = requirementsFactory.NewApplicationRequirement(appName)
-	cmd.serviceInstanceReq = requirementsFactory.NewServiceInstanceRequirement(serviceName)
+	cmd.appReq = requirementsFactory.NewApplicationRequirement(cmd.appNames[0])
+	cmd.serviceInstanceReq = requirementsFactory.NewServiceInstanceRequirement(cmd.instanceNames[0])
 
 	reqs = []requirements.Requirement{requirementsFactory.NewLoginRequirement(), cmd.appReq, cmd.serviceInstanceReq}
 	return
 }
 
+func (cmd *BindService) isBulk() bool {
+	return len(cmd.appNames) > 1 || len(cmd.instanceNames) > 1
+}
+
 func (cmd *BindService) Run(c *cli.Context) {
-	app := cmd.appReq.GetApplication()
-	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
+	params, err := json.ParseJsonFromFileOrString(c.String("c"))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if cmd.promptForArgs {
+		cmd.promptForMissingArgs()
+	}
+
+	if cmd.isBulk() {
+		cmd.bindAll(params)
+		return
+	}
+
+	var app models.Application
+	var serviceInstance models.ServiceInstance
+	if cmd.promptForArgs {
+		app, err = cmd.appRepo.Read(cmd.appNames[0])
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+		serviceInstance, err = cmd.serviceRepo.FindInstanceByName(cmd.instanceNames[0])
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+	} else {
+		app = cmd.appReq.GetApplication()
+		serviceInstance = cmd.serviceInstanceReq.GetServiceInstance()
+	}
 
 	cmd.ui.Say(T("Binding service {{.ServiceInstanceName}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 		map[string]interface{}{
@@ -71,7 +162,7 @@ func (cmd *BindService) Run(c *cli.Context) {
 			"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	err := cmd.BindApplication(app, serviceInstance)
+	err = cmd.BindApplication(app, serviceInstance, params)
 	if err != nil {
 		if err, ok := err.(errors.HttpError); ok && err.ErrorCode() == errors.APP_ALREADY_BOUND {
 			cmd.ui.Ok()
@@ -91,7 +182,138 @@ func (cmd *BindService) Run(c *cli.Context) {
 		map[string]interface{}{"CFCommand": terminal.CommandColor(cf.Name() + " restage")}))
 }
 
-func (cmd *BindService) BindApplication(app models.Application, serviceInstance models.ServiceInstance) (apiErr error) {
-	apiErr = cmd.serviceBindingRepo.Create(serviceInstance.Guid, app.Guid)
+// promptForMissingArgs lets the user pick the app and the service instance
+// from the ones in the targeted space.
+func (cmd *BindService) promptForMissingArgs() {
+	if len(cmd.appNames) == 0 {
+		apps, err := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+
+		appNames := []string{}
+		for _, app := range apps {
+			appNames = append(appNames, app.Name)
+		}
+
+		appName := terminal.PromptForChoice(cmd.ui, appNames, T("Select an app:"), T("App"))
+		if appName == "" {
+			cmd.ui.Failed(T("No app was selected"))
+			return
+		}
+		cmd.appNames = []string{appName}
+	}
+
+	instances, err := cmd.serviceSummaryRepo.GetSummariesInCurrentSpace()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	instanceNames := []string{}
+	for _, instance := range instances {
+		instanceNames = append(instanceNames, instance.Name)
+	}
+
+	instanceName := terminal.PromptForChoice(cmd.ui, instanceNames, T("Select a service instance:"), T("Service instance"))
+	if instanceName == "" {
+		cmd.ui.Failed(T("No service instance was selected"))
+		return
+	}
+	cmd.instanceNames = []string{instanceName}
+}
+
+// bindAll binds every instance to every app, carrying on past failures so
+// that the result of each binding can be reported at the end.
+func (cmd *BindService) bindAll(params map[string]interface{}) {
+	cmd.ui.Say(T("Binding services {{.ServiceInstanceNames}} to apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ServiceInstanceNames": terminal.EntityNameColor(strings.Join(cmd.instanceNames, ", ")),
+			"AppNames":             terminal.EntityNameColor(strings.Join(cmd.appNames, ", ")),
+			"OrgName":              terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+			"SpaceName":            terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+			"CurrentUser":          terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	instances := map[string]models.ServiceInstance{}
+	instanceErrs := map[string]error{}
+	for _, instanceName := range cmd.instanceNames {
+		instance, err := cmd.serviceRepo.FindInstanceByName(instanceName)
+		if err != nil {
+			instanceErrs[instanceName] = err
+			continue
+		}
+		instances[instanceName] = instance
+	}
+
+	table := terminal.NewTable(cmd.ui, []string{T("app"), T("service"), T("status")})
+	failures := 0
+	for _, appName := range cmd.appNames {
+		app, appErr := cmd.appRepo.Read(appName)
+
+		for _, instanceName := range cmd.instanceNames {
+			status, ok := cmd.bindingStatus(app, appErr, instances[instanceName], instanceErrs[instanceName], params)
+			if !ok {
+				failures++
+			}
+			table.Add(appName, instanceName, status)
+		}
+	}
+
+	cmd.ui.Say("")
+	table.Print()
+	cmd.ui.Say("")
+
+	if failures > 0 {
+		cmd.ui.Failed(T("{{.FailureCount}} of {{.BindingCount}} bindings failed",
+			map[string]interface{}{
+				"FailureCount": failures,
+				"BindingCount": len(cmd.appNames) * len(cmd.instanceNames),
+			}))
+		return
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("TIP: Use '{{.CFCommand}}' to ensure your env variable changes take effect",
+		map[string]interface{}{"CFCommand": terminal.CommandColor(cf.Name() + " restage")}))
+}
+
+func (cmd *BindService) bindingStatus(app models.Application, appErr error, instance models.ServiceInstance, instanceErr error, params map[string]interface{}) (status string, ok bool) {
+	if appErr != nil {
+		return bindingFailedStatus(appErr), false
+	}
+	if instanceErr != nil {
+		return bindingFailedStatus(instanceErr), false
+	}
+
+	err := cmd.BindApplication(app, instance, params)
+	if httpErr, isHttpErr := err.(errors.HttpError); isHttpErr && httpErr.ErrorCode() == errors.APP_ALREADY_BOUND {
+		return T("already bound"), true
+	}
+	if err != nil {
+		return bindingFailedStatus(err), false
+	}
+	return T("bound"), true
+}
+
+func bindingFailedStatus(err error) string {
+	return T("failed: {{.Err}}", map[string]interface{}{"Err": err.Error()})
+}
+
+// splitNames splits a comma separated list of app or service instance names.
+func splitNames(names string) []string {
+	result := []string{}
+	for _, name := range strings.Split(names, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func (cmd *BindService) BindApplication(app models.Application, serviceInstance models.ServiceInstance, params map[string]interface{}) (apiErr error) {
+	apiErr = cmd.serviceBindingRepo.Create(serviceInstance.Guid, app.Guid, params)
 	return
 }
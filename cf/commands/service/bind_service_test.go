@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"github.com/cloudfoundry/cli/cf/api"
+	testApplication "github.com/cloudfoundry/cli/cf/api/applications/fakes"
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands/service"
 	"github.com/cloudfoundry/cli/cf/models"
@@ -25,7 +26,7 @@ var _ = Describe("bind-service command", func() {
 	})
 
 	It("fails requirements when not logged in", func() {
-		cmd := NewBindService(&testterm.FakeUI{}, testconfig.NewRepository(), &testapi.FakeServiceBindingRepo{})
+		cmd := NewBindService(&testterm.FakeUI{}, testconfig.NewRepository(), &testapi.FakeServiceBindingRepo{}, &testApplication.FakeApplicationRepository{}, &testapi.FakeServiceRepo{}, &testapi.FakeAppSummaryRepo{}, &testapi.FakeServiceSummaryRepo{})
 
 		Expect(testcmd.RunCommand(cmd, []string{"service", "app"}, requirementsFactory)).To(BeFalse())
 	})
@@ -59,6 +60,29 @@ var _ = Describe("bind-service command", func() {
 			Expect(serviceBindingRepo.CreateApplicationGuid).To(Equal("my-app-guid"))
 		})
 
+		It("passes parameters provided with -c to the binding", func() {
+			requirementsFactory.Application = models.Application{}
+			requirementsFactory.ServiceInstance = models.ServiceInstance{}
+			serviceBindingRepo := &testapi.FakeServiceBindingRepo{}
+			ui := callBindService([]string{"-c", `{"permissions":"read-only"}`, "my-app", "my-service"}, requirementsFactory, serviceBindingRepo)
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+			Expect(serviceBindingRepo.CreateParams).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+		})
+
+		It("fails without binding when the parameters are invalid", func() {
+			requirementsFactory.Application = models.Application{}
+			requirementsFactory.ServiceInstance = models.ServiceInstance{ServiceInstanceFields: models.ServiceInstanceFields{Guid: "my-service-guid"}}
+			serviceBindingRepo := &testapi.FakeServiceBindingRepo{}
+			ui := callBindService([]string{"-c", `{"permissions"}`, "my-app", "my-service"}, requirementsFactory, serviceBindingRepo)
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Invalid configuration provided for -c flag"},
+			))
+			Expect(serviceBindingRepo.CreateServiceInstanceGuid).To(BeEmpty())
+		})
+
 		It("warns the user when the service instance is already bound to the given app", func() {
 			app := models.Application{}
 			app.Name = "my-app"
@@ -78,6 +102,86 @@ var _ = Describe("bind-service command", func() {
 			))
 		})
 
+		Context("when binding several apps or service instances", func() {
+			var (
+				ui                 *testterm.FakeUI
+				serviceBindingRepo *testapi.FakeServiceBindingRepo
+				appRepo            *testApplication.FakeApplicationRepository
+				serviceRepo        *testapi.FakeServiceRepo
+			)
+
+			BeforeEach(func() {
+				requirementsFactory.TargetedSpaceSuccess = true
+				ui = new(testterm.FakeUI)
+				serviceBindingRepo = &testapi.FakeServiceBindingRepo{}
+				appRepo = &testApplication.FakeApplicationRepository{}
+				appRepo.ReadByName = map[string]models.Application{
+					"app1": {ApplicationFields: models.ApplicationFields{Name: "app1", Guid: "app1-guid"}},
+					"app2": {ApplicationFields: models.ApplicationFields{Name: "app2", Guid: "app2-guid"}},
+				}
+				serviceRepo = &testapi.FakeServiceRepo{}
+				serviceRepo.FindInstanceByNameServiceInstance = models.ServiceInstance{
+					ServiceInstanceFields: models.ServiceInstanceFields{Name: "my-service", Guid: "my-service-guid"},
+				}
+			})
+
+			runBulkBind := func(args ...string) {
+				cmd := NewBindService(ui, testconfig.NewRepositoryWithDefaults(), serviceBindingRepo, appRepo, serviceRepo, &testapi.FakeAppSummaryRepo{}, &testapi.FakeServiceSummaryRepo{})
+				testcmd.RunCommand(cmd, args, requirementsFactory)
+			}
+
+			It("binds the service instance to each app and reports the result per app", func() {
+				runBulkBind("app1,app2", "my-service")
+
+				Expect(requirementsFactory.ApplicationName).To(BeEmpty())
+				Expect(serviceBindingRepo.CreateApplicationGuids).To(Equal([]string{"app1-guid", "app2-guid"}))
+				Expect(serviceBindingRepo.CreateServiceInstanceGuid).To(Equal("my-service-guid"))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Binding services", "my-service", "app1, app2", "my-org", "my-space", "my-user"},
+					[]string{"app", "service", "status"},
+					[]string{"app1", "my-service", "bound"},
+					[]string{"app2", "my-service", "bound"},
+					[]string{"OK"},
+				))
+			})
+
+			It("binds every service instance to every app", func() {
+				runBulkBind("app1", "my-service, my-cache")
+
+				Expect(serviceRepo.FindInstanceByNameCallCount).To(Equal(2))
+				Expect(serviceBindingRepo.CreateApplicationGuids).To(Equal([]string{"app1-guid", "app1-guid"}))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"app1", "my-service", "bound"},
+					[]string{"app1", "my-cache", "bound"},
+				))
+			})
+
+			It("carries on past apps that cannot be found and fails at the end", func() {
+				runBulkBind("app1,missing-app,app2", "my-service")
+
+				Expect(serviceBindingRepo.CreateApplicationGuids).To(Equal([]string{"app1-guid", "app2-guid"}))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"app1", "my-service", "bound"},
+					[]string{"missing-app", "my-service", "failed", "missing-app", "not found"},
+					[]string{"app2", "my-service", "bound"},
+					[]string{"FAILED"},
+					[]string{"1 of 3 bindings failed"},
+				))
+			})
+
+			It("reports apps that are already bound", func() {
+				serviceBindingRepo.CreateErrorCode = "90003"
+
+				runBulkBind("app1,app2", "my-service")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"app1", "my-service", "already bound"},
+					[]string{"app2", "my-service", "already bound"},
+					[]string{"OK"},
+				))
+			})
+		})
+
 		It("fails with usage when called without a service instance and app", func() {
 			serviceBindingRepo := &testapi.FakeServiceBindingRepo{}
 
@@ -90,6 +194,93 @@ var _ = Describe("bind-service command", func() {
 			ui = callBindService([]string{"my-app", "my-service"}, requirementsFactory, serviceBindingRepo)
 			Expect(ui.FailedWithUsage).To(BeFalse())
 		})
+
+		Context("when arguments are missing and the terminal is interactive", func() {
+			var (
+				ui                 *testterm.FakeUI
+				serviceBindingRepo *testapi.FakeServiceBindingRepo
+				appRepo            *testApplication.FakeApplicationRepository
+				serviceRepo        *testapi.FakeServiceRepo
+				appSummaryRepo     *testapi.FakeAppSummaryRepo
+				serviceSummaryRepo *testapi.FakeServiceSummaryRepo
+			)
+
+			BeforeEach(func() {
+				requirementsFactory.TargetedSpaceSuccess = true
+				ui = &testterm.FakeUI{Interactive: true}
+				serviceBindingRepo = &testapi.FakeServiceBindingRepo{}
+				appRepo = &testApplication.FakeApplicationRepository{}
+				appRepo.ReadByName = map[string]models.Application{
+					"app1": {ApplicationFields: models.ApplicationFields{Name: "app1", Guid: "app1-guid"}},
+					"app2": {ApplicationFields: models.ApplicationFields{Name: "app2", Guid: "app2-guid"}},
+				}
+				serviceRepo = &testapi.FakeServiceRepo{}
+				serviceRepo.FindInstanceByNameServiceInstance = models.ServiceInstance{
+					ServiceInstanceFields: models.ServiceInstanceFields{Name: "my-service", Guid: "my-service-guid"},
+				}
+				appSummaryRepo = &testapi.FakeAppSummaryRepo{
+					GetSummariesInCurrentSpaceApps: []models.Application{
+						{ApplicationFields: models.ApplicationFields{Name: "app1"}},
+						{ApplicationFields: models.ApplicationFields{Name: "app2"}},
+					},
+				}
+				serviceSummaryRepo = &testapi.FakeServiceSummaryRepo{
+					GetSummariesInCurrentSpaceInstances: []models.ServiceInstance{
+						{ServiceInstanceFields: models.ServiceInstanceFields{Name: "my-service"}},
+					},
+				}
+			})
+
+			runInteractiveBind := func(args ...string) bool {
+				cmd := NewBindService(ui, testconfig.NewRepositoryWithDefaults(), serviceBindingRepo, appRepo, serviceRepo, appSummaryRepo, serviceSummaryRepo)
+				return testcmd.RunCommand(cmd, args, requirementsFactory)
+			}
+
+			It("requires a targeted space", func() {
+				requirementsFactory.TargetedSpaceSuccess = false
+				Expect(runInteractiveBind()).To(BeFalse())
+			})
+
+			It("prompts for the app and the service instance", func() {
+				ui.Inputs = []string{"2", "1"}
+
+				runInteractiveBind()
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Select an app:"},
+					[]string{"1. app1"},
+					[]string{"2. app2"},
+					[]string{"Select a service instance:"},
+					[]string{"1. my-service"},
+					[]string{"Binding service", "my-service", "app2"},
+					[]string{"OK"},
+				))
+				Expect(serviceRepo.FindInstanceByNameName).To(Equal("my-service"))
+				Expect(serviceBindingRepo.CreateApplicationGuid).To(Equal("app2-guid"))
+				Expect(serviceBindingRepo.CreateServiceInstanceGuid).To(Equal("my-service-guid"))
+			})
+
+			It("only prompts for the service instance when the app is given", func() {
+				ui.Inputs = []string{"my-service"}
+
+				runInteractiveBind("app1")
+
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Select an app:"}))
+				Expect(serviceBindingRepo.CreateApplicationGuid).To(Equal("app1-guid"))
+			})
+
+			It("fails when no service instance is chosen", func() {
+				ui.Inputs = []string{""}
+
+				runInteractiveBind("app1")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"No service instance was selected"},
+				))
+				Expect(serviceBindingRepo.CreateApplicationGuid).To(BeEmpty())
+			})
+		})
 	})
 })
 
@@ -98,7 +289,7 @@ func callBindService(args []string, requirementsFactory *testreq.FakeReqFactory,
 
 	config := testconfig.NewRepositoryWithDefaults()
 
-	cmd := NewBindService(fakeUI, config, serviceBindingRepo)
+	cmd := NewBindService(fakeUI, config, serviceBindingRepo, &testApplication.FakeApplicationRepository{}, &testapi.FakeServiceRepo{}, &testapi.FakeAppSummaryRepo{}, &testapi.FakeServiceSummaryRepo{})
 	testcmd.RunCommand(cmd, args, requirementsFactory)
 	return
 }
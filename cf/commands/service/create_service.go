@@ -1,12 +1,18 @@
 package service
 
 import (
+	"sort"
+	"strings"
+	"time"
+
 	"github.com/cloudfoundry/cli/cf/actors/service_builder"
 	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/json"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
@@ -18,6 +24,13 @@ type CreateService struct {
 	config         core_config.Reader
 	serviceRepo    api.ServiceRepository
 	serviceBuilder service_builder.ServiceBuilder
+
+	PollInterval     time.Duration
+	OperationTimeout string
+}
+
+type ServiceCreator interface {
+	CreateService(serviceName string, planName string, serviceInstanceName string, params map[string]interface{}, tags []string) (models.ServicePlanFields, error)
 }
 
 func NewCreateService(ui terminal.UI, config core_config.Reader, serviceRepo api.ServiceRepository, serviceBuilder service_builder.ServiceBuilder) (cmd CreateService) {
@@ -25,6 +38,8 @@ func NewCreateService(ui terminal.UI, config core_config.Reader, serviceRepo api
 	cmd.config = config
 	cmd.serviceRepo = serviceRepo
 	cmd.serviceBuilder = serviceBuilder
+	cmd.PollInterval = DefaultServiceOperationPollInterval
+	cmd.OperationTimeout = serviceOperationTimeoutFromEnv()
 	return
 }
 
@@ -33,18 +48,34 @@ func (cmd CreateService) Metadata() command_metadata.CommandMetadata {
 		Name:        "create-service",
 		ShortName:   "cs",
 		Description: T("Create a service instance"),
-		Usage: T(`CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE
+		Usage: T(`CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait]
+
+   Optionally provide service-specific configuration parameters in a valid JSON object in-line:
+   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c '{"name":"value","name":"value"}'
+
+   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. The path to the parameters file can be an absolute or relative path to a file:
+   CF_NAME create-service SERVICE PLAN SERVICE_INSTANCE -c PATH_TO_FILE
 
 EXAMPLE:
    CF_NAME create-service cleardb spark clear-db-mine
+   CF_NAME create-service db-service silver mydb -c '{"ram_gb":4}'
+   CF_NAME create-service db-service silver mydb -c ~/workspace/tmp/instance_config.json
+   CF_NAME create-service db-service silver mydb -t "list, of, tags"
+
+   When run in a terminal without SERVICE, PLAN or SERVICE_INSTANCE, the missing arguments are prompted for.
 
 TIP:
    Use 'CF_NAME create-user-provided-service' to make user-provided services available to cf apps`),
+		Flags: []cli.Flag{
+			flag_helpers.NewStringFlag("c", T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file")),
+			flag_helpers.NewStringFlag("t", T("User provided tags")),
+			cli.BoolFlag{Name: "wait", Usage: T("Wait for the service broker to finish the operation")},
+		},
 	}
 }
 
 func (cmd CreateService) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
-	if len(c.Args()) != 3 {
+	if len(c.Args()) != 3 && !(len(c.Args()) < 3 && cmd.ui.IsInteractive()) {
 		cmd.ui.FailWithUsage(c)
 	}
 
@@ -57,9 +88,13 @@ func (cmd CreateService) GetRequirements(requirementsFactory requirements.Factor
 }
 
 func (cmd CreateService) Run(c *cli.Context) {
-	serviceName := c.Args()[0]
-	planName := c.Args()[1]
-	serviceInstanceName := c.Args()[2]
+	serviceName, planName, serviceInstanceName := cmd.promptForMissingArgs(c.Args())
+
+	params, err := json.ParseJsonFromFileOrString(c.String("c"))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
 
 	cmd.ui.Say(T("Creating service {{.ServiceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
 		map[string]interface{}{
@@ -69,7 +104,12 @@ func (cmd CreateService) Run(c *cli.Context) {
 			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	plan, err := cmd.CreateService(serviceName, planName, serviceInstanceName)
+	var tags []string
+	if c.String("t") != "" {
+		tags = parseTags(c.String("t"))
+	}
+
+	plan, err := cmd.CreateService(serviceName, planName, serviceInstanceName, params, tags)
 
 	switch err.(type) {
 	case nil:
@@ -84,6 +124,7 @@ func (cmd CreateService) Run(c *cli.Context) {
 				}))
 			cmd.ui.Say("")
 		}
+		followServiceOperation(cmd.ui, cmd.serviceRepo, serviceInstanceName, c.Bool("wait"), cmd.PollInterval, cmd.OperationTimeout)
 	case *errors.ModelAlreadyExistsError:
 		cmd.ui.Ok()
 		cmd.ui.Warn(err.Error())
@@ -92,7 +133,7 @@ func (cmd CreateService) Run(c *cli.Context) {
 	}
 }
 
-func (cmd CreateService) CreateService(serviceName string, planName string, serviceInstanceName string) (models.ServicePlanFields, error) {
+func (cmd CreateService) CreateService(serviceName string, planName string, serviceInstanceName string, params map[string]interface{}, tags []string) (models.ServicePlanFields, error) {
 	offerings, apiErr := cmd.serviceBuilder.GetServicesByNameForSpaceWithPlans(cmd.config.SpaceFields().Guid, serviceName)
 	if apiErr != nil {
 		return models.ServicePlanFields{}, apiErr
@@ -103,10 +144,80 @@ func (cmd CreateService) CreateService(serviceName string, planName string, serv
 		return plan, apiErr
 	}
 
-	apiErr = cmd.serviceRepo.CreateServiceInstance(serviceInstanceName, plan.Guid)
+	apiErr = cmd.serviceRepo.CreateServiceInstance(serviceInstanceName, plan.Guid, params, tags)
 	return plan, apiErr
 }
 
+// promptForMissingArgs lets the user pick the service and plan from the
+// offerings available in the space, and asks for the instance name.
+func (cmd CreateService) promptForMissingArgs(args []string) (serviceName, planName, serviceInstanceName string) {
+	if len(args) == 3 {
+		return args[0], args[1], args[2]
+	}
+
+	offerings, err := cmd.serviceBuilder.GetServicesForSpaceWithPlans(cmd.config.SpaceFields().Guid)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if len(args) > 0 {
+		serviceName = args[0]
+	} else {
+		labels := []string{}
+		for _, offering := range offerings {
+			labels = append(labels, offering.Label)
+		}
+		sort.Strings(labels)
+		serviceName = terminal.PromptForChoice(cmd.ui, labels, T("Select a service:"), T("Service"))
+	}
+	if serviceName == "" {
+		cmd.ui.Failed(T("No service was selected"))
+		return
+	}
+
+	if len(args) > 1 {
+		planName = args[1]
+	} else {
+		planNames := []string{}
+		for _, offering := range offerings {
+			if offering.Label != serviceName {
+				continue
+			}
+			for _, plan := range offering.Plans {
+				planNames = append(planNames, plan.Name)
+			}
+		}
+		if len(planNames) == 0 {
+			cmd.ui.Failed(T("Service offering {{.ServiceName}} not found", map[string]interface{}{"ServiceName": serviceName}))
+			return
+		}
+		planName = terminal.PromptForChoice(cmd.ui, planNames, T("Select a plan:"), T("Plan"))
+	}
+	if planName == "" {
+		cmd.ui.Failed(T("No plan was selected"))
+		return
+	}
+
+	serviceInstanceName = cmd.ui.Ask(T("Service instance name"))
+	if serviceInstanceName == "" {
+		cmd.ui.Failed(T("A service instance name is required"))
+	}
+	return
+}
+
+// parseTags splits a comma separated list of tags such as "db, mysql".
+func parseTags(tags string) []string {
+	result := []string{}
+	for _, tag := range strings.Split(tags, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "" {
+			result = append(result, tag)
+		}
+	}
+	return result
+}
+
 func findPlanFromOfferings(offerings models.ServiceOfferings, name string) (plan models.ServicePlanFields, err error) {
 	for _, offering := range offerings {
 		for _, plan := range offering.Plans {
@@ -1,6 +1,9 @@
 package service_test
 
 import (
+	"io/ioutil"
+	"os"
+
 	"github.com/cloudfoundry/cli/cf/actors/service_builder/fakes"
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands/service"
@@ -37,6 +40,7 @@ var _ = Describe("create-service command", func() {
 		serviceRepo = &testapi.FakeServiceRepo{}
 		serviceBuilder = &fakes.FakeServiceBuilder{}
 		cmd = NewCreateService(ui, config, serviceRepo, serviceBuilder)
+		cmd.PollInterval = 0
 
 		offering1 = models.ServiceOffering{}
 		offering1.Label = "cleardb"
@@ -76,6 +80,67 @@ var _ = Describe("create-service command", func() {
 		})
 	})
 
+	Context("when arguments are missing", func() {
+		It("fails with usage when the terminal is not interactive", func() {
+			callCreateService([]string{"cleardb"})
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		Context("and the terminal is interactive", func() {
+			BeforeEach(func() {
+				ui.Interactive = true
+				serviceBuilder.GetServicesForSpaceWithPlansReturns(models.ServiceOfferings{offering2, offering1}, nil)
+			})
+
+			It("prompts for the service, the plan and the instance name", func() {
+				ui.Inputs = []string{"1", "expensive", "my-cleardb-service"}
+
+				callCreateService([]string{})
+
+				Expect(ui.FailedWithUsage).To(BeFalse())
+				Expect(serviceBuilder.GetServicesForSpaceWithPlansArgsForCall(0)).To(Equal(config.SpaceFields().Guid))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Select a service:"},
+					[]string{"1. cleardb"},
+					[]string{"2. postgres"},
+					[]string{"Select a plan:"},
+					[]string{"1. spark"},
+					[]string{"2. expensive"},
+					[]string{"Creating service", "my-cleardb-service"},
+					[]string{"OK"},
+				))
+				Expect(ui.Prompts).To(ContainSubstrings(
+					[]string{"Service"},
+					[]string{"Plan"},
+					[]string{"Service instance name"},
+				))
+				Expect(serviceRepo.CreateServiceInstanceArgs.Name).To(Equal("my-cleardb-service"))
+				Expect(serviceRepo.CreateServiceInstanceArgs.PlanGuid).To(Equal("luxury-guid"))
+			})
+
+			It("only prompts for the arguments that are missing", func() {
+				ui.Inputs = []string{"2", "my-cleardb-service"}
+
+				callCreateService([]string{"cleardb"})
+
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Select a service:"}))
+				Expect(serviceRepo.CreateServiceInstanceArgs.PlanGuid).To(Equal("luxury-guid"))
+			})
+
+			It("fails when no service is chosen", func() {
+				ui.Inputs = []string{""}
+
+				callCreateService([]string{})
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"No service was selected"},
+				))
+				Expect(serviceRepo.CreateServiceInstanceArgs.Name).To(BeEmpty())
+			})
+		})
+	})
+
 	It("successfully creates a service", func() {
 		callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})
 
@@ -90,6 +155,108 @@ var _ = Describe("create-service command", func() {
 		Expect(serviceRepo.CreateServiceInstanceArgs.PlanGuid).To(Equal("cleardb-spark-guid"))
 	})
 
+	Context("when service parameters are provided with -c", func() {
+		It("passes inline JSON parameters to the service instance", func() {
+			callCreateService([]string{"-c", `{"ram_gb":4}`, "cleardb", "spark", "my-cleardb-service"})
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+			Expect(serviceRepo.CreateServiceInstanceArgs.Params).To(Equal(map[string]interface{}{"ram_gb": float64(4)}))
+		})
+
+		It("reads the parameters from a file", func() {
+			tempFile, err := ioutil.TempFile("", "create-service-params")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.Remove(tempFile.Name())
+			tempFile.WriteString(`{"db_version":"9.4"}`)
+			tempFile.Close()
+
+			callCreateService([]string{"-c", tempFile.Name(), "cleardb", "spark", "my-cleardb-service"})
+
+			Expect(serviceRepo.CreateServiceInstanceArgs.Params).To(Equal(map[string]interface{}{"db_version": "9.4"}))
+		})
+
+		It("fails without creating the service when the parameters are invalid", func() {
+			callCreateService([]string{"-c", `{"ram_gb":`, "cleardb", "spark", "my-cleardb-service"})
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Invalid configuration provided for -c flag"},
+			))
+			Expect(serviceRepo.CreateServiceInstanceArgs.Name).To(BeEmpty())
+		})
+	})
+
+	It("passes tags given with -t to the service instance", func() {
+		callCreateService([]string{"-t", "db, mysql", "cleardb", "spark", "my-cleardb-service"})
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+		Expect(serviceRepo.CreateServiceInstanceArgs.Tags).To(Equal([]string{"db", "mysql"}))
+	})
+
+	Context("when the broker provisions the instance asynchronously", func() {
+		var inProgress models.ServiceInstance
+
+		BeforeEach(func() {
+			inProgress = models.ServiceInstance{}
+			inProgress.Name = "my-cleardb-service"
+			inProgress.LastOperation = models.LastOperationFields{Type: "create", State: "in progress"}
+		})
+
+		It("tells the user the create is in progress", func() {
+			serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{inProgress}
+
+			callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})
+
+			Expect(serviceRepo.FindInstanceByNameName).To(Equal("my-cleardb-service"))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"OK"},
+				[]string{"Create in progress", "services", "service my-cleardb-service"},
+			))
+		})
+
+		It("waits for the instance to be created when --wait is given", func() {
+			succeeded := inProgress
+			succeeded.LastOperation.State = "succeeded"
+			serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{inProgress, succeeded}
+
+			callCreateService([]string{"--wait", "cleardb", "spark", "my-cleardb-service"})
+
+			Expect(serviceRepo.FindInstanceByNameCallCount).To(Equal(2))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"OK"},
+				[]string{"Waiting for the operation on service instance", "my-cleardb-service"},
+				[]string{"OK"},
+			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"Create in progress"}))
+		})
+	})
+
+	Context("when CF_SERVICE_OPERATION_TIMEOUT is invalid", func() {
+		BeforeEach(func() {
+			os.Setenv("CF_SERVICE_OPERATION_TIMEOUT", "forever")
+			cmd = NewCreateService(ui, config, serviceRepo, serviceBuilder)
+			cmd.PollInterval = 0
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("CF_SERVICE_OPERATION_TIMEOUT")
+		})
+
+		It("only fails when asked to wait", func() {
+			callCreateService([]string{"cleardb", "spark", "my-cleardb-service"})
+			Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"FAILED"}))
+
+			ui = &testterm.FakeUI{}
+			cmd = NewCreateService(ui, config, serviceRepo, serviceBuilder)
+			serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{{}}
+			callCreateService([]string{"--wait", "cleardb", "spark", "my-cleardb-service"})
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"invalid value for env var CF_SERVICE_OPERATION_TIMEOUT"},
+			))
+		})
+	})
+
 	Describe("warning the user about paid services", func() {
 		It("does not warn the user when the service is free", func() {
 			callCreateService([]string{"cleardb", "spark", "my-free-cleardb-service"})
@@ -32,7 +32,7 @@ func (cmd CreateUserProvidedService) Metadata() command_metadata.CommandMetadata
 		Name:        "create-user-provided-service",
 		ShortName:   "cups",
 		Description: T("Make a user-provided service instance available to cf apps"),
-		Usage: T(`CF_NAME create-user-provided-service SERVICE_INSTANCE [-p CREDENTIALS] [-l SYSLOG-DRAIN-URL]
+		Usage: T(`CF_NAME create-user-provided-service SERVICE_INSTANCE [-p CREDENTIALS] [-l SYSLOG-DRAIN-URL] [-t TAGS]
 
    Pass comma separated credential parameter names to enable interactive mode:
    CF_NAME create-user-provided-service SERVICE_INSTANCE -p "comma, separated, parameter, names"
@@ -44,10 +44,12 @@ EXAMPLE:
    CF_NAME create-user-provided-service oracle-db-mine -p "username, password"
    CF_NAME create-user-provided-service oracle-db-mine -p '{"username":"admin","password":"pa55woRD"}'
    CF_NAME create-user-provided-service my-drain-service -l syslog://example.com
+   CF_NAME create-user-provided-service oracle-db-mine -p '{"username":"admin","password":"pa55woRD"}' -t "db, oracle"
 `),
 		Flags: []cli.Flag{
 			flag_helpers.NewStringFlag("p", T("Credentials")),
 			flag_helpers.NewStringFlag("l", T("Syslog Drain Url")),
+			flag_helpers.NewStringFlag("t", T("User provided tags")),
 		},
 	}
 }
@@ -82,7 +84,12 @@ func (cmd CreateUserProvidedService) Run(c *cli.Context) {
 			"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	apiErr := cmd.userProvidedServiceInstanceRepo.Create(name, drainUrl, paramsMap)
+	var tags []string
+	if c.String("t") != "" {
+		tags = parseTags(c.String("t"))
+	}
+
+	apiErr := cmd.userProvidedServiceInstanceRepo.Create(name, drainUrl, paramsMap, tags)
 	if apiErr != nil {
 		cmd.ui.Failed(apiErr.Error())
 		return
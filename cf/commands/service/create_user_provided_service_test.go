@@ -97,4 +97,17 @@ var _ = Describe("create-user-provided-service command", func() {
 			[]string{"OK"},
 		))
 	})
+
+	It("creates a user provided service with tags", func() {
+		testcmd.RunCommand(cmd, []string{"-t", "db, oracle ,", "my-custom-service"}, requirementsFactory)
+
+		Expect(repo.CreateTags).To(Equal([]string{"db", "oracle"}))
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+	})
+
+	It("does not send tags when none are given", func() {
+		testcmd.RunCommand(cmd, []string{"my-custom-service"}, requirementsFactory)
+
+		Expect(repo.CreateTags).To(BeNil())
+	})
 })
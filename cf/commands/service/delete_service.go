@@ -1,6 +1,8 @@
 package service
 
 import (
+	"time"
+
 	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
@@ -8,6 +10,7 @@ import (
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/ui_helpers"
 	"github.com/codegangsta/cli"
 )
 
@@ -16,6 +19,9 @@ type DeleteService struct {
 	config             core_config.Reader
 	serviceRepo        api.ServiceRepository
 	serviceInstanceReq requirements.ServiceInstanceRequirement
+
+	PollInterval     time.Duration
+	OperationTimeout string
 }
 
 func NewDeleteService(ui terminal.UI, config core_config.Reader, serviceRepo api.ServiceRepository) (cmd *DeleteService) {
@@ -23,6 +29,8 @@ func NewDeleteService(ui terminal.UI, config core_config.Reader, serviceRepo api
 	cmd.ui = ui
 	cmd.config = config
 	cmd.serviceRepo = serviceRepo
+	cmd.PollInterval = DefaultServiceOperationPollInterval
+	cmd.OperationTimeout = serviceOperationTimeoutFromEnv()
 	return
 }
 
@@ -31,9 +39,10 @@ func (cmd *DeleteService) Metadata() command_metadata.CommandMetadata {
 		Name:        "delete-service",
 		ShortName:   "ds",
 		Description: T("Delete a service instance"),
-		Usage:       T("CF_NAME delete-service SERVICE_INSTANCE [-f]"),
+		Usage:       T("CF_NAME delete-service SERVICE_INSTANCE [-f] [--wait]"),
 		Flags: []cli.Flag{
 			cli.BoolFlag{Name: "f", Usage: T("Force deletion without confirmation")},
+			cli.BoolFlag{Name: "wait", Usage: T("Wait for the service broker to finish the operation")},
 		},
 	}
 }
@@ -49,7 +58,11 @@ func (cmd *DeleteService) GetRequirements(requirementsFactory requirements.Facto
 func (cmd *DeleteService) Run(c *cli.Context) {
 	serviceName := c.Args()[0]
 
-	if !c.Bool("f") {
+	if core_config.IsProtectedSpace(cmd.config.ProtectedTargets(), cmd.config.OrganizationFields().Name, cmd.config.SpaceFields().Name) {
+		if !ui_helpers.ConfirmProtectedDelete(cmd.ui, T("service"), serviceName, c.Bool("f")) {
+			return
+		}
+	} else if !c.Bool("f") {
 		if !cmd.ui.ConfirmDelete(T("service"), serviceName) {
 			return
 		}
@@ -83,4 +96,5 @@ func (cmd *DeleteService) Run(c *cli.Context) {
 	}
 
 	cmd.ui.Ok()
+	followServiceOperation(cmd.ui, cmd.serviceRepo, serviceName, c.Bool("wait"), cmd.PollInterval, cmd.OperationTimeout)
 }
@@ -1,8 +1,11 @@
 package service_test
 
 import (
+	"os"
+
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands/service"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/models"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
@@ -20,6 +23,7 @@ var _ = Describe("delete-service command", func() {
 		requirementsFactory *testreq.FakeReqFactory
 		serviceRepo         *testapi.FakeServiceRepo
 		serviceInstance     models.ServiceInstance
+		configRepo          core_config.ReadWriter
 	)
 
 	BeforeEach(func() {
@@ -28,14 +32,15 @@ var _ = Describe("delete-service command", func() {
 		}
 
 		serviceRepo = &testapi.FakeServiceRepo{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
 		requirementsFactory = &testreq.FakeReqFactory{
 			LoginSuccess: true,
 		}
 	})
 
 	runCommand := func(args ...string) bool {
-		configRepo := testconfig.NewRepositoryWithDefaults()
 		cmd := NewDeleteService(ui, configRepo, serviceRepo)
+		cmd.PollInterval = 0
 		return testcmd.RunCommand(cmd, args, requirementsFactory)
 	}
 
@@ -91,6 +96,72 @@ var _ = Describe("delete-service command", func() {
 					[]string{"OK"},
 				))
 			})
+
+			Context("when the targeted space is protected", func() {
+				BeforeEach(func() {
+					configRepo.SetProtectedTargets([]string{"my-org"})
+				})
+
+				AfterEach(func() {
+					os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "")
+				})
+
+				It("requires the service name to be typed back", func() {
+					runCommand("my-service")
+					Expect(serviceRepo.DeleteServiceServiceInstance).To(Equal(models.ServiceInstance{}))
+
+					ui.Inputs = []string{"my-service"}
+					runCommand("my-service")
+					Expect(ui.Prompts).To(ContainSubstrings([]string{"protected target", "service", "my-service"}))
+					Expect(serviceRepo.DeleteServiceServiceInstance).To(Equal(serviceInstance))
+				})
+
+				It("refuses the -f flag unless the override is set", func() {
+					runCommand("-f", "my-service")
+					Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+					Expect(serviceRepo.DeleteServiceServiceInstance).To(Equal(models.ServiceInstance{}))
+
+					os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "true")
+					runCommand("-f", "my-service")
+					Expect(serviceRepo.DeleteServiceServiceInstance).To(Equal(serviceInstance))
+				})
+			})
+		})
+
+		Context("when the broker deletes the service asynchronously", func() {
+			BeforeEach(func() {
+				serviceInstance = models.ServiceInstance{}
+				serviceInstance.Name = "my-service"
+				serviceInstance.Guid = "my-service-guid"
+
+				inProgress := serviceInstance
+				inProgress.LastOperation = models.LastOperationFields{Type: "delete", State: "in progress"}
+
+				serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{serviceInstance, inProgress, inProgress}
+				serviceRepo.FindInstanceByNameNotFound = true
+			})
+
+			It("tells the user the delete is in progress", func() {
+				runCommand("-f", "my-service")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"Delete in progress", "services", "service my-service"},
+				))
+			})
+
+			It("waits for the service to be deleted when --wait is given", func() {
+				runCommand("-f", "--wait", "my-service")
+
+				Expect(serviceRepo.FindInstanceByNameCallCount).To(Equal(4))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Deleting service", "my-service"},
+					[]string{"OK"},
+					[]string{"Waiting for the operation on service instance", "my-service"},
+					[]string{"OK"},
+				))
+				Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"FAILED"}))
+			})
 		})
 
 		Context("when the service does not exist", func() {
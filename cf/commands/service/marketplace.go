@@ -1,6 +1,8 @@
 package service
 
 import (
+	"encoding/json"
+	"fmt"
 	"sort"
 	"strings"
 
@@ -8,6 +10,7 @@ import (
 	. "github.com/cloudfoundry/cli/cf/i18n"
 
 	"github.com/cloudfoundry/cli/cf/actors/service_builder"
+	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/models"
@@ -20,13 +23,37 @@ type MarketplaceServices struct {
 	ui             terminal.UI
 	config         core_config.Reader
 	serviceBuilder service_builder.ServiceBuilder
+	brokerRepo     api.ServiceBrokerRepository
 }
 
-func NewMarketplaceServices(ui terminal.UI, config core_config.Reader, serviceBuilder service_builder.ServiceBuilder) MarketplaceServices {
+type marketplaceOffering struct {
+	Label       string            `json:"label"`
+	Description string            `json:"description"`
+	Tags        []string          `json:"tags"`
+	SpaceScoped bool              `json:"space_scoped"`
+	Plans       []marketplacePlan `json:"plans"`
+}
+
+type marketplacePlan struct {
+	Name        string            `json:"name"`
+	DisplayName string            `json:"display_name,omitempty"`
+	Description string            `json:"description"`
+	Free        bool              `json:"free"`
+	Costs       []marketplaceCost `json:"costs,omitempty"`
+	Bullets     []string          `json:"bullets,omitempty"`
+}
+
+type marketplaceCost struct {
+	Amount map[string]float64 `json:"amount"`
+	Unit   string             `json:"unit"`
+}
+
+func NewMarketplaceServices(ui terminal.UI, config core_config.Reader, serviceBuilder service_builder.ServiceBuilder, brokerRepo api.ServiceBrokerRepository) MarketplaceServices {
 	return MarketplaceServices{
 		ui:             ui,
 		config:         config,
 		serviceBuilder: serviceBuilder,
+		brokerRepo:     brokerRepo,
 	}
 }
 
@@ -35,9 +62,16 @@ func (cmd MarketplaceServices) Metadata() command_metadata.CommandMetadata {
 		Name:        "marketplace",
 		ShortName:   "m",
 		Description: T("List available offerings in the marketplace"),
-		Usage:       "CF_NAME marketplace",
+		Usage: T(`CF_NAME marketplace [-s SERVICE] [--search TERM] [--broker BROKER] [--json]
+
+EXAMPLE:
+   CF_NAME marketplace --search mysql
+   CF_NAME marketplace -s p-mysql --json`),
 		Flags: []cli.Flag{
 			flag_helpers.NewStringFlag("s", T("Show plan details for a particular service offering")),
+			flag_helpers.NewStringFlag("search", T("Only list service offerings whose label, description or tags contain the term")),
+			flag_helpers.NewStringFlag("broker", T("Only list service offerings from a particular broker. Unless you are an admin, the broker must be registered in the targeted space")),
+			cli.BoolFlag{Name: "json", Usage: T("Print the offerings and their plans as JSON. All other output is suppressed.")},
 		},
 	}
 }
@@ -52,29 +86,34 @@ func (cmd MarketplaceServices) GetRequirements(requirementsFactory requirements.
 
 func (cmd MarketplaceServices) Run(c *cli.Context) {
 	serviceName := c.String("s")
+	jsonOutput := c.Bool("json")
 
 	if serviceName != "" {
-		cmd.marketplaceByService(serviceName)
+		cmd.marketplaceByService(serviceName, jsonOutput)
 	} else {
-		cmd.marketplace()
+		cmd.marketplace(c.String("search"), c.String("broker"), jsonOutput)
 	}
 }
 
-func (cmd MarketplaceServices) marketplaceByService(serviceName string) {
+func (cmd MarketplaceServices) marketplaceByService(serviceName string, jsonOutput bool) {
 	var (
 		serviceOffering models.ServiceOffering
 		apiErr          error
 	)
 
 	if cmd.config.HasSpace() {
-		cmd.ui.Say(T("Getting service plan information for service {{.ServiceName}} as {{.CurrentUser}}...",
-			map[string]interface{}{
-				"ServiceName": terminal.EntityNameColor(serviceName),
-				"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
-			}))
+		if !jsonOutput {
+			cmd.ui.Say(T("Getting service plan information for service {{.ServiceName}} as {{.CurrentUser}}...",
+				map[string]interface{}{
+					"ServiceName": terminal.EntityNameColor(serviceName),
+					"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+				}))
+		}
 		serviceOffering, apiErr = cmd.serviceBuilder.GetServiceByNameForSpaceWithPlans(serviceName, cmd.config.SpaceFields().Guid)
 	} else if !cmd.config.IsLoggedIn() {
-		cmd.ui.Say(T("Getting service plan information for service {{.ServiceName}}...", map[string]interface{}{"ServiceName": terminal.EntityNameColor(serviceName)}))
+		if !jsonOutput {
+			cmd.ui.Say(T("Getting service plan information for service {{.ServiceName}}...", map[string]interface{}{"ServiceName": terminal.EntityNameColor(serviceName)}))
+		}
 		serviceOffering, apiErr = cmd.serviceBuilder.GetServiceByNameWithPlans(serviceName)
 	} else {
 		cmd.ui.Failed(T("Cannot list plan information for {{.ServiceName}} without a targeted space",
@@ -86,6 +125,16 @@ func (cmd MarketplaceServices) marketplaceByService(serviceName string) {
 		return
 	}
 
+	if jsonOutput {
+		if serviceOffering.Guid == "" {
+			cmd.ui.Failed(T("Service offering not found"))
+			return
+		}
+		spaceBrokerGuids := cmd.spaceScopedBrokerGuids(cmd.visibleBrokers())
+		cmd.printJson(newMarketplaceOffering(serviceOffering, spaceBrokerGuids[serviceOffering.BrokerGuid]))
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -94,7 +143,7 @@ func (cmd MarketplaceServices) marketplaceByService(serviceName string) {
 		return
 	}
 
-	table := terminal.NewTable(cmd.ui, []string{T("service plan"), T("description"), T("free or paid")})
+	table := terminal.NewTable(cmd.ui, []string{T("service plan"), T("description"), T("free or paid"), T("costs")})
 	for _, plan := range serviceOffering.Plans {
 		var freeOrPaid string
 		if plan.Free {
@@ -102,28 +151,48 @@ func (cmd MarketplaceServices) marketplaceByService(serviceName string) {
 		} else {
 			freeOrPaid = "paid"
 		}
-		table.Add(plan.Name, plan.Description, freeOrPaid)
+		table.Add(plan.Name, plan.Description, freeOrPaid, formatPlanCosts(plan.Extra.Costs))
 	}
 
 	table.Print()
+
+	for _, plan := range serviceOffering.Plans {
+		if plan.Extra.DisplayName == "" && len(plan.Extra.Bullets) == 0 {
+			continue
+		}
+
+		cmd.ui.Say("")
+		if plan.Extra.DisplayName != "" {
+			cmd.ui.Say(fmt.Sprintf("%s (%s):", terminal.EntityNameColor(plan.Name), plan.Extra.DisplayName))
+		} else {
+			cmd.ui.Say(fmt.Sprintf("%s:", terminal.EntityNameColor(plan.Name)))
+		}
+		for _, bullet := range plan.Extra.Bullets {
+			cmd.ui.Say("   - " + bullet)
+		}
+	}
 }
 
-func (cmd MarketplaceServices) marketplace() {
+func (cmd MarketplaceServices) marketplace(search, brokerName string, jsonOutput bool) {
 	var (
 		serviceOfferings models.ServiceOfferings
 		apiErr           error
 	)
 
 	if cmd.config.HasSpace() {
-		cmd.ui.Say(T("Getting services from marketplace in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
-			map[string]interface{}{
-				"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
-				"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
-				"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
-			}))
+		if !jsonOutput {
+			cmd.ui.Say(T("Getting services from marketplace in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+				map[string]interface{}{
+					"OrgName":     terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+					"SpaceName":   terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+					"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
+				}))
+		}
 		serviceOfferings, apiErr = cmd.serviceBuilder.GetServicesForSpaceWithPlans(cmd.config.SpaceFields().Guid)
 	} else if !cmd.config.IsLoggedIn() {
-		cmd.ui.Say(T("Getting all services from marketplace..."))
+		if !jsonOutput {
+			cmd.ui.Say(T("Getting all services from marketplace..."))
+		}
 		serviceOfferings, apiErr = cmd.serviceBuilder.GetAllServicesWithPlans()
 	} else {
 		cmd.ui.Failed(T("Cannot list marketplace services without a targeted space"))
@@ -134,6 +203,34 @@ func (cmd MarketplaceServices) marketplace() {
 		return
 	}
 
+	brokers := cmd.visibleBrokers()
+
+	if brokerName != "" {
+		broker, found := findBrokerByName(brokers, brokerName)
+		if !found {
+			cmd.ui.Failed(T("Service broker {{.BrokerName}} not found. Only admins can filter by brokers that are not registered in the targeted space.",
+				map[string]interface{}{"BrokerName": brokerName}))
+			return
+		}
+		serviceOfferings = filterOfferingsByBroker(serviceOfferings, broker.Guid)
+	}
+
+	if search != "" {
+		serviceOfferings = filterOfferingsBySearch(serviceOfferings, search)
+	}
+
+	spaceBrokerGuids := cmd.spaceScopedBrokerGuids(brokers)
+	sort.Sort(serviceOfferings)
+
+	if jsonOutput {
+		offerings := []marketplaceOffering{}
+		for _, offering := range serviceOfferings {
+			offerings = append(offerings, newMarketplaceOffering(offering, spaceBrokerGuids[offering.BrokerGuid]))
+		}
+		cmd.printJson(offerings)
+		return
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -144,8 +241,7 @@ func (cmd MarketplaceServices) marketplace() {
 
 	table := terminal.NewTable(cmd.ui, []string{T("service"), T("plans"), T("description")})
 
-	sort.Sort(serviceOfferings)
-	var paidPlanExists bool
+	var paidPlanExists, spaceOfferingExists bool
 	for _, offering := range serviceOfferings {
 		planNames := ""
 
@@ -163,12 +259,144 @@ func (cmd MarketplaceServices) marketplace() {
 
 		planNames = strings.TrimPrefix(planNames, ", ")
 
-		table.Add(offering.Label, planNames, offering.Description)
+		label := offering.Label
+		if spaceBrokerGuids[offering.BrokerGuid] {
+			spaceOfferingExists = true
+			label += " [space]"
+		}
+
+		table.Add(label, planNames, offering.Description)
 	}
 
 	table.Print()
 	if paidPlanExists {
 		cmd.ui.Say(T("\n* The denoted service plans have specific costs associated with them. If a service instance of this type is created, a cost will be incurred."))
 	}
+	if spaceOfferingExists {
+		cmd.ui.Say(T("\n[space] The denoted services are offered by a broker registered only for this space."))
+	}
 	cmd.ui.Say(T("\nTIP:  Use 'cf marketplace -s SERVICE' to view descriptions of individual plans of a given service."))
 }
+
+func (cmd MarketplaceServices) printJson(value interface{}) {
+	output, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+	cmd.ui.Say(string(output))
+}
+
+// visibleBrokers lists the brokers the user may see: all of them for admins,
+// otherwise only those registered for spaces the user is a developer in.
+// Listing errors leave the list empty, as brokers only refine the output.
+func (cmd MarketplaceServices) visibleBrokers() []models.ServiceBroker {
+	brokers := []models.ServiceBroker{}
+	if !cmd.config.IsLoggedIn() {
+		return brokers
+	}
+
+	err := cmd.brokerRepo.ListServiceBrokers(func(broker models.ServiceBroker) bool {
+		brokers = append(brokers, broker)
+		return true
+	})
+	if err != nil {
+		return []models.ServiceBroker{}
+	}
+	return brokers
+}
+
+// spaceScopedBrokerGuids returns the brokers registered for the targeted space.
+func (cmd MarketplaceServices) spaceScopedBrokerGuids(brokers []models.ServiceBroker) map[string]bool {
+	guids := map[string]bool{}
+	if !cmd.config.HasSpace() {
+		return guids
+	}
+
+	for _, broker := range brokers {
+		if broker.SpaceGuid != "" && broker.SpaceGuid == cmd.config.SpaceFields().Guid {
+			guids[broker.Guid] = true
+		}
+	}
+	return guids
+}
+
+func findBrokerByName(brokers []models.ServiceBroker, name string) (models.ServiceBroker, bool) {
+	for _, broker := range brokers {
+		if broker.Name == name {
+			return broker, true
+		}
+	}
+	return models.ServiceBroker{}, false
+}
+
+func filterOfferingsByBroker(offerings models.ServiceOfferings, brokerGuid string) (filtered models.ServiceOfferings) {
+	for _, offering := range offerings {
+		if offering.BrokerGuid == brokerGuid {
+			filtered = append(filtered, offering)
+		}
+	}
+	return
+}
+
+func filterOfferingsBySearch(offerings models.ServiceOfferings, search string) (filtered models.ServiceOfferings) {
+	search = strings.ToLower(search)
+	for _, offering := range offerings {
+		fields := append([]string{offering.Label, offering.Description}, offering.Tags...)
+		for _, field := range fields {
+			if strings.Contains(strings.ToLower(field), search) {
+				filtered = append(filtered, offering)
+				break
+			}
+		}
+	}
+	return
+}
+
+func formatPlanCosts(costs []models.ServicePlanCost) string {
+	formatted := []string{}
+	for _, cost := range costs {
+		currencies := []string{}
+		for currency := range cost.Amount {
+			currencies = append(currencies, currency)
+		}
+		sort.Strings(currencies)
+
+		for _, currency := range currencies {
+			amount := fmt.Sprintf("%.2f %s", cost.Amount[currency], strings.ToUpper(currency))
+			if cost.Unit != "" {
+				amount += "/" + strings.ToLower(cost.Unit)
+			}
+			formatted = append(formatted, amount)
+		}
+	}
+	return strings.Join(formatted, ", ")
+}
+
+func newMarketplaceOffering(offering models.ServiceOffering, spaceScoped bool) marketplaceOffering {
+	result := marketplaceOffering{
+		Label:       offering.Label,
+		Description: offering.Description,
+		Tags:        offering.Tags,
+		SpaceScoped: spaceScoped,
+		Plans:       []marketplacePlan{},
+	}
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+
+	for _, plan := range offering.Plans {
+		marketplacePlan := marketplacePlan{
+			Name:        plan.Name,
+			DisplayName: plan.Extra.DisplayName,
+			Description: plan.Description,
+			Free:        plan.Free,
+			Bullets:     plan.Extra.Bullets,
+		}
+		for _, cost := range plan.Extra.Costs {
+			marketplacePlan.Costs = append(marketplacePlan.Costs, marketplaceCost{Amount: cost.Amount, Unit: cost.Unit})
+		}
+		result.Plans = append(result.Plans, marketplacePlan)
+	}
+	return result
+}
@@ -1,7 +1,11 @@
 package service_test
 
 import (
+	"encoding/json"
+	"strings"
+
 	testapi "github.com/cloudfoundry/cli/cf/actors/service_builder/fakes"
+	fakeapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands/service"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/models"
@@ -20,12 +24,14 @@ var _ = Describe("marketplace command", func() {
 	var requirementsFactory *testreq.FakeReqFactory
 	var config core_config.ReadWriter
 	var serviceBuilder *testapi.FakeServiceBuilder
+	var brokerRepo *fakeapi.FakeServiceBrokerRepo
 	var fakeServiceOfferings []models.ServiceOffering
 	var serviceWithAPaidPlan models.ServiceOffering
 	var service2 models.ServiceOffering
 
 	BeforeEach(func() {
 		serviceBuilder = &testapi.FakeServiceBuilder{}
+		brokerRepo = &fakeapi.FakeServiceBrokerRepo{}
 		ui = &testterm.FakeUI{}
 		requirementsFactory = &testreq.FakeReqFactory{ApiEndpointSuccess: true}
 
@@ -55,14 +61,14 @@ var _ = Describe("marketplace command", func() {
 		Context("when the an API endpoint is not targeted", func() {
 			It("does not meet its requirements", func() {
 				config := testconfig.NewRepository()
-				cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+				cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 				requirementsFactory.ApiEndpointSuccess = false
 
 				Expect(testcmd.RunCommand(cmd, []string{}, requirementsFactory)).To(BeFalse())
 			})
 			It("should fail with usage when provided any arguments", func() {
 				config := testconfig.NewRepository()
-				cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+				cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 				requirementsFactory.ApiEndpointSuccess = true
 				Expect(testcmd.RunCommand(cmd, []string{"blahblah"}, requirementsFactory)).To(BeFalse())
 				Expect(ui.FailedWithUsage).To(BeTrue())
@@ -85,7 +91,7 @@ var _ = Describe("marketplace command", func() {
 			})
 
 			It("lists all of the service offerings for the space", func() {
-				cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+				cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 				testcmd.RunCommand(cmd, []string{}, requirementsFactory)
 
 				args := serviceBuilder.GetServicesForSpaceWithPlansArgsForCall(0)
@@ -102,13 +108,145 @@ var _ = Describe("marketplace command", func() {
 				))
 			})
 
+			It("marks the offerings of brokers scoped to the space", func() {
+				service2.BrokerGuid = "private-broker-guid"
+				serviceBuilder.GetServicesForSpaceWithPlansReturns([]models.ServiceOffering{serviceWithAPaidPlan, service2}, nil)
+				brokerRepo.ServiceBrokers = []models.ServiceBroker{
+					{Guid: "private-broker-guid", SpaceGuid: "the-space-guid"},
+					{Guid: "other-broker-guid", SpaceGuid: "other-space-guid"},
+				}
+
+				cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+				testcmd.RunCommand(cmd, []string{}, requirementsFactory)
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"aaa-my-service-offering [space]", "service offering 2 description"},
+					[]string{"[space] The denoted services are offered by a broker registered only for this space."},
+				))
+				Expect(ui.Outputs).NotTo(ContainSubstrings(
+					[]string{"zzz-my-service-offering [space]"},
+				))
+			})
+
+			Context("when filtering", func() {
+				BeforeEach(func() {
+					serviceWithAPaidPlan.BrokerGuid = "broker-1-guid"
+					serviceWithAPaidPlan.Tags = []string{"relational", "mysql"}
+					service2.BrokerGuid = "broker-2-guid"
+					serviceBuilder.GetServicesForSpaceWithPlansReturns([]models.ServiceOffering{serviceWithAPaidPlan, service2}, nil)
+				})
+
+				It("only lists offerings whose label, description or tags match the search", func() {
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+
+					testcmd.RunCommand(cmd, []string{"--search", "MySQL"}, requirementsFactory)
+					Expect(ui.Outputs).To(ContainSubstrings([]string{"zzz-my-service-offering"}))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"aaa-my-service-offering"}))
+
+					ui = &testterm.FakeUI{}
+					cmd = NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"--search", "offering 2"}, requirementsFactory)
+					Expect(ui.Outputs).To(ContainSubstrings([]string{"aaa-my-service-offering"}))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"zzz-my-service-offering"}))
+				})
+
+				It("says when nothing matches the search", func() {
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"--search", "postgres"}, requirementsFactory)
+
+					Expect(ui.Outputs).To(ContainSubstrings([]string{"No service offerings found"}))
+				})
+
+				It("only lists offerings from the given broker", func() {
+					brokerRepo.ServiceBrokers = []models.ServiceBroker{
+						{Guid: "broker-1-guid", Name: "broker-1"},
+						{Guid: "broker-2-guid", Name: "broker-2"},
+					}
+
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"--broker", "broker-2"}, requirementsFactory)
+
+					Expect(brokerRepo.FindByNameName).To(BeEmpty())
+					Expect(ui.Outputs).To(ContainSubstrings([]string{"aaa-my-service-offering"}))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"zzz-my-service-offering"}))
+				})
+
+				It("filters by a broker registered in the space without being an admin", func() {
+					brokerRepo.ServiceBrokers = []models.ServiceBroker{
+						{Guid: "broker-2-guid", Name: "broker-2", SpaceGuid: "the-space-guid"},
+					}
+
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"--broker", "broker-2"}, requirementsFactory)
+
+					Expect(ui.Outputs).To(ContainSubstrings([]string{"aaa-my-service-offering [space]"}))
+					Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"zzz-my-service-offering"}))
+				})
+
+				It("fails with an explanation when the broker cannot be seen", func() {
+					brokerRepo.ServiceBrokers = []models.ServiceBroker{
+						{Guid: "broker-1-guid", Name: "broker-1"},
+					}
+
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"--broker", "nope"}, requirementsFactory)
+
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Service broker nope not found", "Only admins"},
+					))
+				})
+
+				It("fails with an explanation when brokers cannot be listed", func() {
+					brokerRepo.ListErr = true
+
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"--broker", "broker-2"}, requirementsFactory)
+
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Service broker broker-2 not found", "Only admins"},
+					))
+				})
+			})
+
+			It("prints the offerings as JSON with --json", func() {
+				serviceWithAPaidPlan.Tags = []string{"mysql"}
+				serviceWithAPaidPlan.Plans[1].Extra = models.ServicePlanExtra{
+					DisplayName: "Plan B",
+					Costs:       []models.ServicePlanCost{{Amount: map[string]float64{"usd": 10}, Unit: "MONTHLY"}},
+				}
+				serviceBuilder.GetServicesForSpaceWithPlansReturns([]models.ServiceOffering{serviceWithAPaidPlan}, nil)
+
+				cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+				testcmd.RunCommand(cmd, []string{"--json"}, requirementsFactory)
+
+				Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"Getting services from marketplace"}))
+
+				var offerings []map[string]interface{}
+				err := json.Unmarshal([]byte(strings.Join(ui.Outputs, "\n")), &offerings)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(offerings).To(HaveLen(1))
+				Expect(offerings[0]["label"]).To(Equal("zzz-my-service-offering"))
+				Expect(offerings[0]["tags"]).To(Equal([]interface{}{"mysql"}))
+
+				plans := offerings[0]["plans"].([]interface{})
+				Expect(plans).To(HaveLen(2))
+				Expect(plans[0].(map[string]interface{})["free"]).To(BeTrue())
+				paidPlan := plans[1].(map[string]interface{})
+				Expect(paidPlan["display_name"]).To(Equal("Plan B"))
+				Expect(paidPlan["costs"]).To(Equal([]interface{}{
+					map[string]interface{}{"amount": map[string]interface{}{"usd": 10.0}, "unit": "MONTHLY"},
+				}))
+			})
+
 			Context("when there are no paid plans", func() {
 				BeforeEach(func() {
 					serviceBuilder.GetServicesForSpaceWithPlansReturns([]models.ServiceOffering{service2}, nil)
 				})
 
 				It("lists the service offerings without displaying the paid message", func() {
-					cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 					testcmd.RunCommand(cmd, []string{}, requirementsFactory)
 
 					Expect(ui.Outputs).To(ContainSubstrings(
@@ -128,7 +266,7 @@ var _ = Describe("marketplace command", func() {
 				It("Displays the list of plans for each service with info", func() {
 					serviceBuilder.GetServiceByNameForSpaceWithPlansReturns(serviceWithAPaidPlan, nil)
 
-					cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 					testcmd.RunCommand(cmd, []string{"-s", "aaa-my-service-offering"}, requirementsFactory)
 
 					Expect(ui.Outputs).To(ContainSubstrings(
@@ -140,8 +278,64 @@ var _ = Describe("marketplace command", func() {
 					))
 				})
 
+				It("shows plan costs, display names and bullets", func() {
+					serviceWithAPaidPlan.Plans[1].Extra = models.ServicePlanExtra{
+						DisplayName: "Plan B",
+						Bullets:     []string{"5 GB storage", "10 connections"},
+						Costs: []models.ServicePlanCost{
+							{Amount: map[string]float64{"usd": 10, "eur": 9.5}, Unit: "MONTHLY"},
+						},
+					}
+					serviceBuilder.GetServiceByNameForSpaceWithPlansReturns(serviceWithAPaidPlan, nil)
+
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"-s", "zzz-my-service-offering"}, requirementsFactory)
+
+					Expect(ui.Outputs).To(ContainSubstrings(
+						[]string{"service plan", "description", "free or paid", "costs"},
+						[]string{"service-plan-a", "free"},
+						[]string{"service-plan-b", "paid", "9.50 EUR/monthly, 10.00 USD/monthly"},
+						[]string{"service-plan-b (Plan B):"},
+						[]string{"- 5 GB storage"},
+						[]string{"- 10 connections"},
+					))
+				})
+
+				It("marks an offering of a broker scoped to the space in its JSON", func() {
+					serviceWithAPaidPlan.BrokerGuid = "private-broker-guid"
+					serviceBuilder.GetServiceByNameForSpaceWithPlansReturns(serviceWithAPaidPlan, nil)
+					brokerRepo.ServiceBrokers = []models.ServiceBroker{
+						{Guid: "private-broker-guid", SpaceGuid: "the-space-guid"},
+					}
+
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"-s", "zzz-my-service-offering", "--json"}, requirementsFactory)
+
+					var offering map[string]interface{}
+					err := json.Unmarshal([]byte(strings.Join(ui.Outputs, "\n")), &offering)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(offering["label"]).To(Equal("zzz-my-service-offering"))
+					Expect(offering["space_scoped"]).To(BeTrue())
+				})
+
+				It("does not mark an offering of a broker scoped to another space in its JSON", func() {
+					serviceWithAPaidPlan.BrokerGuid = "other-broker-guid"
+					serviceBuilder.GetServiceByNameForSpaceWithPlansReturns(serviceWithAPaidPlan, nil)
+					brokerRepo.ServiceBrokers = []models.ServiceBroker{
+						{Guid: "other-broker-guid", SpaceGuid: "other-space-guid"},
+					}
+
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
+					testcmd.RunCommand(cmd, []string{"-s", "zzz-my-service-offering", "--json"}, requirementsFactory)
+
+					var offering map[string]interface{}
+					err := json.Unmarshal([]byte(strings.Join(ui.Outputs, "\n")), &offering)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(offering["space_scoped"]).To(BeFalse())
+				})
+
 				It("informs the user if the service cannot be found", func() {
-					cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+					cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 					testcmd.RunCommand(cmd, []string{"-s", "aaa-my-service-offering"}, requirementsFactory)
 
 					Expect(ui.Outputs).To(ContainSubstrings(
@@ -160,7 +354,7 @@ var _ = Describe("marketplace command", func() {
 			})
 
 			It("tells the user to target a space", func() {
-				cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+				cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 				testcmd.RunCommand(cmd, []string{}, requirementsFactory)
 				Expect(ui.Outputs).To(ContainSubstrings(
 					[]string{"without", "space"},
@@ -178,7 +372,7 @@ var _ = Describe("marketplace command", func() {
 			serviceBuilder := &testapi.FakeServiceBuilder{}
 			serviceBuilder.GetAllServicesWithPlansReturns(fakeServiceOfferings, nil)
 
-			cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+			cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 			testcmd.RunCommand(cmd, []string{}, requirementsFactory)
 
 			Expect(ui.Outputs).To(ContainSubstrings(
@@ -194,7 +388,7 @@ var _ = Describe("marketplace command", func() {
 			serviceBuilder := &testapi.FakeServiceBuilder{}
 			serviceBuilder.GetAllServicesWithPlansReturns([]models.ServiceOffering{}, nil)
 
-			cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+			cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 			testcmd.RunCommand(cmd, []string{}, requirementsFactory)
 
 			Expect(ui.Outputs).To(ContainSubstrings(
@@ -208,7 +402,7 @@ var _ = Describe("marketplace command", func() {
 		Context("when the user passes the -s flag", func() {
 			It("Displays the list of plans for each service with info", func() {
 				serviceBuilder.GetServiceByNameWithPlansReturns(serviceWithAPaidPlan, nil)
-				cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+				cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 				testcmd.RunCommand(cmd, []string{"-s", "aaa-my-service-offering"}, requirementsFactory)
 
 				Expect(ui.Outputs).To(ContainSubstrings(
@@ -221,7 +415,7 @@ var _ = Describe("marketplace command", func() {
 			})
 
 			It("informs the user if the service cannot be found", func() {
-				cmd := NewMarketplaceServices(ui, config, serviceBuilder)
+				cmd := NewMarketplaceServices(ui, config, serviceBuilder, brokerRepo)
 				testcmd.RunCommand(cmd, []string{"-s", "aaa-my-service-offering"}, requirementsFactory)
 
 				Expect(ui.Outputs).To(ContainSubstrings(
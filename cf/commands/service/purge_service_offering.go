@@ -9,11 +9,13 @@ import (
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/ui_helpers"
 	"github.com/codegangsta/cli"
 )
 
 type PurgeServiceOffering struct {
 	ui          terminal.UI
+	config      core_config.Reader
 	serviceRepo api.ServiceRepository
 }
 
@@ -57,7 +59,12 @@ func (cmd PurgeServiceOffering) Run(c *cli.Context) {
 	}
 
 	confirmed := c.Bool("f")
-	if !confirmed {
+	// purging removes the offering's instances from every org, so any
+	// protected target makes it a protected operation
+	if len(cmd.config.ProtectedTargets()) > 0 {
+		cmd.ui.Warn(scaryWarningMessage())
+		confirmed = ui_helpers.ConfirmProtectedDelete(cmd.ui, T("service offering"), serviceName, confirmed)
+	} else if !confirmed {
 		cmd.ui.Warn(scaryWarningMessage())
 		confirmed = cmd.ui.Confirm(T("Really purge service offering {{.ServiceName}} from Cloud Foundry?",
 			map[string]interface{}{"ServiceName": serviceName},
@@ -78,6 +85,7 @@ func (cmd PurgeServiceOffering) Run(c *cli.Context) {
 
 func NewPurgeServiceOffering(ui terminal.UI, config core_config.Reader, serviceRepo api.ServiceRepository) (cmd PurgeServiceOffering) {
 	cmd.ui = ui
+	cmd.config = config
 	cmd.serviceRepo = serviceRepo
 	return
 }
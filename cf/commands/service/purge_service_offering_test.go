@@ -2,6 +2,7 @@ package service_test
 
 import (
 	"errors"
+	"os"
 
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands/service"
@@ -177,6 +178,41 @@ var _ = Describe("purge-service command", func() {
 
 		Expect(deps.serviceRepo.PurgeServiceOfferingCalled).To(Equal(false))
 	})
+
+	Context("when any target is protected", func() {
+		var deps commandDependencies
+
+		BeforeEach(func() {
+			deps = setupDependencies()
+			deps.config.SetProtectedTargets([]string{"prod"})
+			deps.serviceRepo.FindServiceOfferingByLabelAndProviderServiceOffering = maker.NewServiceOffering("the-service-name")
+		})
+
+		AfterEach(func() {
+			os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "")
+		})
+
+		It("requires the service offering name to be typed back", func() {
+			deps.ui.Inputs = []string{"yes"}
+			testcmd.RunCommand(NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo), []string{"the-service-name"}, deps.requirementsFactory)
+			Expect(deps.serviceRepo.PurgeServiceOfferingCalled).To(Equal(false))
+
+			deps.ui.Inputs = []string{"the-service-name"}
+			testcmd.RunCommand(NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo), []string{"the-service-name"}, deps.requirementsFactory)
+			Expect(deps.ui.Prompts).To(ContainSubstrings([]string{"protected target", "service offering", "the-service-name"}))
+			Expect(deps.serviceRepo.PurgeServiceOfferingCalled).To(Equal(true))
+		})
+
+		It("refuses the -f flag unless the override is set", func() {
+			testcmd.RunCommand(NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo), []string{"-f", "the-service-name"}, deps.requirementsFactory)
+			Expect(deps.ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+			Expect(deps.serviceRepo.PurgeServiceOfferingCalled).To(Equal(false))
+
+			os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "true")
+			testcmd.RunCommand(NewPurgeServiceOffering(deps.ui, deps.config, deps.serviceRepo), []string{"-f", "the-service-name"}, deps.requirementsFactory)
+			Expect(deps.serviceRepo.PurgeServiceOfferingCalled).To(Equal(true))
+		})
+	})
 })
 
 type commandDependencies struct {
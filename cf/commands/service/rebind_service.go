@@ -0,0 +1,185 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf"
+	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/applications"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/json"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ApplicationRestager interface {
+	ApplicationRestage(app models.Application, orgName string, spaceName string) (updatedApp models.Application, err error)
+}
+
+type RebindService struct {
+	ui                 terminal.UI
+	config             core_config.Reader
+	serviceBindingRepo api.ServiceBindingRepository
+	appRepo            applications.ApplicationRepository
+	appRestager        ApplicationRestager
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+	appNames           []string
+}
+
+func NewRebindService(ui terminal.UI, config core_config.Reader, serviceBindingRepo api.ServiceBindingRepository, appRepo applications.ApplicationRepository, appRestager ApplicationRestager) (cmd *RebindService) {
+	cmd = new(RebindService)
+	cmd.ui = ui
+	cmd.config = config
+	cmd.serviceBindingRepo = serviceBindingRepo
+	cmd.appRepo = appRepo
+	cmd.appRestager = appRestager
+	return
+}
+
+func (cmd *RebindService) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "rebind-service",
+		ShortName:   "rbs",
+		Description: T("Unbind and bind a service instance to apps again, which generates new credentials"),
+		Usage: T(`CF_NAME rebind-service APP_NAME[,APP_NAME...] SERVICE_INSTANCE [-c PARAMETERS_AS_JSON] [--restage]
+
+EXAMPLE:
+   CF_NAME rebind-service myapp mydb
+   CF_NAME rebind-service myapp,myworker mydb --restage`),
+		Flags: []cli.Flag{
+			flag_helpers.NewStringFlag("c", T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file")),
+			cli.BoolFlag{Name: "restage", Usage: T("Restage the apps once they have all been rebound")},
+		},
+	}
+}
+
+func (cmd *RebindService) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.appNames = splitNames(c.Args()[0])
+	if len(cmd.appNames) == 0 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.serviceInstanceReq = requirementsFactory.NewServiceInstanceRequirement(c.Args()[1])
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return
+}
+
+func (cmd *RebindService) Run(c *cli.Context) {
+	instance := cmd.serviceInstanceReq.GetServiceInstance()
+
+	params, err := json.ParseJsonFromFileOrString(c.String("c"))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	orgName := cmd.config.OrganizationFields().Name
+	spaceName := cmd.config.SpaceFields().Name
+
+	cmd.ui.Say(T("Rebinding service {{.ServiceInstanceName}} to apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ServiceInstanceName": terminal.EntityNameColor(instance.Name),
+			"AppNames":            terminal.EntityNameColor(strings.Join(cmd.appNames, ", ")),
+			"OrgName":             terminal.EntityNameColor(orgName),
+			"SpaceName":           terminal.EntityNameColor(spaceName),
+			"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	table := terminal.NewTable(cmd.ui, []string{T("app"), T("status")})
+	reboundApps := []models.Application{}
+	for _, appName := range cmd.appNames {
+		app, status, ok := cmd.rebind(appName, instance, params)
+		if ok {
+			reboundApps = append(reboundApps, app)
+		}
+		table.Add(appName, status)
+	}
+
+	cmd.ui.Say("")
+	table.Print()
+	cmd.ui.Say("")
+
+	failures := []string{}
+	if rebindFailures := len(cmd.appNames) - len(reboundApps); rebindFailures > 0 {
+		failures = append(failures, T("{{.FailureCount}} of {{.AppCount}} apps could not be rebound",
+			map[string]interface{}{
+				"FailureCount": rebindFailures,
+				"AppCount":     len(cmd.appNames),
+			}))
+	}
+
+	// restaging streams staging logs, so it only starts once every app has
+	// been rebound and the table is printed
+	if c.Bool("restage") && len(reboundApps) > 0 {
+		restageTable := terminal.NewTable(cmd.ui, []string{T("app"), T("restage")})
+		restageFailures := 0
+		for _, app := range reboundApps {
+			_, err = cmd.appRestager.ApplicationRestage(app, orgName, spaceName)
+			if err != nil {
+				restageFailures++
+				restageTable.Add(app.Name, bindingFailedStatus(err))
+			} else {
+				restageTable.Add(app.Name, T("restaged"))
+			}
+		}
+
+		cmd.ui.Say("")
+		restageTable.Print()
+		cmd.ui.Say("")
+
+		if restageFailures > 0 {
+			failures = append(failures, T("{{.FailureCount}} of {{.AppCount}} apps could not be restaged",
+				map[string]interface{}{
+					"FailureCount": restageFailures,
+					"AppCount":     len(reboundApps),
+				}))
+		}
+	}
+
+	if len(failures) > 0 {
+		cmd.ui.Failed(strings.Join(failures, "\n"))
+		return
+	}
+
+	cmd.ui.Ok()
+	if !c.Bool("restage") {
+		cmd.ui.Say(T("TIP: Use '{{.CFCommand}}' to ensure your env variable changes take effect",
+			map[string]interface{}{"CFCommand": terminal.CommandColor(cf.Name() + " restage")}))
+	}
+}
+
+func (cmd *RebindService) rebind(appName string, instance models.ServiceInstance, params map[string]interface{}) (app models.Application, status string, ok bool) {
+	app, err := cmd.appRepo.Read(appName)
+	if err != nil {
+		return app, bindingFailedStatus(err), false
+	}
+
+	wasBound, err := cmd.serviceBindingRepo.Delete(instance, app.Guid)
+	if err != nil {
+		return app, bindingFailedStatus(err), false
+	}
+
+	err = cmd.serviceBindingRepo.Create(instance.Guid, app.Guid, params)
+	if err != nil {
+		return app, bindingFailedStatus(err), false
+	}
+
+	if !wasBound {
+		return app, T("bound (was not bound before)"), true
+	}
+	return app, T("rebound"), true
+}
@@ -0,0 +1,176 @@
+package service_test
+
+import (
+	"errors"
+
+	testApplication "github.com/cloudfoundry/cli/cf/api/applications/fakes"
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/service"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rebind-service command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *testreq.FakeReqFactory
+		serviceBindingRepo  *testapi.FakeServiceBindingRepo
+		appRepo             *testApplication.FakeApplicationRepository
+		restager            *testcmd.FakeApplicationRestager
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+		requirementsFactory.ServiceInstance = models.ServiceInstance{
+			ServiceInstanceFields: models.ServiceInstanceFields{Name: "my-service", Guid: "my-service-guid"},
+		}
+		serviceBindingRepo = &testapi.FakeServiceBindingRepo{}
+		appRepo = &testApplication.FakeApplicationRepository{}
+		appRepo.ReadByName = map[string]models.Application{
+			"app1": {ApplicationFields: models.ApplicationFields{Name: "app1", Guid: "app1-guid"}},
+			"app2": {ApplicationFields: models.ApplicationFields{Name: "app2", Guid: "app2-guid"}},
+		}
+		restager = &testcmd.FakeApplicationRestager{}
+	})
+
+	runCommand := func(args ...string) bool {
+		cmd := NewRebindService(ui, testconfig.NewRepositoryWithDefaults(), serviceBindingRepo, appRepo, restager)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given an app and a service instance", func() {
+			runCommand("app1")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand("app1", "my-service")).To(BeFalse())
+		})
+
+		It("fails when a space is not targeted", func() {
+			requirementsFactory.TargetedSpaceSuccess = false
+			Expect(runCommand("app1", "my-service")).To(BeFalse())
+		})
+
+		It("requires the service instance", func() {
+			Expect(runCommand("app1", "my-service")).To(BeTrue())
+			Expect(requirementsFactory.ServiceInstanceName).To(Equal("my-service"))
+		})
+	})
+
+	It("unbinds and binds the service instance for each app", func() {
+		runCommand("app1,app2", "my-service")
+
+		Expect(serviceBindingRepo.DeleteServiceInstance.Guid).To(Equal("my-service-guid"))
+		Expect(serviceBindingRepo.DeleteApplicationGuids).To(Equal([]string{"app1-guid", "app2-guid"}))
+		Expect(serviceBindingRepo.CreateApplicationGuids).To(Equal([]string{"app1-guid", "app2-guid"}))
+		Expect(serviceBindingRepo.CreateServiceInstanceGuid).To(Equal("my-service-guid"))
+		Expect(restager.RestagedApps).To(BeEmpty())
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Rebinding service", "my-service", "app1, app2", "my-org", "my-space", "my-user"},
+			[]string{"app", "status"},
+			[]string{"app1", "rebound"},
+			[]string{"app2", "rebound"},
+			[]string{"OK"},
+			[]string{"TIP", "restage"},
+		))
+	})
+
+	It("passes parameters provided with -c to the new bindings", func() {
+		runCommand("-c", `{"permissions":"read-only"}`, "app1", "my-service")
+
+		Expect(serviceBindingRepo.CreateParams).To(Equal(map[string]interface{}{"permissions": "read-only"}))
+	})
+
+	It("binds apps that were not bound before", func() {
+		serviceBindingRepo.DeleteBindingNotFound = true
+
+		runCommand("app1", "my-service")
+
+		Expect(serviceBindingRepo.CreateApplicationGuids).To(Equal([]string{"app1-guid"}))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"app1", "bound (was not bound before)"},
+			[]string{"OK"},
+		))
+	})
+
+	It("restages the apps once they have all been rebound when --restage is given", func() {
+		runCommand("--restage", "app1,app2", "my-service")
+
+		Expect(len(restager.RestagedApps)).To(Equal(2))
+		Expect(restager.RestagedApps[0].Name).To(Equal("app1"))
+		Expect(restager.RestagedApps[1].Name).To(Equal("app2"))
+		Expect(restager.OrgName).To(Equal("my-org"))
+		Expect(restager.SpaceName).To(Equal("my-space"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"app1", "rebound"},
+			[]string{"app2", "rebound"},
+			[]string{"OK"},
+		))
+		Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"TIP"}))
+	})
+
+	It("restages the remaining apps after one fails to restage and fails at the end", func() {
+		restager.ApplicationRestageErrorsByName = map[string]error{"app1": errors.New("staging failed")}
+
+		runCommand("--restage", "app1,app2", "my-service")
+
+		Expect(serviceBindingRepo.CreateApplicationGuids).To(Equal([]string{"app1-guid", "app2-guid"}))
+		Expect(len(restager.RestagedApps)).To(Equal(2))
+		Expect(restager.RestagedApps[0].Name).To(Equal("app1"))
+		Expect(restager.RestagedApps[1].Name).To(Equal("app2"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"app1", "rebound"},
+			[]string{"app2", "rebound"},
+			[]string{"app1", "failed", "staging failed"},
+			[]string{"app2", "restaged"},
+			[]string{"FAILED"},
+			[]string{"1 of 2 apps could not be restaged"},
+		))
+		Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"OK"}))
+	})
+
+	It("only restages the apps that were rebound", func() {
+		runCommand("--restage", "app1,missing-app", "my-service")
+
+		Expect(len(restager.RestagedApps)).To(Equal(1))
+		Expect(restager.RestagedApps[0].Name).To(Equal("app1"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"1 of 2 apps could not be rebound"},
+		))
+	})
+
+	It("carries on past apps that cannot be found and fails at the end", func() {
+		runCommand("app1,missing-app,app2", "my-service")
+
+		Expect(serviceBindingRepo.CreateApplicationGuids).To(Equal([]string{"app1-guid", "app2-guid"}))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"app1", "rebound"},
+			[]string{"missing-app", "failed", "not found"},
+			[]string{"app2", "rebound"},
+			[]string{"FAILED"},
+			[]string{"1 of 3 apps could not be rebound"},
+		))
+	})
+
+	It("fails without rebinding when the parameters are invalid", func() {
+		runCommand("-c", `{"permissions"}`, "app1", "my-service")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Invalid configuration provided for -c flag"},
+		))
+		Expect(serviceBindingRepo.DeleteApplicationGuids).To(BeEmpty())
+	})
+})
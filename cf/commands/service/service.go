@@ -1,8 +1,17 @@
 package service
 
 import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudfoundry/cli/cf"
+	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/errors"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/codegangsta/cli"
@@ -10,12 +19,14 @@ import (
 
 type ShowService struct {
 	ui                 terminal.UI
+	serviceSummaryRepo api.ServiceSummaryRepository
 	serviceInstanceReq requirements.ServiceInstanceRequirement
 }
 
-func NewShowService(ui terminal.UI) (cmd *ShowService) {
+func NewShowService(ui terminal.UI, serviceSummaryRepo api.ServiceSummaryRepository) (cmd *ShowService) {
 	cmd = new(ShowService)
 	cmd.ui = ui
+	cmd.serviceSummaryRepo = serviceSummaryRepo
 	return
 }
 
@@ -51,14 +62,35 @@ func (cmd *ShowService) Run(c *cli.Context) {
 	if c.Bool("guid") {
 		cmd.ui.Say(serviceInstance.Guid)
 	} else {
+		var summary models.ServiceInstance
+		var err error
+		if serviceInstance.IsUserProvided() {
+			summary, err = cmd.serviceSummaryRepo.GetUserProvidedServiceInstanceSummary(serviceInstance.Guid)
+		} else {
+			summary, err = cmd.serviceSummaryRepo.GetServiceInstanceSummary(serviceInstance.Guid)
+		}
+		if err != nil {
+			cmd.ui.Failed(err.Error())
+			return
+		}
+		serviceInstance.ServiceBindings = summary.ServiceBindings
+		serviceInstance.Space = summary.Space
+		serviceInstance.Organization = summary.Organization
+
 		cmd.ui.Say("")
 		cmd.ui.Say(T("Service instance: {{.ServiceName}}", map[string]interface{}{"ServiceName": terminal.EntityNameColor(serviceInstance.Name)}))
+		cmd.ui.Say(T("Org / Space: {{.OrgName}} / {{.SpaceName}}",
+			map[string]interface{}{
+				"OrgName":   terminal.EntityNameColor(serviceInstance.Organization.Name),
+				"SpaceName": terminal.EntityNameColor(serviceInstance.Space.Name),
+			}))
 
 		if serviceInstance.IsUserProvided() {
 			cmd.ui.Say(T("Service: {{.ServiceDescription}}",
 				map[string]interface{}{
 					"ServiceDescription": terminal.EntityNameColor(T("user-provided")),
 				}))
+			cmd.sayTags(serviceInstance.Tags)
 		} else {
 			cmd.ui.Say(T("Service: {{.ServiceDescription}}",
 				map[string]interface{}{
@@ -68,6 +100,7 @@ func (cmd *ShowService) Run(c *cli.Context) {
 				map[string]interface{}{
 					"ServicePlanName": terminal.EntityNameColor(serviceInstance.ServicePlan.Name),
 				}))
+			cmd.sayTags(serviceInstance.Tags)
 			cmd.ui.Say(T("Description: {{.ServiceDescription}}", map[string]interface{}{"ServiceDescription": terminal.EntityNameColor(serviceInstance.ServiceOffering.Description)}))
 			cmd.ui.Say(T("Documentation url: {{.URL}}",
 				map[string]interface{}{
@@ -77,6 +110,209 @@ func (cmd *ShowService) Run(c *cli.Context) {
 				map[string]interface{}{
 					"URL": terminal.EntityNameColor(serviceInstance.DashboardUrl),
 				}))
+
+			if serviceInstance.LastOperation.State != "" {
+				cmd.ui.Say("")
+				cmd.ui.Say(T("Last Operation"))
+				cmd.ui.Say(T("Status: {{.State}}",
+					map[string]interface{}{
+						"State": terminal.EntityNameColor(lastOperationStatus(serviceInstance.LastOperation)),
+					}))
+				cmd.ui.Say(T("Message: {{.Message}}",
+					map[string]interface{}{
+						"Message": terminal.EntityNameColor(serviceInstance.LastOperation.Description),
+					}))
+				cmd.ui.Say(T("Started: {{.Started}}",
+					map[string]interface{}{
+						"Started": terminal.EntityNameColor(serviceInstance.LastOperation.CreatedAt),
+					}))
+				cmd.ui.Say(T("Updated: {{.Updated}}",
+					map[string]interface{}{
+						"Updated": terminal.EntityNameColor(serviceInstance.LastOperation.UpdatedAt),
+					}))
+			}
 		}
+
+		cmd.sayBoundApps(serviceInstance.ServiceBindings)
+
+		if !serviceInstance.IsUserProvided() {
+			cmd.sayPlanHistory(serviceInstance.Guid)
+		}
+	}
+}
+
+func (cmd *ShowService) sayBoundApps(bindings []models.ServiceBindingFields) {
+	cmd.ui.Say("")
+	if len(bindings) == 0 {
+		cmd.ui.Say(T("There are no bound apps for this service."))
+		return
+	}
+
+	cmd.ui.Say(T("Bound apps:"))
+	table := terminal.NewTable(cmd.ui, []string{T("name"), T("binding guid")})
+	for _, binding := range bindings {
+		table.Add(binding.AppName, binding.Guid)
+	}
+	table.Print()
+}
+
+func (cmd *ShowService) sayPlanHistory(instanceGuid string) {
+	// reading events needs more permissions than reading the instance, so
+	// a missing history is not worth failing the command for
+	changes, err := cmd.serviceSummaryRepo.GetServicePlanHistory(instanceGuid)
+	if err != nil {
+		cmd.ui.Say("")
+		cmd.ui.Warn(T("Could not get the plan history: {{.Error}}", map[string]interface{}{"Error": err.Error()}))
+		return
+	}
+
+	if len(changes) == 0 {
+		return
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(T("Plan history:"))
+	table := terminal.NewTable(cmd.ui, []string{T("time"), T("actor"), T("plan")})
+	for _, change := range changes {
+		table.Add(change.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"), change.ActorName, change.PlanName)
+	}
+	table.Print()
+}
+
+func (cmd *ShowService) sayTags(tags []string) {
+	if len(tags) == 0 {
+		return
+	}
+
+	cmd.ui.Say(T("Tags: {{.Tags}}",
+		map[string]interface{}{
+			"Tags": terminal.EntityNameColor(strings.Join(tags, ", ")),
+		}))
+}
+
+const (
+	DefaultServiceOperationTimeout      = 30 * time.Minute
+	DefaultServiceOperationPollInterval = 5 * time.Second
+)
+
+// serviceOperationTimeoutFromEnv returns the raw value of
+// CF_SERVICE_OPERATION_TIMEOUT. It is only parsed once --wait is given, so a
+// bad value cannot break commands that never wait.
+func serviceOperationTimeoutFromEnv() string {
+	return os.Getenv("CF_SERVICE_OPERATION_TIMEOUT")
+}
+
+// parseServiceOperationTimeout returns how long --wait polls for, which can
+// be overridden in minutes.
+func parseServiceOperationTimeout(ui terminal.UI, minutes string) time.Duration {
+	if minutes == "" {
+		return DefaultServiceOperationTimeout
+	}
+
+	duration, err := strconv.ParseInt(minutes, 10, 64)
+	if err != nil {
+		ui.Failed(T("invalid value for env var CF_SERVICE_OPERATION_TIMEOUT\n{{.Err}}",
+			map[string]interface{}{"Err": err}))
+	}
+	return time.Duration(duration) * time.Minute
+}
+
+// followServiceOperation reports on an operation the broker may still be
+// running. With wait it polls until the operation finishes, otherwise it
+// tells the user how to check on it.
+func followServiceOperation(ui terminal.UI, serviceRepo api.ServiceRepository, instanceName string, wait bool, pollInterval time.Duration, timeout string) {
+	if !wait {
+		instance, err := serviceRepo.FindInstanceByName(instanceName)
+		if err == nil && instance.IsOperationInProgress() {
+			ui.Say("")
+			ui.Say(T("{{.OperationType}} in progress. Use '{{.ServicesCommand}}' or '{{.ServiceCommand}}' to check operation status.",
+				map[string]interface{}{
+					"OperationType":   lastOperationType(instance.LastOperation),
+					"ServicesCommand": terminal.CommandColor(cf.Name() + " services"),
+					"ServiceCommand":  terminal.CommandColor(cf.Name() + " service " + instanceName),
+				}))
+		}
+		return
+	}
+
+	ui.Say("")
+	ui.Say(T("Waiting for the operation on service instance {{.ServiceInstanceName}} to complete...",
+		map[string]interface{}{"ServiceInstanceName": terminal.EntityNameColor(instanceName)}))
+
+	err := waitForServiceOperation(ui, serviceRepo, instanceName, pollInterval, parseServiceOperationTimeout(ui, timeout))
+	switch err.(type) {
+	case nil, *errors.ModelNotFoundError:
+		ui.Ok()
+	default:
+		ui.Failed(err.Error())
+	}
+}
+
+// WaitForServiceInstance polls until the last operation on the instance has
+// finished, for commands that can only go on once the instance is usable.
+func WaitForServiceInstance(ui terminal.UI, serviceRepo api.ServiceRepository, instanceName string, pollInterval time.Duration) error {
+	return waitForServiceOperation(ui, serviceRepo, instanceName, pollInterval, parseServiceOperationTimeout(ui, serviceOperationTimeoutFromEnv()))
+}
+
+// waitForServiceOperation polls the service instance until its last operation
+// is no longer in progress. An instance that has been deleted is reported as
+// a ModelNotFoundError.
+func waitForServiceOperation(ui terminal.UI, serviceRepo api.ServiceRepository, instanceName string, pollInterval, timeout time.Duration) error {
+	startTime := time.Now()
+
+	for {
+		instance, err := serviceRepo.FindInstanceByName(instanceName)
+		if err != nil {
+			return err
+		}
+
+		switch instance.LastOperation.State {
+		case models.LastOperationInProgress:
+		case models.LastOperationFailed:
+			return errors.New(T("{{.OperationType}} failed: {{.Description}}",
+				map[string]interface{}{
+					"OperationType": lastOperationType(instance.LastOperation),
+					"Description":   instance.LastOperation.Description,
+				}))
+		default:
+			return nil
+		}
+
+		if time.Since(startTime) > timeout {
+			return errors.New(T("Timed out waiting for the operation on service instance {{.ServiceInstanceName}} to complete",
+				map[string]interface{}{"ServiceInstanceName": instanceName}))
+		}
+
+		ui.Wait(pollInterval)
+	}
+}
+
+func lastOperationType(operation models.LastOperationFields) string {
+	switch operation.Type {
+	case "create":
+		return T("Create")
+	case "update":
+		return T("Update")
+	case "delete":
+		return T("Delete")
+	}
+	return T("Operation")
+}
+
+// lastOperationStatus describes the last operation for display, e.g.
+// "create in progress". It is empty for instances without one.
+func lastOperationStatus(operation models.LastOperationFields) string {
+	if operation.State == "" {
+		return ""
+	}
+
+	switch operation.State {
+	case models.LastOperationInProgress:
+		return T("{{.OperationType}} in progress", map[string]interface{}{"OperationType": operation.Type})
+	case models.LastOperationSucceeded:
+		return T("{{.OperationType}} succeeded", map[string]interface{}{"OperationType": operation.Type})
+	case models.LastOperationFailed:
+		return T("{{.OperationType}} failed", map[string]interface{}{"OperationType": operation.Type})
 	}
+	return operation.Type + " " + operation.State
 }
@@ -1,6 +1,10 @@
 package service_test
 
 import (
+	"errors"
+	"time"
+
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	"github.com/cloudfoundry/cli/cf/models"
 	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
 	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
@@ -16,15 +20,17 @@ var _ = Describe("service command", func() {
 	var (
 		ui                  *testterm.FakeUI
 		requirementsFactory *testreq.FakeReqFactory
+		serviceSummaryRepo  *testapi.FakeServiceSummaryRepo
 	)
 
 	BeforeEach(func() {
 		ui = &testterm.FakeUI{}
 		requirementsFactory = &testreq.FakeReqFactory{}
+		serviceSummaryRepo = &testapi.FakeServiceSummaryRepo{}
 	})
 
 	runCommand := func(args ...string) bool {
-		return testcmd.RunCommand(NewShowService(ui), args, requirementsFactory)
+		return testcmd.RunCommand(NewShowService(ui, serviceSummaryRepo), args, requirementsFactory)
 	}
 
 	Describe("requirements", func() {
@@ -83,6 +89,125 @@ var _ = Describe("service command", func() {
 				Expect(requirementsFactory.ServiceInstanceName).To(Equal("service1"))
 			})
 
+			It("shows the last operation when there is one", func() {
+				serviceInstance := requirementsFactory.ServiceInstance
+				serviceInstance.LastOperation = models.LastOperationFields{
+					Type:        "update",
+					State:       "failed",
+					Description: "Cluster resize failed",
+					CreatedAt:   "2015-05-01T10:00:00Z",
+					UpdatedAt:   "2015-05-01T10:05:00Z",
+				}
+				requirementsFactory.ServiceInstance = serviceInstance
+
+				runCommand("service1")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Dashboard: ", "some-url"},
+					[]string{"Last Operation"},
+					[]string{"Status: ", "update failed"},
+					[]string{"Message: ", "Cluster resize failed"},
+					[]string{"Started: ", "2015-05-01T10:00:00Z"},
+					[]string{"Updated: ", "2015-05-01T10:05:00Z"},
+				))
+			})
+
+			It("shows the tags of the service instance", func() {
+				serviceInstance := requirementsFactory.ServiceInstance
+				serviceInstance.Tags = []string{"db", "mysql"}
+				requirementsFactory.ServiceInstance = serviceInstance
+
+				runCommand("service1")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Plan: ", "plan-name"},
+					[]string{"Tags: ", "db, mysql"},
+				))
+			})
+
+			It("does not show a last operation when there is none", func() {
+				runCommand("service1")
+
+				Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"Last Operation"}))
+			})
+
+			It("shows the org and space of the service instance", func() {
+				summary := models.ServiceInstance{}
+				summary.Organization = models.OrganizationFields{Name: "my-org"}
+				summary.Space = models.SpaceFields{Name: "my-space"}
+				serviceSummaryRepo.GetServiceInstanceSummaryInstance = summary
+
+				runCommand("service1")
+
+				Expect(serviceSummaryRepo.GetServiceInstanceSummaryGuid).To(Equal("service1-guid"))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Service instance:", "service1"},
+					[]string{"Org / Space: ", "my-org / my-space"},
+				))
+			})
+
+			It("shows the apps bound to the service instance", func() {
+				summary := models.ServiceInstance{}
+				summary.ServiceBindings = []models.ServiceBindingFields{
+					{Guid: "binding1-guid", AppName: "app1"},
+					{Guid: "binding2-guid", AppName: "app2"},
+				}
+				serviceSummaryRepo.GetServiceInstanceSummaryInstance = summary
+
+				runCommand("service1")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Bound apps:"},
+					[]string{"name", "binding guid"},
+					[]string{"app1", "binding1-guid"},
+					[]string{"app2", "binding2-guid"},
+				))
+			})
+
+			It("says when there are no bound apps", func() {
+				runCommand("service1")
+
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"There are no bound apps for this service."}))
+			})
+
+			It("shows the plan history of the service instance", func() {
+				timestamp, err := time.Parse(time.RFC3339, "2015-05-01T10:00:00Z")
+				Expect(err).NotTo(HaveOccurred())
+				serviceSummaryRepo.GetServicePlanHistoryChanges = []models.ServicePlanChange{
+					{Timestamp: timestamp, ActorName: "admin", PlanGuid: "plan-guid", PlanName: "plan-name"},
+				}
+
+				runCommand("service1")
+
+				Expect(serviceSummaryRepo.GetServicePlanHistoryGuid).To(Equal("service1-guid"))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Plan history:"},
+					[]string{"time", "actor", "plan"},
+					[]string{timestamp.Local().Format("2006-01-02T15:04:05.00-0700"), "admin", "plan-name"},
+				))
+			})
+
+			It("warns and carries on when the plan history cannot be fetched", func() {
+				serviceSummaryRepo.GetServicePlanHistoryErr = errors.New("not authorized")
+
+				runCommand("service1")
+
+				Expect(ui.WarnOutputs).To(ContainSubstrings([]string{"Could not get the plan history", "not authorized"}))
+				Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"FAILED"}))
+				Expect(ui.Outputs).To(ContainSubstrings([]string{"Service instance:", "service1"}))
+			})
+
+			It("fails when the service instance summary cannot be fetched", func() {
+				serviceSummaryRepo.GetServiceInstanceSummaryErr = errors.New("summary error")
+
+				runCommand("service1")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"summary error"},
+				))
+			})
+
 			Context("when the guid flag is provided", func() {
 				It("shows only the service guid", func() {
 					runCommand("--guid", "service1")
@@ -114,6 +239,30 @@ var _ = Describe("service command", func() {
 					[]string{"Service: ", "user-provided"},
 				))
 			})
+
+			It("gets the summary of the user-provided instance", func() {
+				summary := models.ServiceInstance{}
+				summary.Organization = models.OrganizationFields{Name: "my-org"}
+				summary.Space = models.SpaceFields{Name: "my-space"}
+				summary.ServiceBindings = []models.ServiceBindingFields{{Guid: "binding1-guid", AppName: "app1"}}
+				serviceSummaryRepo.GetUserProvidedServiceInstanceSummaryInstance = summary
+
+				runCommand("service1")
+
+				Expect(serviceSummaryRepo.GetUserProvidedServiceInstanceSummaryGuid).To(Equal("service1-guid"))
+				Expect(serviceSummaryRepo.GetServiceInstanceSummaryGuid).To(BeEmpty())
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Org / Space: ", "my-org / my-space"},
+					[]string{"app1", "binding1-guid"},
+				))
+			})
+
+			It("does not look up the plan history", func() {
+				runCommand("service1")
+
+				Expect(serviceSummaryRepo.GetServicePlanHistoryGuid).To(BeEmpty())
+				Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"Plan history:"}))
+			})
 		})
 	})
 })
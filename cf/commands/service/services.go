@@ -7,6 +7,8 @@ import (
 	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
 	"github.com/codegangsta/cli"
@@ -30,7 +32,10 @@ func (cmd ListServices) Metadata() command_metadata.CommandMetadata {
 		Name:        "services",
 		ShortName:   "s",
 		Description: T("List all service instances in the target space"),
-		Usage:       "CF_NAME services",
+		Usage:       T("CF_NAME services [--tag TAG]"),
+		Flags: []cli.Flag{
+			flag_helpers.NewStringFlag("tag", T("Only list service instances with the given tag")),
+		},
 	}
 }
 
@@ -60,6 +65,10 @@ func (cmd ListServices) Run(c *cli.Context) {
 		return
 	}
 
+	if c.String("tag") != "" {
+		serviceInstances = filterInstancesByTag(serviceInstances, c.String("tag"))
+	}
+
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
@@ -68,7 +77,7 @@ func (cmd ListServices) Run(c *cli.Context) {
 		return
 	}
 
-	table := terminal.NewTable(cmd.ui, []string{T("name"), T("service"), T("plan"), T("bound apps")})
+	table := terminal.NewTable(cmd.ui, []string{T("name"), T("service"), T("plan"), T("bound apps"), T("tags"), T("last operation")})
 
 	for _, instance := range serviceInstances {
 		var serviceColumn string
@@ -84,8 +93,22 @@ func (cmd ListServices) Run(c *cli.Context) {
 			serviceColumn,
 			instance.ServicePlan.Name,
 			strings.Join(instance.ApplicationNames, ", "),
+			strings.Join(instance.Tags, ", "),
+			lastOperationStatus(instance.LastOperation),
 		)
 	}
 
 	table.Print()
 }
+
+func filterInstancesByTag(instances []models.ServiceInstance, tag string) (filtered []models.ServiceInstance) {
+	for _, instance := range instances {
+		for _, instanceTag := range instance.Tags {
+			if instanceTag == tag {
+				filtered = append(filtered, instance)
+				break
+			}
+		}
+	}
+	return
+}
@@ -90,6 +90,7 @@ var _ = Describe("services", func() {
 		serviceInstance2.ServicePlan = plan2
 		serviceInstance2.ApplicationNames = []string{"cli1"}
 		serviceInstance2.ServiceOffering = offering
+		serviceInstance2.LastOperation = models.LastOperationFields{Type: "create", State: "in progress"}
 
 		serviceInstance3 := models.ServiceInstance{}
 		serviceInstance3.Name = "my-service-provided-by-user"
@@ -105,12 +106,67 @@ var _ = Describe("services", func() {
 		Expect(ui.Outputs).To(ContainSubstrings(
 			[]string{"Getting services in org", "my-org", "my-space", "my-user"},
 			[]string{"OK"},
+			[]string{"name", "service", "plan", "bound apps", "last operation"},
 			[]string{"my-service-1", "cleardb", "spark", "cli1, cli2"},
-			[]string{"my-service-2", "cleardb", "spark-2", "cli1"},
+			[]string{"my-service-2", "cleardb", "spark-2", "cli1", "create in progress"},
 			[]string{"my-service-provided-by-user", "user-provided"},
 		))
 	})
 
+	Describe("tags", func() {
+		var serviceSummaryRepo *testapi.FakeServiceSummaryRepo
+
+		BeforeEach(func() {
+			mysql := models.ServiceInstance{}
+			mysql.Name = "my-mysql"
+			mysql.ServicePlan = models.ServicePlanFields{Guid: "spark-guid", Name: "spark"}
+			mysql.Tags = []string{"db", "mysql"}
+
+			cache := models.ServiceInstance{}
+			cache.Name = "my-cache"
+			cache.ServicePlan = models.ServicePlanFields{Guid: "spark-guid", Name: "spark"}
+			cache.Tags = []string{"cache"}
+
+			userProvided := models.ServiceInstance{}
+			userProvided.Name = "my-oracle"
+			userProvided.Tags = []string{"db"}
+
+			serviceSummaryRepo = &testapi.FakeServiceSummaryRepo{
+				GetSummariesInCurrentSpaceInstances: []models.ServiceInstance{mysql, cache, userProvided},
+			}
+		})
+
+		It("shows the tags of each service instance", func() {
+			cmd := NewListServices(ui, configRepo, serviceSummaryRepo)
+			testcmd.RunCommand(cmd, []string{}, requirementsFactory)
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"name", "tags"},
+				[]string{"my-mysql", "db, mysql"},
+				[]string{"my-cache", "cache"},
+				[]string{"my-oracle", "user-provided", "db"},
+			))
+		})
+
+		It("only lists service instances with the tag given with --tag", func() {
+			cmd := NewListServices(ui, configRepo, serviceSummaryRepo)
+			testcmd.RunCommand(cmd, []string{"--tag", "db"}, requirementsFactory)
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"my-mysql"},
+				[]string{"my-oracle"},
+			))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"my-cache"}))
+		})
+
+		It("says so when no service instance has the tag", func() {
+			cmd := NewListServices(ui, configRepo, serviceSummaryRepo)
+			testcmd.RunCommand(cmd, []string{"--tag", "queue"}, requirementsFactory)
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"No services found"}))
+		})
+	})
+
 	It("lists no services when none are found", func() {
 		serviceInstances := []models.ServiceInstance{}
 		serviceSummaryRepo := &testapi.FakeServiceSummaryRepo{
@@ -2,6 +2,7 @@ package service
 
 import (
 	"errors"
+	"time"
 
 	"github.com/cloudfoundry/cli/cf/actors/plan_builder"
 	"github.com/cloudfoundry/cli/cf/api"
@@ -9,6 +10,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/flag_helpers"
 	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/json"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
@@ -20,14 +22,19 @@ type UpdateService struct {
 	config      core_config.Reader
 	serviceRepo api.ServiceRepository
 	planBuilder plan_builder.PlanBuilder
+
+	PollInterval     time.Duration
+	OperationTimeout string
 }
 
 func NewUpdateService(ui terminal.UI, config core_config.Reader, serviceRepo api.ServiceRepository, planBuilder plan_builder.PlanBuilder) (cmd *UpdateService) {
 	return &UpdateService{
-		ui:          ui,
-		config:      config,
-		serviceRepo: serviceRepo,
-		planBuilder: planBuilder,
+		ui:               ui,
+		config:           config,
+		serviceRepo:      serviceRepo,
+		planBuilder:      planBuilder,
+		PollInterval:     DefaultServiceOperationPollInterval,
+		OperationTimeout: serviceOperationTimeoutFromEnv(),
 	}
 }
 
@@ -35,9 +42,24 @@ func (cmd *UpdateService) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "update-service",
 		Description: T("Update a service instance"),
-		Usage:       T("CF_NAME update-service SERVICE [-p NEW_PLAN]"),
+		Usage: T(`CF_NAME update-service SERVICE_INSTANCE [-p NEW_PLAN] [-c PARAMETERS_AS_JSON] [-t TAGS] [--wait]
+
+   Optionally provide service-specific configuration parameters in a valid JSON object in-line:
+   CF_NAME update-service SERVICE_INSTANCE -c '{"name":"value","name":"value"}'
+
+   Optionally provide a file containing service-specific configuration parameters in a valid JSON object. The path to the parameters file can be an absolute or relative path to a file:
+   CF_NAME update-service SERVICE_INSTANCE -c PATH_TO_FILE
+
+EXAMPLE:
+   CF_NAME update-service mydb -p gold
+   CF_NAME update-service mydb -c '{"ram_gb":4}'
+   CF_NAME update-service mydb -c ~/workspace/tmp/instance_config.json
+   CF_NAME update-service mydb -t "list, of, tags"`),
 		Flags: []cli.Flag{
 			flag_helpers.NewStringFlag("p", T("Change service plan for a service instance")),
+			flag_helpers.NewStringFlag("c", T("Valid JSON object containing service-specific configuration parameters, provided either in-line or in a file")),
+			flag_helpers.NewStringFlag("t", T("User provided tags, replacing any existing tags. An empty value removes all tags")),
+			cli.BoolFlag{Name: "wait", Usage: T("Wait for the service broker to finish the operation")},
 		},
 	}
 }
@@ -58,6 +80,12 @@ func (cmd *UpdateService) GetRequirements(requirementsFactory requirements.Facto
 func (cmd *UpdateService) Run(c *cli.Context) {
 	serviceInstanceName := c.Args()[0]
 
+	params, err := json.ParseJsonFromFileOrString(c.String("c"))
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
 	serviceInstance, err := cmd.serviceRepo.FindInstanceByName(serviceInstanceName)
 	if err != nil {
 		cmd.ui.Failed(err.Error())
@@ -66,17 +94,23 @@ func (cmd *UpdateService) Run(c *cli.Context) {
 
 	planName := c.String("p")
 
-	if planName != "" {
+	var tags []string
+	if c.IsSet("t") {
+		tags = parseTags(c.String("t"))
+	}
+
+	if planName != "" || params != nil || tags != nil {
 		cmd.ui.Say(T("Updating service instance {{.ServiceName}} as {{.UserName}}...",
 			map[string]interface{}{
 				"ServiceName": terminal.EntityNameColor(serviceInstanceName),
 				"UserName":    terminal.EntityNameColor(cmd.config.Username()),
 			}))
 
-		err := cmd.updateServiceWithPlan(serviceInstance, planName)
+		err := cmd.updateService(serviceInstance, planName, params, tags)
 		switch err.(type) {
 		case nil:
 			cmd.ui.Ok()
+			followServiceOperation(cmd.ui, cmd.serviceRepo, serviceInstanceName, c.Bool("wait"), cmd.PollInterval, cmd.OperationTimeout)
 		default:
 			cmd.ui.Failed(err.Error())
 		}
@@ -86,7 +120,11 @@ func (cmd *UpdateService) Run(c *cli.Context) {
 	}
 }
 
-func (cmd *UpdateService) updateServiceWithPlan(serviceInstance models.ServiceInstance, planName string) (err error) {
+func (cmd *UpdateService) updateService(serviceInstance models.ServiceInstance, planName string, params map[string]interface{}, tags []string) (err error) {
+	if planName == "" {
+		return cmd.serviceRepo.UpdateServiceInstance(serviceInstance.Guid, "", params, tags)
+	}
+
 	plans, err := cmd.planBuilder.GetPlansForServiceForOrg(serviceInstance.ServiceOffering.Guid, cmd.config.OrganizationFields().Name)
 	if err != nil {
 		return
@@ -94,7 +132,7 @@ func (cmd *UpdateService) updateServiceWithPlan(serviceInstance models.ServiceIn
 
 	for _, plan := range plans {
 		if plan.Name == planName {
-			err = cmd.serviceRepo.UpdateServiceInstance(serviceInstance.Guid, plan.Guid)
+			err = cmd.serviceRepo.UpdateServiceInstance(serviceInstance.Guid, plan.Guid, params, tags)
 			return
 		}
 	}
@@ -50,6 +50,7 @@ var _ = Describe("update-service command", func() {
 
 	var callUpdateService = func(args []string) bool {
 		cmd := NewUpdateService(ui, config, serviceRepo, planBuilder)
+		cmd.PollInterval = 0
 		return testcmd.RunCommand(cmd, args, requirementsFactory)
 	}
 
@@ -136,6 +137,118 @@ var _ = Describe("update-service command", func() {
 			Expect(serviceRepo.UpdateServiceInstanceArgs.PlanGuid).To(Equal("murkydb-flare-guid"))
 		})
 
+		It("updates the plan and parameters together", func() {
+			callUpdateService([]string{"-p", "flare", "-c", `{"ram_gb":4}`, "my-service-instance"})
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+			Expect(serviceRepo.UpdateServiceInstanceArgs.PlanGuid).To(Equal("murkydb-flare-guid"))
+			Expect(serviceRepo.UpdateServiceInstanceArgs.Params).To(Equal(map[string]interface{}{"ram_gb": float64(4)}))
+		})
+
+		It("updates only the parameters when no plan is given", func() {
+			callUpdateService([]string{"-c", `{"ram_gb":4}`, "my-service-instance"})
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Updating service", "my-service-instance", "my-user"},
+				[]string{"OK"},
+			))
+			Expect(planBuilder.GetPlansForServiceForOrgCallCount()).To(Equal(0))
+			Expect(serviceRepo.UpdateServiceInstanceArgs.InstanceGuid).To(Equal("my-service-instance-guid"))
+			Expect(serviceRepo.UpdateServiceInstanceArgs.PlanGuid).To(BeEmpty())
+			Expect(serviceRepo.UpdateServiceInstanceArgs.Params).To(Equal(map[string]interface{}{"ram_gb": float64(4)}))
+		})
+
+		It("replaces the tags when -t is given", func() {
+			callUpdateService([]string{"-t", "db, mysql", "my-service-instance"})
+
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"OK"}))
+			Expect(serviceRepo.UpdateServiceInstanceArgs.InstanceGuid).To(Equal("my-service-instance-guid"))
+			Expect(serviceRepo.UpdateServiceInstanceArgs.Tags).To(Equal([]string{"db", "mysql"}))
+		})
+
+		It("removes all tags when -t is empty", func() {
+			callUpdateService([]string{"-t", "", "my-service-instance"})
+
+			Expect(serviceRepo.UpdateServiceInstanceArgs.InstanceGuid).To(Equal("my-service-instance-guid"))
+			Expect(serviceRepo.UpdateServiceInstanceArgs.Tags).To(Equal([]string{}))
+		})
+
+		It("leaves the tags unchanged when -t is not given", func() {
+			callUpdateService([]string{"-p", "flare", "my-service-instance"})
+
+			Expect(serviceRepo.UpdateServiceInstanceArgs.Tags).To(BeNil())
+		})
+
+		It("fails when the parameters are not a valid JSON object", func() {
+			callUpdateService([]string{"-c", `not-json`, "my-service-instance"})
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"FAILED"},
+				[]string{"Invalid configuration provided for -c flag"},
+			))
+			Expect(serviceRepo.UpdateServiceInstanceArgs.InstanceGuid).To(BeEmpty())
+		})
+
+		Context("when the broker updates the instance asynchronously", func() {
+			var inProgress, finished models.ServiceInstance
+
+			BeforeEach(func() {
+				inProgress = serviceRepo.FindInstanceByNameServiceInstance
+				inProgress.LastOperation = models.LastOperationFields{Type: "update", State: "in progress"}
+				finished = serviceRepo.FindInstanceByNameServiceInstance
+				finished.LastOperation = models.LastOperationFields{Type: "update", State: "succeeded"}
+			})
+
+			It("tells the user the update is in progress", func() {
+				serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{inProgress, inProgress}
+
+				callUpdateService([]string{"-p", "flare", "my-service-instance"})
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"OK"},
+					[]string{"Update in progress"},
+				))
+			})
+
+			It("waits until the update succeeds when --wait is given", func() {
+				serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{inProgress, inProgress, inProgress, finished}
+
+				callUpdateService([]string{"-p", "flare", "--wait", "my-service-instance"})
+
+				Expect(serviceRepo.FindInstanceByNameCallCount).To(Equal(4))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Waiting for the operation on service instance", "my-service-instance"},
+					[]string{"OK"},
+				))
+				Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"FAILED"}))
+			})
+
+			It("fails with the broker's description when the update fails", func() {
+				failed := inProgress
+				failed.LastOperation = models.LastOperationFields{Type: "update", State: "failed", Description: "Cluster resize failed"}
+				serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{inProgress, failed}
+
+				callUpdateService([]string{"-p", "flare", "--wait", "my-service-instance"})
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Update failed: Cluster resize failed"},
+				))
+			})
+
+			It("fails when the operation does not finish before the timeout", func() {
+				serviceRepo.FindInstanceByNameSequence = []models.ServiceInstance{inProgress, inProgress}
+				cmd := NewUpdateService(ui, config, serviceRepo, planBuilder)
+				cmd.OperationTimeout = "0"
+				testcmd.RunCommand(cmd, []string{"-p", "flare", "--wait", "my-service-instance"}, requirementsFactory)
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Timed out waiting for the operation on service instance my-service-instance to complete"},
+				))
+			})
+		})
+
 		Context("when there is an err finding the instance", func() {
 			It("returns an error", func() {
 				serviceRepo.FindInstanceByNameErr = true
@@ -0,0 +1,158 @@
+package serviceaccess
+
+import (
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/actors"
+	"github.com/cloudfoundry/cli/cf/api/authentication"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ApplyServiceAccess struct {
+	ui             terminal.UI
+	config         core_config.Reader
+	serviceActor   actors.ServiceActor
+	planActor      actors.ServicePlanActor
+	tokenRefresher authentication.TokenRefresher
+}
+
+func NewApplyServiceAccess(ui terminal.UI, config core_config.Reader, serviceActor actors.ServiceActor, planActor actors.ServicePlanActor, tokenRefresher authentication.TokenRefresher) (cmd *ApplyServiceAccess) {
+	return &ApplyServiceAccess{
+		ui:             ui,
+		config:         config,
+		serviceActor:   serviceActor,
+		planActor:      planActor,
+		tokenRefresher: tokenRefresher,
+	}
+}
+
+func (cmd *ApplyServiceAccess) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "apply-service-access",
+		Description: T("Converge service plan access with a policy file"),
+		Usage: T(`CF_NAME apply-service-access POLICY_FILE [--dry-run]
+
+   The policy file lists, for each broker and service, which plans are public and which are limited to a list of orgs.
+   Plans that are neither are made private. Plans the file does not mention are left unchanged.
+
+EXAMPLE POLICY FILE:
+   brokers:
+   - name: my-broker
+     services:
+     - name: mysql
+       plans:
+       - name: small
+         public: true
+       - name: large
+         orgs:
+         - org-1
+         - org-2
+       - name: beta`),
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "dry-run", Usage: T("Show the changes without making them")},
+		},
+	}
+}
+
+func (cmd *ApplyServiceAccess) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ApplyServiceAccess) Run(c *cli.Context) {
+	_, err := cmd.tokenRefresher.RefreshAuthToken()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	policyFile := c.Args()[0]
+	file, err := os.Open(policyFile)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+	defer file.Close()
+
+	policy, err := actors.ParseServiceAccessPolicy(file)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Say(T("Applying service access policy {{.PolicyFile}} as {{.Username}}...",
+		map[string]interface{}{
+			"PolicyFile": terminal.EntityNameColor(policyFile),
+			"Username":   terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	brokers, err := cmd.serviceActor.FilterBrokers("", "", "")
+	if err != nil {
+		cmd.ui.Failed(T("Failed fetching service brokers.\n{{.Error}}", map[string]interface{}{"Error": err}))
+		return
+	}
+
+	changes, err := policy.Diff(brokers)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if len(changes) == 0 {
+		cmd.ui.Ok()
+		cmd.ui.Say(T("Service access already matches the policy"))
+		return
+	}
+
+	cmd.ui.Say("")
+	table := terminal.NewTable(cmd.ui, []string{T("broker"), T("service"), T("plan"), T("change")})
+	for _, change := range changes {
+		table.Add(change.BrokerName, change.ServiceName, change.PlanName, describeServiceAccessChange(change))
+	}
+	table.Print()
+	cmd.ui.Say("")
+
+	if c.Bool("dry-run") {
+		cmd.ui.Say(T("{{.ChangeCount}} changes would be made", map[string]interface{}{"ChangeCount": len(changes)}))
+		return
+	}
+
+	for _, change := range changes {
+		err = actors.ApplyServiceAccessChange(cmd.planActor, change)
+		if err != nil {
+			cmd.ui.Failed(T("Could not change access to plan {{.PlanName}} of service {{.ServiceName}}: {{.Error}}",
+				map[string]interface{}{
+					"PlanName":    change.PlanName,
+					"ServiceName": change.ServiceName,
+					"Error":       err.Error(),
+				}))
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+}
+
+func describeServiceAccessChange(change actors.ServiceAccessChange) string {
+	switch change.Type {
+	case actors.MakePlanPublic:
+		return T("make public")
+	case actors.MakePlanPrivate:
+		return T("make private")
+	case actors.EnablePlanForOrg:
+		return T("enable for org {{.OrgName}}", map[string]interface{}{"OrgName": change.OrgName})
+	case actors.DisablePlanForOrg:
+		return T("disable for org {{.OrgName}}", map[string]interface{}{"OrgName": change.OrgName})
+	}
+	return ""
+}
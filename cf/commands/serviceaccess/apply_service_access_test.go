@@ -0,0 +1,196 @@
+package serviceaccess_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	testactor "github.com/cloudfoundry/cli/cf/actors/fakes"
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/serviceaccess"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("apply-service-access command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		serviceActor        *testactor.FakeServiceActor
+		planActor           *testactor.FakeServicePlanActor
+		requirementsFactory *testreq.FakeReqFactory
+		tokenRefresher      *testapi.FakeAuthenticationRepository
+		policyFile          *os.File
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		serviceActor = &testactor.FakeServiceActor{}
+		planActor = &testactor.FakeServicePlanActor{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		tokenRefresher = &testapi.FakeAuthenticationRepository{}
+
+		serviceActor.FilterBrokersReturns([]models.ServiceBroker{
+			{
+				Name: "my-broker",
+				Services: []models.ServiceOffering{
+					{
+						ServiceOfferingFields: models.ServiceOfferingFields{Label: "mysql", Guid: "mysql-guid"},
+						Plans: []models.ServicePlanFields{
+							{Name: "small", Guid: "small-guid", Public: true},
+							{Name: "large", Guid: "large-guid", OrgNames: []string{"org-2"}},
+							{Name: "beta", Guid: "beta-guid"},
+						},
+					},
+				},
+			},
+		}, nil)
+
+		var err error
+		policyFile, err = ioutil.TempFile("", "service-access-policy")
+		Expect(err).NotTo(HaveOccurred())
+		_, err = policyFile.WriteString(`
+brokers:
+- name: my-broker
+  services:
+  - name: mysql
+    plans:
+    - name: small
+      public: true
+    - name: large
+      orgs:
+      - org-1
+    - name: beta
+      public: true
+`)
+		Expect(err).NotTo(HaveOccurred())
+		policyFile.Close()
+	})
+
+	AfterEach(func() {
+		os.Remove(policyFile.Name())
+	})
+
+	runCommand := func(args ...string) bool {
+		cmd := NewApplyServiceAccess(ui, testconfig.NewRepositoryWithDefaults(), serviceActor, planActor, tokenRefresher)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("requires the user to be logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand(policyFile.Name())).ToNot(HavePassedRequirements())
+		})
+
+		It("fails with usage without a policy file", func() {
+			Expect(runCommand()).To(BeFalse())
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
+	It("refreshes the auth token", func() {
+		runCommand(policyFile.Name())
+		Expect(tokenRefresher.RefreshTokenCalled).To(BeTrue())
+	})
+
+	It("shows the changes and applies them", func() {
+		runCommand(policyFile.Name())
+
+		brokerName, serviceName, orgName := serviceActor.FilterBrokersArgsForCall(0)
+		Expect(brokerName).To(BeEmpty())
+		Expect(serviceName).To(BeEmpty())
+		Expect(orgName).To(BeEmpty())
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Applying service access policy", policyFile.Name(), "my-user"},
+			[]string{"broker", "service", "plan", "change"},
+			[]string{"my-broker", "mysql", "large", "enable for org org-1"},
+			[]string{"my-broker", "mysql", "large", "disable for org org-2"},
+			[]string{"my-broker", "mysql", "beta", "make public"},
+			[]string{"OK"},
+		))
+
+		Expect(planActor.UpdatePlanOrgVisibilityCallCount()).To(Equal(2))
+		planGuid, orgName, visible := planActor.UpdatePlanOrgVisibilityArgsForCall(0)
+		Expect([]interface{}{planGuid, orgName, visible}).To(Equal([]interface{}{"large-guid", "org-1", true}))
+		planGuid, orgName, visible = planActor.UpdatePlanOrgVisibilityArgsForCall(1)
+		Expect([]interface{}{planGuid, orgName, visible}).To(Equal([]interface{}{"large-guid", "org-2", false}))
+
+		Expect(planActor.UpdatePlanVisibilityCallCount()).To(Equal(1))
+		serviceGuid, plan, public := planActor.UpdatePlanVisibilityArgsForCall(0)
+		Expect([]interface{}{serviceGuid, plan.Guid, public}).To(Equal([]interface{}{"mysql-guid", "beta-guid", true}))
+	})
+
+	It("only shows the changes with --dry-run", func() {
+		runCommand("--dry-run", policyFile.Name())
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"my-broker", "mysql", "beta", "make public"},
+			[]string{"3 changes would be made"},
+		))
+		Expect(planActor.UpdatePlanOrgVisibilityCallCount()).To(Equal(0))
+		Expect(planActor.UpdatePlanVisibilityCallCount()).To(Equal(0))
+	})
+
+	It("says so when access already matches the policy", func() {
+		ioutil.WriteFile(policyFile.Name(), []byte(`
+brokers:
+- name: my-broker
+  services:
+  - name: mysql
+    plans:
+    - name: small
+      public: true
+`), 0644)
+
+		runCommand(policyFile.Name())
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"OK"},
+			[]string{"Service access already matches the policy"},
+		))
+	})
+
+	It("fails when the policy file cannot be read", func() {
+		runCommand("/does/not/exist.yml")
+
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+		Expect(serviceActor.FilterBrokersCallCount()).To(Equal(0))
+	})
+
+	It("fails when the policy refers to an unknown plan", func() {
+		ioutil.WriteFile(policyFile.Name(), []byte(`
+brokers:
+- name: my-broker
+  services:
+  - name: mysql
+    plans:
+    - name: huge
+      public: true
+`), 0644)
+
+		runCommand(policyFile.Name())
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Plan huge not found for service mysql"},
+		))
+	})
+
+	It("fails when a change cannot be made", func() {
+		planActor.UpdatePlanOrgVisibilityReturns(errors.New("org not found"))
+
+		runCommand(policyFile.Name())
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Could not change access to plan large of service mysql: org not found"},
+		))
+	})
+})
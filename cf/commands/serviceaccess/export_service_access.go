@@ -0,0 +1,97 @@
+package serviceaccess
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cloudfoundry/cli/cf/actors"
+	"github.com/cloudfoundry/cli/cf/api/authentication"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ExportServiceAccess struct {
+	ui             terminal.UI
+	config         core_config.Reader
+	actor          actors.ServiceActor
+	tokenRefresher authentication.TokenRefresher
+}
+
+func NewExportServiceAccess(ui terminal.UI, config core_config.Reader, actor actors.ServiceActor, tokenRefresher authentication.TokenRefresher) (cmd *ExportServiceAccess) {
+	return &ExportServiceAccess{
+		ui:             ui,
+		config:         config,
+		actor:          actor,
+		tokenRefresher: tokenRefresher,
+	}
+}
+
+func (cmd *ExportServiceAccess) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "export-service-access",
+		Description: T("Write the current service plan access settings as a policy file"),
+		Usage: T(`CF_NAME export-service-access [POLICY_FILE] [-b BROKER]
+
+   Without POLICY_FILE the policy is printed. The result can be used with apply-service-access.`),
+		Flags: []cli.Flag{
+			flag_helpers.NewStringFlag("b", T("only export the plans of a particular broker")),
+		},
+	}
+}
+
+func (cmd *ExportServiceAccess) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) > 1 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	reqs = []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+	}
+	return
+}
+
+func (cmd *ExportServiceAccess) Run(c *cli.Context) {
+	_, err := cmd.tokenRefresher.RefreshAuthToken()
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+	}
+
+	brokers, err := cmd.actor.FilterBrokers(c.String("b"), "", "")
+	if err != nil {
+		cmd.ui.Failed(T("Failed fetching service brokers.\n{{.Error}}", map[string]interface{}{"Error": err}))
+		return
+	}
+
+	buffer := new(bytes.Buffer)
+	err = actors.NewServiceAccessPolicy(brokers).Encode(buffer)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if len(c.Args()) == 0 {
+		cmd.ui.Say(strings.TrimRight(buffer.String(), "\n"))
+		return
+	}
+
+	policyFile := c.Args()[0]
+	cmd.ui.Say(T("Exporting service access to {{.PolicyFile}} as {{.Username}}...",
+		map[string]interface{}{
+			"PolicyFile": terminal.EntityNameColor(policyFile),
+			"Username":   terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	err = ioutil.WriteFile(policyFile, buffer.Bytes(), 0644)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+}
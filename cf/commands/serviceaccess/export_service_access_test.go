@@ -0,0 +1,109 @@
+package serviceaccess_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	testactor "github.com/cloudfoundry/cli/cf/actors/fakes"
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/serviceaccess"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("export-service-access command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		actor               *testactor.FakeServiceActor
+		requirementsFactory *testreq.FakeReqFactory
+		tokenRefresher      *testapi.FakeAuthenticationRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		actor = &testactor.FakeServiceActor{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true}
+		tokenRefresher = &testapi.FakeAuthenticationRepository{}
+
+		actor.FilterBrokersReturns([]models.ServiceBroker{
+			{
+				Name: "my-broker",
+				Services: []models.ServiceOffering{
+					{
+						ServiceOfferingFields: models.ServiceOfferingFields{Label: "mysql"},
+						Plans: []models.ServicePlanFields{
+							{Name: "small", Public: true},
+							{Name: "large", OrgNames: []string{"org-2", "org-1"}},
+						},
+					},
+				},
+			},
+		}, nil)
+	})
+
+	runCommand := func(args ...string) bool {
+		cmd := NewExportServiceAccess(ui, testconfig.NewRepositoryWithDefaults(), actor, tokenRefresher)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("requires the user to be logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(runCommand()).ToNot(HavePassedRequirements())
+		})
+
+		It("fails with usage when given more than one argument", func() {
+			Expect(runCommand("a", "b")).To(BeFalse())
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+	})
+
+	It("prints the policy when no file is given", func() {
+		runCommand()
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"brokers:"},
+			[]string{"- name: my-broker"},
+			[]string{"- name: mysql"},
+			[]string{"- name: small"},
+			[]string{"public: true"},
+			[]string{"- name: large"},
+			[]string{"orgs:"},
+			[]string{"- org-1"},
+			[]string{"- org-2"},
+		))
+	})
+
+	It("only exports the given broker with -b", func() {
+		runCommand("-b", "my-broker")
+
+		brokerName, _, _ := actor.FilterBrokersArgsForCall(0)
+		Expect(brokerName).To(Equal("my-broker"))
+	})
+
+	It("writes the policy to a file", func() {
+		dir, err := ioutil.TempDir("", "export-service-access")
+		Expect(err).NotTo(HaveOccurred())
+		defer os.RemoveAll(dir)
+		policyFile := filepath.Join(dir, "policy.yml")
+
+		runCommand(policyFile)
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Exporting service access to", policyFile, "my-user"},
+			[]string{"OK"},
+		))
+		contents, err := ioutil.ReadFile(policyFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(contents)).To(ContainSubstring("- name: my-broker"))
+		Expect(string(contents)).To(ContainSubstring("- org-1"))
+	})
+})
@@ -0,0 +1,172 @@
+package servicebroker
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/cloudfoundry/cli/cf/api/service_broker_client"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/models"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type CheckBroker struct {
+	ui           terminal.UI
+	config       core_config.Reader
+	brokerClient service_broker_client.ServiceBrokerClient
+}
+
+func NewCheckBroker(ui terminal.UI, config core_config.Reader, brokerClient service_broker_client.ServiceBrokerClient) (cmd *CheckBroker) {
+	return &CheckBroker{
+		ui:           ui,
+		config:       config,
+		brokerClient: brokerClient,
+	}
+}
+
+func (cmd *CheckBroker) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "check-broker",
+		Description: T("Check that a service broker's catalog is valid before registering it"),
+		Usage: T(`CF_NAME check-broker URL -u USERNAME -p PASSWORD [--exercise]
+
+   With --exercise, the first plan of each service is provisioned, bound, unbound and deprovisioned using throwaway ids.
+
+EXAMPLE:
+   CF_NAME check-broker http://localhost:9292 -u admin -p secret
+   CF_NAME check-broker http://localhost:9292 -u admin -p secret --exercise`),
+		Flags: []cli.Flag{
+			flag_helpers.NewStringFlag("u", T("Username for the broker's basic auth")),
+			flag_helpers.NewStringFlag("p", T("Password for the broker's basic auth")),
+			cli.BoolFlag{Name: "exercise", Usage: T("Also provision, bind, unbind and deprovision each service against the broker")},
+		},
+	}
+}
+
+func (cmd *CheckBroker) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 1 || c.String("u") == "" || c.String("p") == "" {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	reqs = []requirements.Requirement{}
+	return
+}
+
+func (cmd *CheckBroker) Run(c *cli.Context) {
+	broker := models.ServiceBroker{
+		Url:      c.Args()[0],
+		Username: c.String("u"),
+		Password: c.String("p"),
+	}
+
+	cmd.ui.Say(T("Checking service broker at {{.URL}}...",
+		map[string]interface{}{"URL": terminal.EntityNameColor(broker.Url)}))
+
+	catalog, err := cmd.brokerClient.GetCatalog(broker)
+	if err != nil {
+		cmd.ui.Failed(T("Could not fetch the catalog:\n{{.Error}}", map[string]interface{}{"Error": err.Error()}))
+		return
+	}
+
+	planCount := 0
+	for _, service := range catalog.Services {
+		planCount += len(service.Plans)
+	}
+	cmd.ui.Say(T("Catalog has {{.ServiceCount}} services with {{.PlanCount}} plans",
+		map[string]interface{}{"ServiceCount": len(catalog.Services), "PlanCount": planCount}))
+
+	problems := catalog.Validate()
+	if len(problems) > 0 {
+		cmd.ui.Say("")
+		for _, problem := range problems {
+			cmd.ui.Say("  " + problem)
+		}
+		cmd.ui.Say("")
+		cmd.ui.Failed(T("The catalog has {{.ProblemCount}} problems", map[string]interface{}{"ProblemCount": len(problems)}))
+		return
+	}
+
+	if c.Bool("exercise") {
+		if !cmd.exercise(broker, catalog) {
+			cmd.ui.Failed(T("The broker did not complete every step"))
+			return
+		}
+	}
+
+	cmd.ui.Ok()
+}
+
+// exercise runs each service's first plan through its lifecycle and reports
+// the result of every step. A provisioned instance is always deprovisioned.
+func (cmd *CheckBroker) exercise(broker models.ServiceBroker, catalog service_broker_client.Catalog) bool {
+	orgGuid := newThrowawayId()
+	spaceGuid := newThrowawayId()
+	appGuid := newThrowawayId()
+
+	succeeded := true
+	table := terminal.NewTable(cmd.ui, []string{T("service"), T("plan"), T("step"), T("result")})
+	report := func(service service_broker_client.CatalogService, plan service_broker_client.CatalogPlan, step string, err error) bool {
+		result := T("ok")
+		if err != nil {
+			result = T("failed: {{.Error}}", map[string]interface{}{"Error": err.Error()})
+			succeeded = false
+		}
+		table.Add(service.Name, plan.Name, step, result)
+		return err == nil
+	}
+
+	for _, service := range catalog.Services {
+		plan := service.Plans[0]
+		instanceId := newThrowawayId()
+
+		cmd.ui.Say(T("Exercising plan {{.PlanName}} of service {{.ServiceName}}...",
+			map[string]interface{}{
+				"PlanName":    terminal.EntityNameColor(plan.Name),
+				"ServiceName": terminal.EntityNameColor(service.Name),
+			}))
+
+		err := cmd.brokerClient.Provision(broker, instanceId, service_broker_client.ProvisionRequest{
+			ServiceId:        service.Id,
+			PlanId:           plan.Id,
+			OrganizationGuid: orgGuid,
+			SpaceGuid:        spaceGuid,
+		})
+		if !report(service, plan, T("provision"), err) {
+			continue
+		}
+
+		if *service.Bindable {
+			bindingId := newThrowawayId()
+			err = cmd.brokerClient.Bind(broker, instanceId, bindingId, service_broker_client.BindRequest{
+				ServiceId: service.Id,
+				PlanId:    plan.Id,
+				AppGuid:   appGuid,
+			})
+			if report(service, plan, T("bind"), err) {
+				err = cmd.brokerClient.Unbind(broker, instanceId, bindingId, service.Id, plan.Id)
+				report(service, plan, T("unbind"), err)
+			}
+		}
+
+		err = cmd.brokerClient.Deprovision(broker, instanceId, service.Id, plan.Id)
+		report(service, plan, T("deprovision"), err)
+	}
+
+	cmd.ui.Say("")
+	table.Print()
+	cmd.ui.Say("")
+	return succeeded
+}
+
+func newThrowawayId() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,186 @@
+package servicebroker_test
+
+import (
+	"errors"
+
+	"github.com/cloudfoundry/cli/cf/api/service_broker_client"
+	"github.com/cloudfoundry/cli/cf/api/service_broker_client/fakes"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/commands/servicebroker"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("check-broker command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		requirementsFactory *testreq.FakeReqFactory
+		configRepo          core_config.ReadWriter
+		brokerClient        *fakes.FakeServiceBrokerClient
+		bindable            bool
+	)
+
+	BeforeEach(func() {
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		ui = &testterm.FakeUI{}
+		requirementsFactory = &testreq.FakeReqFactory{}
+		brokerClient = &fakes.FakeServiceBrokerClient{}
+
+		bindable = true
+		brokerClient.GetCatalogReturns(service_broker_client.Catalog{
+			Services: []service_broker_client.CatalogService{
+				{
+					Id:          "service-id",
+					Name:        "mysql",
+					Description: "A MySQL database",
+					Bindable:    &bindable,
+					Plans: []service_broker_client.CatalogPlan{
+						{Id: "small-id", Name: "small", Description: "small"},
+						{Id: "large-id", Name: "large", Description: "large"},
+					},
+				},
+			},
+		}, nil)
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCommand(NewCheckBroker(ui, configRepo, brokerClient), args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage without a url", func() {
+			runCommand("-u", "admin", "-p", "secret")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails with usage without credentials", func() {
+			runCommand("http://broker.example.com")
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("does not require a login", func() {
+			Expect(runCommand("-u", "admin", "-p", "secret", "http://broker.example.com")).To(BeTrue())
+		})
+	})
+
+	It("fetches and validates the catalog", func() {
+		runCommand("-u", "admin", "-p", "secret", "http://broker.example.com")
+
+		Expect(brokerClient.GetCatalogArgsForCall(0)).To(Equal(models.ServiceBroker{
+			Url:      "http://broker.example.com",
+			Username: "admin",
+			Password: "secret",
+		}))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Checking service broker at", "http://broker.example.com"},
+			[]string{"Catalog has 1 services with 2 plans"},
+			[]string{"OK"},
+		))
+		Expect(brokerClient.ProvisionCallCount()).To(Equal(0))
+	})
+
+	It("fails when the catalog cannot be fetched", func() {
+		brokerClient.GetCatalogReturns(service_broker_client.Catalog{}, errors.New("connection refused"))
+
+		runCommand("-u", "admin", "-p", "secret", "http://broker.example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"connection refused"},
+		))
+	})
+
+	It("lists the problems with an invalid catalog", func() {
+		brokerClient.GetCatalogReturns(service_broker_client.Catalog{
+			Services: []service_broker_client.CatalogService{
+				{Id: "service-id", Name: "mysql", Description: "A MySQL database", Bindable: &bindable},
+			},
+		}, nil)
+
+		runCommand("-u", "admin", "-p", "secret", "--exercise", "http://broker.example.com")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Service mysql does not have any plans"},
+			[]string{"FAILED"},
+			[]string{"The catalog has 1 problems"},
+		))
+		Expect(brokerClient.ProvisionCallCount()).To(Equal(0))
+	})
+
+	Context("with --exercise", func() {
+		It("provisions, binds, unbinds and deprovisions the first plan of each service", func() {
+			runCommand("-u", "admin", "-p", "secret", "--exercise", "http://broker.example.com")
+
+			Expect(brokerClient.ProvisionCallCount()).To(Equal(1))
+			_, instanceId, provisionRequest := brokerClient.ProvisionArgsForCall(0)
+			Expect(instanceId).NotTo(BeEmpty())
+			Expect(provisionRequest.ServiceId).To(Equal("service-id"))
+			Expect(provisionRequest.PlanId).To(Equal("small-id"))
+
+			_, boundInstanceId, bindingId, bindRequest := brokerClient.BindArgsForCall(0)
+			Expect(boundInstanceId).To(Equal(instanceId))
+			Expect(bindRequest.PlanId).To(Equal("small-id"))
+
+			_, unboundInstanceId, unboundBindingId, _, _ := brokerClient.UnbindArgsForCall(0)
+			Expect(unboundInstanceId).To(Equal(instanceId))
+			Expect(unboundBindingId).To(Equal(bindingId))
+
+			_, deprovisionedInstanceId, serviceId, planId := brokerClient.DeprovisionArgsForCall(0)
+			Expect(deprovisionedInstanceId).To(Equal(instanceId))
+			Expect(serviceId).To(Equal("service-id"))
+			Expect(planId).To(Equal("small-id"))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"service", "plan", "step", "result"},
+				[]string{"mysql", "small", "provision", "ok"},
+				[]string{"mysql", "small", "bind", "ok"},
+				[]string{"mysql", "small", "unbind", "ok"},
+				[]string{"mysql", "small", "deprovision", "ok"},
+				[]string{"OK"},
+			))
+		})
+
+		It("skips binding services that are not bindable", func() {
+			bindable = false
+
+			runCommand("-u", "admin", "-p", "secret", "--exercise", "http://broker.example.com")
+
+			Expect(brokerClient.BindCallCount()).To(Equal(0))
+			Expect(brokerClient.DeprovisionCallCount()).To(Equal(1))
+		})
+
+		It("still deprovisions when binding fails and reports the failure", func() {
+			brokerClient.BindReturns(errors.New("bind exploded"))
+
+			runCommand("-u", "admin", "-p", "secret", "--exercise", "http://broker.example.com")
+
+			Expect(brokerClient.UnbindCallCount()).To(Equal(0))
+			Expect(brokerClient.DeprovisionCallCount()).To(Equal(1))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"mysql", "small", "bind", "failed: bind exploded"},
+				[]string{"FAILED"},
+				[]string{"The broker did not complete every step"},
+			))
+		})
+
+		It("does not go on after provisioning fails", func() {
+			brokerClient.ProvisionReturns(errors.New("no capacity"))
+
+			runCommand("-u", "admin", "-p", "secret", "--exercise", "http://broker.example.com")
+
+			Expect(brokerClient.BindCallCount()).To(Equal(0))
+			Expect(brokerClient.DeprovisionCallCount()).To(Equal(0))
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"mysql", "small", "provision", "failed: no capacity"},
+				[]string{"FAILED"},
+			))
+		})
+	})
+})
@@ -27,7 +27,12 @@ func (cmd CreateServiceBroker) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "create-service-broker",
 		Description: T("Create a service broker"),
-		Usage:       T("CF_NAME create-service-broker SERVICE_BROKER USERNAME PASSWORD URL"),
+		Usage: T(`CF_NAME create-service-broker SERVICE_BROKER USERNAME PASSWORD URL [--space-scoped]
+
+   A space-scoped broker can be registered by space developers. Its plans are only visible in the targeted space.`),
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "space-scoped", Usage: T("Make the broker's service plans only visible within the targeted space")},
+		},
 	}
 }
 
@@ -38,6 +43,9 @@ func (cmd CreateServiceBroker) GetRequirements(requirementsFactory requirements.
 	}
 
 	reqs = append(reqs, requirementsFactory.NewLoginRequirement())
+	if c.Bool("space-scoped") {
+		reqs = append(reqs, requirementsFactory.NewTargetedSpaceRequirement())
+	}
 
 	return
 }
@@ -48,12 +56,24 @@ func (cmd CreateServiceBroker) Run(c *cli.Context) {
 	password := c.Args()[2]
 	url := c.Args()[3]
 
-	cmd.ui.Say(T("Creating service broker {{.Name}} as {{.Username}}...",
-		map[string]interface{}{
-			"Name":     terminal.EntityNameColor(name),
-			"Username": terminal.EntityNameColor(cmd.config.Username())}))
+	var apiErr error
+	if c.Bool("space-scoped") {
+		cmd.ui.Say(T("Creating service broker {{.Name}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"Name":      terminal.EntityNameColor(name),
+				"OrgName":   terminal.EntityNameColor(cmd.config.OrganizationFields().Name),
+				"SpaceName": terminal.EntityNameColor(cmd.config.SpaceFields().Name),
+				"Username":  terminal.EntityNameColor(cmd.config.Username())}))
 
-	apiErr := cmd.serviceBrokerRepo.Create(name, url, username, password)
+		apiErr = cmd.serviceBrokerRepo.CreateSpaceScoped(name, url, username, password, cmd.config.SpaceFields().Guid)
+	} else {
+		cmd.ui.Say(T("Creating service broker {{.Name}} as {{.Username}}...",
+			map[string]interface{}{
+				"Name":     terminal.EntityNameColor(name),
+				"Username": terminal.EntityNameColor(cmd.config.Username())}))
+
+		apiErr = cmd.serviceBrokerRepo.Create(name, url, username, password)
+	}
 	if apiErr != nil {
 		cmd.ui.Failed(apiErr.Error())
 		return
@@ -63,6 +63,27 @@ var _ = Describe("create-service-broker command", func() {
 			Expect(serviceBrokerRepo.CreateUrl).To(Equal("http://example.com"))
 			Expect(serviceBrokerRepo.CreateUsername).To(Equal("my-username"))
 			Expect(serviceBrokerRepo.CreatePassword).To(Equal("my-password"))
+			Expect(serviceBrokerRepo.CreateSpaceGuid).To(BeEmpty())
+		})
+
+		Context("with --space-scoped", func() {
+			It("requires a targeted space", func() {
+				Expect(runCommand("--space-scoped", "my-broker", "my-username", "my-password", "http://example.com")).To(BeFalse())
+			})
+
+			It("creates a broker scoped to the targeted space", func() {
+				requirementsFactory.TargetedSpaceSuccess = true
+				runCommand("--space-scoped", "my-broker", "my-username", "my-password", "http://example.com")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Creating service broker", "my-broker", "my-org", "my-space", "my-user"},
+					[]string{"OK"},
+				))
+
+				Expect(serviceBrokerRepo.CreateName).To(Equal("my-broker"))
+				Expect(serviceBrokerRepo.CreateUrl).To(Equal("http://example.com"))
+				Expect(serviceBrokerRepo.CreateSpaceGuid).To(Equal(configRepo.SpaceFields().Guid))
+			})
 		})
 	})
 })
@@ -2,6 +2,7 @@ package servicebroker
 
 import (
 	"github.com/cloudfoundry/cli/cf/api"
+	"github.com/cloudfoundry/cli/cf/api/spaces"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	. "github.com/cloudfoundry/cli/cf/i18n"
@@ -12,15 +13,17 @@ import (
 )
 
 type ListServiceBrokers struct {
-	ui     terminal.UI
-	config core_config.Reader
-	repo   api.ServiceBrokerRepository
+	ui        terminal.UI
+	config    core_config.Reader
+	repo      api.ServiceBrokerRepository
+	spaceRepo spaces.SpaceRepository
 }
 
-func NewListServiceBrokers(ui terminal.UI, config core_config.Reader, repo api.ServiceBrokerRepository) (cmd ListServiceBrokers) {
+func NewListServiceBrokers(ui terminal.UI, config core_config.Reader, repo api.ServiceBrokerRepository, spaceRepo spaces.SpaceRepository) (cmd ListServiceBrokers) {
 	cmd.ui = ui
 	cmd.config = config
 	cmd.repo = repo
+	cmd.spaceRepo = spaceRepo
 	return
 }
 
@@ -46,10 +49,11 @@ func (cmd ListServiceBrokers) Run(c *cli.Context) {
 			"Username": terminal.EntityNameColor(cmd.config.Username()),
 		}))
 
-	table := cmd.ui.Table([]string{T("name"), T("url")})
+	table := cmd.ui.Table([]string{T("name"), T("url"), T("scope")})
 	foundBrokers := false
+	spaceNames := map[string]string{}
 	apiErr := cmd.repo.ListServiceBrokers(func(serviceBroker models.ServiceBroker) bool {
-		table.Add(serviceBroker.Name, serviceBroker.Url)
+		table.Add(serviceBroker.Name, serviceBroker.Url, cmd.brokerScope(serviceBroker, spaceNames))
 		foundBrokers = true
 		return true
 	})
@@ -64,3 +68,21 @@ func (cmd ListServiceBrokers) Run(c *cli.Context) {
 		cmd.ui.Say(T("No service brokers found"))
 	}
 }
+
+func (cmd ListServiceBrokers) brokerScope(serviceBroker models.ServiceBroker, spaceNames map[string]string) string {
+	if serviceBroker.SpaceGuid == "" {
+		return T("global")
+	}
+
+	spaceName, found := spaceNames[serviceBroker.SpaceGuid]
+	if !found {
+		spaceName = serviceBroker.SpaceGuid
+		space, err := cmd.spaceRepo.FindByGuid(serviceBroker.SpaceGuid)
+		if err == nil {
+			spaceName = space.Organization.Name + " / " + space.Name
+		}
+		spaceNames[serviceBroker.SpaceGuid] = spaceName
+	}
+
+	return T("space {{.SpaceName}}", map[string]interface{}{"SpaceName": spaceName})
+}
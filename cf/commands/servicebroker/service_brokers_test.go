@@ -18,7 +18,7 @@ import (
 func callListServiceBrokers(args []string, serviceBrokerRepo *testapi.FakeServiceBrokerRepo) (ui *testterm.FakeUI) {
 	ui = &testterm.FakeUI{}
 	config := testconfig.NewRepositoryWithDefaults()
-	cmd := NewListServiceBrokers(ui, config, serviceBrokerRepo)
+	cmd := NewListServiceBrokers(ui, config, serviceBrokerRepo, &testapi.FakeSpaceRepository{})
 	testcmd.RunCommand(cmd, args, &testreq.FakeReqFactory{})
 
 	return
@@ -30,6 +30,7 @@ var _ = Describe("service-brokers command", func() {
 		config              core_config.Repository
 		cmd                 ListServiceBrokers
 		repo                *testapi.FakeServiceBrokerRepo
+		spaceRepo           *testapi.FakeSpaceRepository
 		requirementsFactory *testreq.FakeReqFactory
 	)
 
@@ -37,7 +38,8 @@ var _ = Describe("service-brokers command", func() {
 		ui = &testterm.FakeUI{}
 		config = testconfig.NewRepositoryWithDefaults()
 		repo = &testapi.FakeServiceBrokerRepo{}
-		cmd = NewListServiceBrokers(ui, config, repo)
+		spaceRepo = &testapi.FakeSpaceRepository{}
+		cmd = NewListServiceBrokers(ui, config, repo, spaceRepo)
 		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true}
 	})
 
@@ -72,13 +74,33 @@ var _ = Describe("service-brokers command", func() {
 
 		Expect(ui.Outputs).To(ContainSubstrings(
 			[]string{"Getting service brokers as", "my-user"},
-			[]string{"name", "url"},
-			[]string{"service-broker-to-list-a", "http://service-a-url.com"},
+			[]string{"name", "url", "scope"},
+			[]string{"service-broker-to-list-a", "http://service-a-url.com", "global"},
 			[]string{"service-broker-to-list-b", "http://service-b-url.com"},
 			[]string{"service-broker-to-list-c", "http://service-c-url.com"},
 		))
 	})
 
+	It("shows the org and space of space-scoped brokers", func() {
+		space := models.Space{}
+		space.Name = "private-space"
+		space.Organization.Name = "private-org"
+		spaceRepo.FindByGuidSpace = space
+
+		repo.ServiceBrokers = []models.ServiceBroker{models.ServiceBroker{
+			Name:      "private-broker",
+			Url:       "http://private-url.com",
+			SpaceGuid: "private-space-guid",
+		}}
+
+		testcmd.RunCommand(cmd, []string{}, requirementsFactory)
+
+		Expect(spaceRepo.FindByGuidGuid).To(Equal("private-space-guid"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"private-broker", "http://private-url.com", "space private-org / private-space"},
+		))
+	})
+
 	It("says when no service brokers were found", func() {
 		testcmd.RunCommand(cmd, []string{}, requirementsFactory)
 
@@ -0,0 +1,74 @@
+package servicekey
+
+import (
+	"github.com/cloudfoundry/cli/cf/api/service_keys"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type CreateServiceKey struct {
+	ui                 terminal.UI
+	config             core_config.Reader
+	serviceKeyRepo     service_keys.ServiceKeyRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewCreateServiceKey(ui terminal.UI, config core_config.Reader, serviceKeyRepo service_keys.ServiceKeyRepository) (cmd *CreateServiceKey) {
+	return &CreateServiceKey{
+		ui:             ui,
+		config:         config,
+		serviceKeyRepo: serviceKeyRepo,
+	}
+}
+
+func (cmd *CreateServiceKey) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "create-service-key",
+		ShortName:   "csk",
+		Description: T("Create key for a service instance"),
+		Usage:       T("CF_NAME create-service-key SERVICE_INSTANCE SERVICE_KEY"),
+	}
+}
+
+func (cmd *CreateServiceKey) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) ([]requirements.Requirement, error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.serviceInstanceReq = requirementsFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return reqs, nil
+}
+
+func (cmd *CreateServiceKey) Run(c *cli.Context) {
+	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
+	serviceKeyName := c.Args()[1]
+
+	cmd.ui.Say(T("Creating service key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ServiceInstanceName": terminal.EntityNameColor(serviceInstance.Name),
+			"ServiceKeyName":      terminal.EntityNameColor(serviceKeyName),
+			"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	err := cmd.serviceKeyRepo.CreateServiceKey(serviceInstance.Guid, serviceKeyName)
+	switch err.(type) {
+	case nil:
+		cmd.ui.Ok()
+	case *errors.ModelAlreadyExistsError:
+		cmd.ui.Ok()
+		cmd.ui.Warn(err.Error())
+	default:
+		cmd.ui.Failed(err.Error())
+	}
+}
@@ -0,0 +1,97 @@
+package servicekey_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/api/service_keys/fakes"
+	. "github.com/cloudfoundry/cli/cf/commands/servicekey"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+)
+
+var _ = Describe("create-service-key command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		config              core_config.Repository
+		requirementsFactory *testreq.FakeReqFactory
+		serviceKeyRepo      *fakes.FakeServiceKeyRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		config = testconfig.NewRepositoryWithDefaults()
+		serviceKeyRepo = &fakes.FakeServiceKeyRepository{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+		requirementsFactory.ServiceInstance = models.ServiceInstance{
+			ServiceInstanceFields: models.ServiceInstanceFields{Name: "fake-service-instance", Guid: "fake-instance-guid"},
+		}
+	})
+
+	var callCreateServiceKey = func(args []string) bool {
+		cmd := NewCreateServiceKey(ui, config, serviceKeyRepo)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given two arguments", func() {
+			callCreateServiceKey([]string{"fake-service-instance"})
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(callCreateServiceKey([]string{"fake-service-instance", "fake-key"})).To(BeFalse())
+		})
+
+		It("fails when a space is not targeted", func() {
+			requirementsFactory.TargetedSpaceSuccess = false
+			Expect(callCreateServiceKey([]string{"fake-service-instance", "fake-key"})).To(BeFalse())
+		})
+
+		It("looks up the service instance by name", func() {
+			callCreateServiceKey([]string{"fake-service-instance", "fake-key"})
+			Expect(requirementsFactory.ServiceInstanceName).To(Equal("fake-service-instance"))
+		})
+	})
+
+	It("creates the service key", func() {
+		callCreateServiceKey([]string{"fake-service-instance", "fake-key"})
+
+		Expect(serviceKeyRepo.CreateServiceKeyCallCount()).To(Equal(1))
+		instanceGuid, keyName := serviceKeyRepo.CreateServiceKeyArgsForCall(0)
+		Expect(instanceGuid).To(Equal("fake-instance-guid"))
+		Expect(keyName).To(Equal("fake-key"))
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Creating service key", "fake-key", "fake-service-instance", "my-user"},
+			[]string{"OK"},
+		))
+	})
+
+	It("warns when the service key already exists", func() {
+		serviceKeyRepo.CreateServiceKeyReturns(errors.NewModelAlreadyExistsError("Service key", "fake-key"))
+		callCreateServiceKey([]string{"fake-service-instance", "fake-key"})
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"OK"},
+			[]string{"fake-key", "already exists"},
+		))
+	})
+
+	It("fails when the service key cannot be created", func() {
+		serviceKeyRepo.CreateServiceKeyReturns(errors.New("the broker exploded"))
+		callCreateServiceKey([]string{"fake-service-instance", "fake-key"})
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"the broker exploded"},
+		))
+	})
+})
@@ -0,0 +1,96 @@
+package servicekey
+
+import (
+	"github.com/cloudfoundry/cli/cf/api/service_keys"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type DeleteServiceKey struct {
+	ui                 terminal.UI
+	config             core_config.Reader
+	serviceKeyRepo     service_keys.ServiceKeyRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewDeleteServiceKey(ui terminal.UI, config core_config.Reader, serviceKeyRepo service_keys.ServiceKeyRepository) (cmd *DeleteServiceKey) {
+	return &DeleteServiceKey{
+		ui:             ui,
+		config:         config,
+		serviceKeyRepo: serviceKeyRepo,
+	}
+}
+
+func (cmd *DeleteServiceKey) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "delete-service-key",
+		ShortName:   "dsk",
+		Description: T("Delete a service key"),
+		Usage:       T("CF_NAME delete-service-key SERVICE_INSTANCE SERVICE_KEY [-f]"),
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "f", Usage: T("Force deletion without confirmation")},
+		},
+	}
+}
+
+func (cmd *DeleteServiceKey) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) ([]requirements.Requirement, error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.serviceInstanceReq = requirementsFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return reqs, nil
+}
+
+func (cmd *DeleteServiceKey) Run(c *cli.Context) {
+	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
+	serviceKeyName := c.Args()[1]
+
+	if !c.Bool("f") {
+		if !cmd.ui.ConfirmDelete(T("service key"), serviceKeyName) {
+			return
+		}
+	}
+
+	cmd.ui.Say(T("Deleting key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ServiceKeyName":      terminal.EntityNameColor(serviceKeyName),
+			"ServiceInstanceName": terminal.EntityNameColor(serviceInstance.Name),
+			"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	serviceKey, err := cmd.serviceKeyRepo.GetServiceKey(serviceInstance.Guid, serviceKeyName)
+	switch err.(type) {
+	case nil:
+	case *errors.ModelNotFoundError:
+		cmd.ui.Ok()
+		cmd.ui.Warn(T("Service key {{.ServiceKeyName}} does not exist for service instance {{.ServiceInstanceName}}.",
+			map[string]interface{}{
+				"ServiceKeyName":      serviceKeyName,
+				"ServiceInstanceName": serviceInstance.Name,
+			}))
+		return
+	default:
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	err = cmd.serviceKeyRepo.DeleteServiceKey(serviceKey.Fields.Guid)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Ok()
+}
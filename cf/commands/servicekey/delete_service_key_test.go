@@ -0,0 +1,104 @@
+package servicekey_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/api/service_keys/fakes"
+	. "github.com/cloudfoundry/cli/cf/commands/servicekey"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+)
+
+var _ = Describe("delete-service-key command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		config              core_config.Repository
+		requirementsFactory *testreq.FakeReqFactory
+		serviceKeyRepo      *fakes.FakeServiceKeyRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		config = testconfig.NewRepositoryWithDefaults()
+		serviceKeyRepo = &fakes.FakeServiceKeyRepository{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+		requirementsFactory.ServiceInstance = models.ServiceInstance{
+			ServiceInstanceFields: models.ServiceInstanceFields{Name: "fake-service-instance", Guid: "fake-instance-guid"},
+		}
+		serviceKeyRepo.GetServiceKeyReturns(models.ServiceKey{
+			Fields: models.ServiceKeyFields{Name: "fake-key", Guid: "fake-key-guid"},
+		}, nil)
+	})
+
+	var callDeleteServiceKey = func(args []string) bool {
+		cmd := NewDeleteServiceKey(ui, config, serviceKeyRepo)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given two arguments", func() {
+			callDeleteServiceKey([]string{"fake-service-instance"})
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(callDeleteServiceKey([]string{"fake-service-instance", "fake-key"})).To(BeFalse())
+		})
+	})
+
+	It("deletes the service key after confirmation", func() {
+		ui.Inputs = []string{"y"}
+		callDeleteServiceKey([]string{"fake-service-instance", "fake-key"})
+
+		Expect(ui.Prompts).To(ContainSubstrings([]string{"Really delete the service key", "fake-key"}))
+		Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(1))
+		Expect(serviceKeyRepo.DeleteServiceKeyArgsForCall(0)).To(Equal("fake-key-guid"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Deleting key", "fake-key", "fake-service-instance", "my-user"},
+			[]string{"OK"},
+		))
+	})
+
+	It("does not delete the service key when the user declines", func() {
+		ui.Inputs = []string{"n"}
+		callDeleteServiceKey([]string{"fake-service-instance", "fake-key"})
+
+		Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(0))
+	})
+
+	It("does not prompt when -f is given", func() {
+		callDeleteServiceKey([]string{"-f", "fake-service-instance", "fake-key"})
+
+		Expect(ui.Prompts).To(BeEmpty())
+		Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(1))
+	})
+
+	It("warns when the service key does not exist", func() {
+		serviceKeyRepo.GetServiceKeyReturns(models.ServiceKey{}, errors.NewModelNotFoundError("Service key", "fake-key"))
+		callDeleteServiceKey([]string{"-f", "fake-service-instance", "fake-key"})
+
+		Expect(serviceKeyRepo.DeleteServiceKeyCallCount()).To(Equal(0))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"OK"},
+			[]string{"fake-key", "does not exist"},
+		))
+	})
+
+	It("fails when the service key cannot be deleted", func() {
+		serviceKeyRepo.DeleteServiceKeyReturns(errors.New("the server exploded"))
+		callDeleteServiceKey([]string{"-f", "fake-service-instance", "fake-key"})
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"the server exploded"},
+		))
+	})
+})
@@ -0,0 +1,102 @@
+package servicekey
+
+import (
+	"encoding/json"
+
+	"github.com/cloudfoundry/cli/cf/api/service_keys"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ServiceKey struct {
+	ui                 terminal.UI
+	config             core_config.Reader
+	serviceKeyRepo     service_keys.ServiceKeyRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewGetServiceKey(ui terminal.UI, config core_config.Reader, serviceKeyRepo service_keys.ServiceKeyRepository) (cmd *ServiceKey) {
+	return &ServiceKey{
+		ui:             ui,
+		config:         config,
+		serviceKeyRepo: serviceKeyRepo,
+	}
+}
+
+func (cmd *ServiceKey) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "service-key",
+		Description: T("Show service key info"),
+		Usage:       T("CF_NAME service-key SERVICE_INSTANCE SERVICE_KEY [--guid]"),
+		Flags: []cli.Flag{
+			cli.BoolFlag{Name: "guid", Usage: T("Retrieve and display the given service-key's guid. All other output for the service is suppressed.")},
+		},
+	}
+}
+
+func (cmd *ServiceKey) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) ([]requirements.Requirement, error) {
+	if len(c.Args()) != 2 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.serviceInstanceReq = requirementsFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return reqs, nil
+}
+
+func (cmd *ServiceKey) Run(c *cli.Context) {
+	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
+	serviceKeyName := c.Args()[1]
+
+	if !c.Bool("guid") {
+		cmd.ui.Say(T("Getting key {{.ServiceKeyName}} for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...",
+			map[string]interface{}{
+				"ServiceKeyName":      terminal.EntityNameColor(serviceKeyName),
+				"ServiceInstanceName": terminal.EntityNameColor(serviceInstance.Name),
+				"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
+			}))
+	}
+
+	serviceKey, err := cmd.serviceKeyRepo.GetServiceKey(serviceInstance.Guid, serviceKeyName)
+	switch err.(type) {
+	case nil:
+	case *errors.ModelNotFoundError:
+		if c.Bool("guid") {
+			return
+		}
+		cmd.ui.Say("")
+		cmd.ui.Warn(T("No service key {{.ServiceKeyName}} found for service instance {{.ServiceInstanceName}}",
+			map[string]interface{}{
+				"ServiceKeyName":      terminal.EntityNameColor(serviceKeyName),
+				"ServiceInstanceName": terminal.EntityNameColor(serviceInstance.Name),
+			}))
+		return
+	default:
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	if c.Bool("guid") {
+		cmd.ui.Say(serviceKey.Fields.Guid)
+		return
+	}
+
+	credentials, err := json.MarshalIndent(serviceKey.Credentials, "", "  ")
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Say("")
+	cmd.ui.Say(string(credentials))
+}
@@ -0,0 +1,102 @@
+package servicekey_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/api/service_keys/fakes"
+	. "github.com/cloudfoundry/cli/cf/commands/servicekey"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+)
+
+var _ = Describe("service-key command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		config              core_config.Repository
+		requirementsFactory *testreq.FakeReqFactory
+		serviceKeyRepo      *fakes.FakeServiceKeyRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		config = testconfig.NewRepositoryWithDefaults()
+		serviceKeyRepo = &fakes.FakeServiceKeyRepository{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+		requirementsFactory.ServiceInstance = models.ServiceInstance{
+			ServiceInstanceFields: models.ServiceInstanceFields{Name: "fake-service-instance", Guid: "fake-instance-guid"},
+		}
+	})
+
+	var callGetServiceKey = func(args []string) bool {
+		cmd := NewGetServiceKey(ui, config, serviceKeyRepo)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given two arguments", func() {
+			callGetServiceKey([]string{"fake-service-instance"})
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(callGetServiceKey([]string{"fake-service-instance", "fake-key"})).To(BeFalse())
+		})
+	})
+
+	Context("when the service key exists", func() {
+		BeforeEach(func() {
+			serviceKeyRepo.GetServiceKeyReturns(models.ServiceKey{
+				Fields:      models.ServiceKeyFields{Name: "fake-key", Guid: "fake-key-guid"},
+				Credentials: map[string]interface{}{"username": "fake-user", "password": "fake-password"},
+			}, nil)
+		})
+
+		It("shows the credentials of the key", func() {
+			callGetServiceKey([]string{"fake-service-instance", "fake-key"})
+
+			instanceGuid, keyName := serviceKeyRepo.GetServiceKeyArgsForCall(0)
+			Expect(instanceGuid).To(Equal("fake-instance-guid"))
+			Expect(keyName).To(Equal("fake-key"))
+
+			Expect(ui.Outputs).To(ContainSubstrings(
+				[]string{"Getting key", "fake-key", "fake-service-instance", "my-user"},
+				[]string{`"password": "fake-password"`},
+				[]string{`"username": "fake-user"`},
+			))
+		})
+
+		It("shows only the guid when --guid is given", func() {
+			callGetServiceKey([]string{"--guid", "fake-service-instance", "fake-key"})
+
+			Expect(ui.Outputs).To(Equal([]string{"fake-key-guid"}))
+		})
+	})
+
+	It("warns when the service key does not exist", func() {
+		serviceKeyRepo.GetServiceKeyReturns(models.ServiceKey{}, errors.NewModelNotFoundError("Service key", "fake-key"))
+		callGetServiceKey([]string{"fake-service-instance", "fake-key"})
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"No service key", "fake-key", "found for service instance", "fake-service-instance"},
+		))
+		Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"FAILED"}))
+	})
+
+	It("fails when the service key cannot be fetched", func() {
+		serviceKeyRepo.GetServiceKeyReturns(models.ServiceKey{}, errors.New("the server exploded"))
+		callGetServiceKey([]string{"fake-service-instance", "fake-key"})
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"the server exploded"},
+		))
+	})
+})
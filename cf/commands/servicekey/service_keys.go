@@ -0,0 +1,80 @@
+package servicekey
+
+import (
+	"github.com/cloudfoundry/cli/cf/api/service_keys"
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ServiceKeys struct {
+	ui                 terminal.UI
+	config             core_config.Reader
+	serviceKeyRepo     service_keys.ServiceKeyRepository
+	serviceInstanceReq requirements.ServiceInstanceRequirement
+}
+
+func NewListServiceKeys(ui terminal.UI, config core_config.Reader, serviceKeyRepo service_keys.ServiceKeyRepository) (cmd *ServiceKeys) {
+	return &ServiceKeys{
+		ui:             ui,
+		config:         config,
+		serviceKeyRepo: serviceKeyRepo,
+	}
+}
+
+func (cmd *ServiceKeys) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "service-keys",
+		ShortName:   "sk",
+		Description: T("List keys for a service instance"),
+		Usage:       T("CF_NAME service-keys SERVICE_INSTANCE"),
+	}
+}
+
+func (cmd *ServiceKeys) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) ([]requirements.Requirement, error) {
+	if len(c.Args()) != 1 {
+		cmd.ui.FailWithUsage(c)
+	}
+
+	cmd.serviceInstanceReq = requirementsFactory.NewServiceInstanceRequirement(c.Args()[0])
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.serviceInstanceReq,
+	}
+	return reqs, nil
+}
+
+func (cmd *ServiceKeys) Run(c *cli.Context) {
+	serviceInstance := cmd.serviceInstanceReq.GetServiceInstance()
+
+	cmd.ui.Say(T("Getting keys for service instance {{.ServiceInstanceName}} as {{.CurrentUser}}...",
+		map[string]interface{}{
+			"ServiceInstanceName": terminal.EntityNameColor(serviceInstance.Name),
+			"CurrentUser":         terminal.EntityNameColor(cmd.config.Username()),
+		}))
+
+	serviceKeys, err := cmd.serviceKeyRepo.ListServiceKeys(serviceInstance.Guid)
+	if err != nil {
+		cmd.ui.Failed(err.Error())
+		return
+	}
+
+	cmd.ui.Say("")
+
+	if len(serviceKeys) == 0 {
+		cmd.ui.Say(T("No service key for service instance {{.ServiceInstanceName}}",
+			map[string]interface{}{"ServiceInstanceName": terminal.EntityNameColor(serviceInstance.Name)}))
+		return
+	}
+
+	table := cmd.ui.Table([]string{T("name")})
+	for _, serviceKey := range serviceKeys {
+		table.Add(serviceKey.Fields.Name)
+	}
+	table.Print()
+}
@@ -0,0 +1,82 @@
+package servicekey_test
+
+import (
+	"github.com/cloudfoundry/cli/cf/api/service_keys/fakes"
+	. "github.com/cloudfoundry/cli/cf/commands/servicekey"
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/models"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testconfig "github.com/cloudfoundry/cli/testhelpers/configuration"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+)
+
+var _ = Describe("service-keys command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		config              core_config.Repository
+		requirementsFactory *testreq.FakeReqFactory
+		serviceKeyRepo      *fakes.FakeServiceKeyRepository
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		config = testconfig.NewRepositoryWithDefaults()
+		serviceKeyRepo = &fakes.FakeServiceKeyRepository{}
+		requirementsFactory = &testreq.FakeReqFactory{LoginSuccess: true, TargetedSpaceSuccess: true}
+		requirementsFactory.ServiceInstance = models.ServiceInstance{
+			ServiceInstanceFields: models.ServiceInstanceFields{Name: "fake-service-instance", Guid: "fake-instance-guid"},
+		}
+	})
+
+	var callListServiceKeys = func(args []string) bool {
+		cmd := NewListServiceKeys(ui, config, serviceKeyRepo)
+		return testcmd.RunCommand(cmd, args, requirementsFactory)
+	}
+
+	Describe("requirements", func() {
+		It("fails with usage when not given one argument", func() {
+			callListServiceKeys([]string{})
+			Expect(ui.FailedWithUsage).To(BeTrue())
+		})
+
+		It("fails when not logged in", func() {
+			requirementsFactory.LoginSuccess = false
+			Expect(callListServiceKeys([]string{"fake-service-instance"})).To(BeFalse())
+		})
+
+		It("fails when a space is not targeted", func() {
+			requirementsFactory.TargetedSpaceSuccess = false
+			Expect(callListServiceKeys([]string{"fake-service-instance"})).To(BeFalse())
+		})
+	})
+
+	It("lists the keys of the service instance", func() {
+		serviceKeyRepo.ListServiceKeysReturns([]models.ServiceKey{
+			{Fields: models.ServiceKeyFields{Name: "fake-key-1"}},
+			{Fields: models.ServiceKeyFields{Name: "fake-key-2"}},
+		}, nil)
+
+		callListServiceKeys([]string{"fake-service-instance"})
+
+		Expect(serviceKeyRepo.ListServiceKeysArgsForCall(0)).To(Equal("fake-instance-guid"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Getting keys for service instance", "fake-service-instance", "my-user"},
+			[]string{"name"},
+			[]string{"fake-key-1"},
+			[]string{"fake-key-2"},
+		))
+	})
+
+	It("tells the user when the service instance has no keys", func() {
+		callListServiceKeys([]string{"fake-service-instance"})
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"No service key for service instance", "fake-service-instance"},
+		))
+	})
+})
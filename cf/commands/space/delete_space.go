@@ -9,6 +9,7 @@ import (
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/ui_helpers"
 	"github.com/codegangsta/cli"
 )
 
@@ -55,7 +56,11 @@ func (cmd *DeleteSpace) GetRequirements(requirementsFactory requirements.Factory
 func (cmd *DeleteSpace) Run(c *cli.Context) {
 	spaceName := c.Args()[0]
 
-	if !c.Bool("f") {
+	if core_config.IsProtectedSpace(cmd.config.ProtectedTargets(), cmd.config.OrganizationFields().Name, spaceName) {
+		if !ui_helpers.ConfirmProtectedDelete(cmd.ui, T("space"), spaceName, c.Bool("f")) {
+			return
+		}
+	} else if !c.Bool("f") {
 		if !cmd.ui.ConfirmDelete(T("space"), spaceName) {
 			return
 		}
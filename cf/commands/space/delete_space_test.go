@@ -1,6 +1,8 @@
 package space_test
 
 import (
+	"os"
+
 	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands/space"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
@@ -94,4 +96,44 @@ var _ = Describe("delete-space command", func() {
 
 		Expect(config.HasSpace()).To(Equal(false))
 	})
+	Context("when the space is protected", func() {
+		BeforeEach(func() {
+			config.SetProtectedTargets([]string{"my-org/space-to-*"})
+		})
+
+		AfterEach(func() {
+			os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "")
+		})
+
+		It("requires the space name to be typed back", func() {
+			ui.Inputs = []string{"yes"}
+			runCommand("space-to-delete")
+			Expect(spaceRepo.DeletedSpaceGuid).To(BeEmpty())
+
+			ui.Inputs = []string{"space-to-delete"}
+			runCommand("space-to-delete")
+			Expect(ui.Prompts).To(ContainSubstrings([]string{"protected target", "space", "space-to-delete"}))
+			Expect(spaceRepo.DeletedSpaceGuid).To(Equal("space-to-delete-guid"))
+		})
+
+		It("protects the space when its name is given in another case", func() {
+			runCommand("-f", "SPACE-TO-DELETE")
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+			Expect(spaceRepo.DeletedSpaceGuid).To(BeEmpty())
+
+			ui.Inputs = []string{"y"}
+			runCommand("SPACE-TO-DELETE")
+			Expect(spaceRepo.DeletedSpaceGuid).To(BeEmpty())
+		})
+
+		It("refuses the -f flag unless the override is set", func() {
+			runCommand("-f", "space-to-delete")
+			Expect(ui.Outputs).To(ContainSubstrings([]string{"FAILED"}))
+			Expect(spaceRepo.DeletedSpaceGuid).To(BeEmpty())
+
+			os.Setenv(core_config.ProtectedTargetsOverrideEnvVar, "true")
+			runCommand("-f", "space-to-delete")
+			Expect(spaceRepo.DeletedSpaceGuid).To(Equal("space-to-delete-guid"))
+		})
+	})
 })
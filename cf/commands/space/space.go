@@ -7,27 +7,36 @@ import (
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/cf/models"
 
+	"github.com/cloudfoundry/cli/cf/api"
 	"github.com/cloudfoundry/cli/cf/api/space_quotas"
+	"github.com/cloudfoundry/cli/cf/api/space_usage"
 	"github.com/cloudfoundry/cli/cf/command_metadata"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/formatters"
 	"github.com/cloudfoundry/cli/cf/requirements"
 	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/cloudfoundry/cli/cf/ui_helpers"
 	"github.com/codegangsta/cli"
 )
 
 type ShowSpace struct {
-	ui        terminal.UI
-	config    core_config.Reader
-	spaceReq  requirements.SpaceRequirement
-	quotaRepo space_quotas.SpaceQuotaRepository
+	ui             terminal.UI
+	config         core_config.Reader
+	spaceReq       requirements.SpaceRequirement
+	quotaRepo      space_quotas.SpaceQuotaRepository
+	appSummaryRepo api.AppSummaryRepository
+	userRepo       api.UserRepository
+	usageRepo      space_usage.SpaceUsageRepository
 }
 
-func NewShowSpace(ui terminal.UI, config core_config.Reader, quotaRepo space_quotas.SpaceQuotaRepository) (cmd *ShowSpace) {
+func NewShowSpace(ui terminal.UI, config core_config.Reader, quotaRepo space_quotas.SpaceQuotaRepository, appSummaryRepo api.AppSummaryRepository, userRepo api.UserRepository, usageRepo space_usage.SpaceUsageRepository) (cmd *ShowSpace) {
 	cmd = new(ShowSpace)
 	cmd.ui = ui
 	cmd.config = config
 	cmd.quotaRepo = quotaRepo
+	cmd.appSummaryRepo = appSummaryRepo
+	cmd.userRepo = userRepo
+	cmd.usageRepo = usageRepo
 	return
 }
 
@@ -35,9 +44,10 @@ func (cmd *ShowSpace) Metadata() command_metadata.CommandMetadata {
 	return command_metadata.CommandMetadata{
 		Name:        "space",
 		Description: T("Show space info"),
-		Usage:       T("CF_NAME space SPACE"),
+		Usage:       T("CF_NAME space SPACE [--guid | --full]"),
 		Flags: []cli.Flag{
 			cli.BoolFlag{Name: "guid", Usage: T("Retrieve and display the given space's guid.  All other output for the space is suppressed.")},
+			cli.BoolFlag{Name: "full", Usage: T("Also show who holds each space role, usage against the space quota and the state of each app")},
 		},
 	}
 }
@@ -69,18 +79,47 @@ func (cmd *ShowSpace) Run(c *cli.Context) {
 				"CurrentUser": terminal.EntityNameColor(cmd.config.Username()),
 			}))
 
-		quotaString := cmd.quotaString(space)
+		quota, found := cmd.findQuota(space)
+		quotaString := ""
+		if found {
+			quotaString = formatQuota(quota)
+		}
+
+		roleRows := [][]string{}
+		usageString := ""
+		apps := []models.Application{}
+		if c.Bool("full") {
+			var err error
+			roleRows, err = cmd.roleRows(space)
+			if err != nil {
+				cmd.ui.Failed(err.Error())
+				return
+			}
+
+			usage, err := cmd.usageRepo.GetSpaceUsage(space.Guid)
+			if err != nil {
+				cmd.ui.Failed(err.Error())
+				return
+			}
+			usageString = formatSpaceUsage(usage, quota, found)
+
+			apps, err = cmd.appSummaryRepo.GetSummariesInSpace(space.Guid)
+			if err != nil {
+				cmd.ui.Failed(err.Error())
+				return
+			}
+		}
 
 		cmd.ui.Ok()
 		cmd.ui.Say("")
 		table := terminal.NewTable(cmd.ui, []string{terminal.EntityNameColor(space.Name), "", ""})
 		table.Add("", T("Org:"), terminal.EntityNameColor(space.Organization.Name))
 
-		apps := []string{}
+		appNames := []string{}
 		for _, app := range space.Applications {
-			apps = append(apps, terminal.EntityNameColor(app.Name))
+			appNames = append(appNames, terminal.EntityNameColor(app.Name))
 		}
-		table.Add("", T("Apps:"), strings.Join(apps, ", "))
+		table.Add("", T("Apps:"), strings.Join(appNames, ", "))
 
 		domains := []string{}
 		for _, domain := range space.Domains {
@@ -102,22 +141,106 @@ func (cmd *ShowSpace) Run(c *cli.Context) {
 
 		table.Add("", T("Space Quota:"), quotaString)
 
+		if c.Bool("full") {
+			for _, row := range roleRows {
+				table.Add("", row[0], row[1])
+			}
+			table.Add("", T("Usage:"), usageString)
+		}
+
 		table.Print()
+
+		if c.Bool("full") {
+			cmd.printApps(apps)
+		}
 	}
 }
 
-func (cmd *ShowSpace) quotaString(space models.Space) string {
-	var instance_memory string
-
+func (cmd *ShowSpace) findQuota(space models.Space) (models.SpaceQuota, bool) {
 	if space.SpaceQuotaGuid == "" {
-		return ""
+		return models.SpaceQuota{}, false
 	}
 
 	quota, err := cmd.quotaRepo.FindByGuid(space.SpaceQuotaGuid)
 	if err != nil {
 		cmd.ui.Failed(err.Error())
-		return ""
+		return models.SpaceQuota{}, false
+	}
+	return quota, true
+}
+
+func (cmd *ShowSpace) roleRows(space models.Space) ([][]string, error) {
+	roles := []struct {
+		role  string
+		label string
+	}{
+		{models.SPACE_MANAGER, T("Managers:")},
+		{models.SPACE_DEVELOPER, T("Developers:")},
+		{models.SPACE_AUDITOR, T("Auditors:")},
+	}
+
+	rows := [][]string{}
+	for _, r := range roles {
+		users, err := cmd.userRepo.ListUsersInSpaceForRole(space.Guid, r.role)
+		if err != nil {
+			return nil, err
+		}
+
+		usernames := []string{}
+		for _, user := range users {
+			usernames = append(usernames, terminal.EntityNameColor(user.Username))
+		}
+		rows = append(rows, []string{r.label, strings.Join(usernames, ", ")})
 	}
+	return rows, nil
+}
+
+func (cmd *ShowSpace) printApps(apps []models.Application) {
+	cmd.ui.Say("")
+	table := terminal.NewTable(cmd.ui, []string{T("name"), T("requested state"), T("instances"), T("services")})
+	for _, app := range apps {
+		services := []string{}
+		for _, service := range app.Services {
+			services = append(services, service.Name)
+		}
+		table.Add(
+			app.Name,
+			ui_helpers.ColoredAppState(app.ApplicationFields),
+			ui_helpers.ColoredAppInstances(app.ApplicationFields),
+			strings.Join(services, ", "),
+		)
+	}
+	table.Print()
+}
+
+// formatSpaceUsage shows memory, services and routes against the space
+// quota. Figures without a limit are shown on their own.
+func formatSpaceUsage(usage models.SpaceUsage, quota models.SpaceQuota, hasQuota bool) string {
+	memory := formatters.ByteSize(usage.Memory * formatters.MEGABYTE)
+	services := fmt.Sprintf("%d", usage.ServiceInstances)
+	routes := fmt.Sprintf("%d", usage.Routes)
+	if hasQuota {
+		if quota.MemoryLimit >= 0 {
+			memory += "/" + formatters.ByteSize(quota.MemoryLimit*formatters.MEGABYTE)
+		}
+		if quota.ServicesLimit >= 0 {
+			services += fmt.Sprintf("/%d", quota.ServicesLimit)
+		}
+		if quota.RoutesLimit >= 0 {
+			routes += fmt.Sprintf("/%d", quota.RoutesLimit)
+		}
+	}
+
+	return T("{{.Memory}} memory, {{.Services}} services, {{.Routes}} routes",
+		map[string]interface{}{
+			"Memory":   memory,
+			"Services": services,
+			"Routes":   routes,
+		})
+}
+
+func formatQuota(quota models.SpaceQuota) string {
+	var instance_memory string
 
 	if quota.InstanceMemoryLimit == -1 {
 		instance_memory = "-1"
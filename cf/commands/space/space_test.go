@@ -1,7 +1,9 @@
 package space_test
 
 import (
+	testapi "github.com/cloudfoundry/cli/cf/api/fakes"
 	"github.com/cloudfoundry/cli/cf/api/space_quotas/fakes"
+	usagefakes "github.com/cloudfoundry/cli/cf/api/space_usage/fakes"
 	. "github.com/cloudfoundry/cli/cf/commands/space"
 	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/models"
@@ -20,18 +22,24 @@ var _ = Describe("space command", func() {
 		ui                  *testterm.FakeUI
 		requirementsFactory *testreq.FakeReqFactory
 		quotaRepo           *fakes.FakeSpaceQuotaRepository
+		appSummaryRepo      *testapi.FakeAppSummaryRepo
+		userRepo            *testapi.FakeUserRepository
+		usageRepo           *usagefakes.FakeSpaceUsageRepository
 		configRepo          core_config.ReadWriter
 	)
 
 	BeforeEach(func() {
 		configRepo = testconfig.NewRepositoryWithDefaults()
 		quotaRepo = &fakes.FakeSpaceQuotaRepository{}
+		appSummaryRepo = &testapi.FakeAppSummaryRepo{}
+		userRepo = &testapi.FakeUserRepository{}
+		usageRepo = &usagefakes.FakeSpaceUsageRepository{}
 		ui = &testterm.FakeUI{}
 		requirementsFactory = &testreq.FakeReqFactory{}
 	})
 
 	runCommand := func(args ...string) bool {
-		return testcmd.RunCommand(NewShowSpace(ui, configRepo, quotaRepo), args, requirementsFactory)
+		return testcmd.RunCommand(NewShowSpace(ui, configRepo, quotaRepo, appSummaryRepo, userRepo, usageRepo), args, requirementsFactory)
 	}
 
 	Describe("requirements", func() {
@@ -129,6 +137,58 @@ var _ = Describe("space command", func() {
 			})
 		})
 
+		Context("when the full flag is passed", func() {
+			BeforeEach(func() {
+				userRepo.ListUsersByRole = map[string][]models.UserFields{
+					models.SPACE_MANAGER:   []models.UserFields{{Username: "alice"}},
+					models.SPACE_DEVELOPER: []models.UserFields{{Username: "bob"}, {Username: "carol"}},
+				}
+
+				usageRepo.GetSpaceUsageReturns(models.SpaceUsage{Memory: 512, ServiceInstances: 3, Routes: 4}, nil)
+
+				app := models.Application{}
+				app.Name = "app1"
+				app.State = "started"
+				app.RunningInstances = 1
+				app.InstanceCount = 2
+				app.Services = []models.ServicePlanSummary{{Name: "service1"}, {Name: "service2"}}
+				appSummaryRepo.GetSummariesInSpaceApps = []models.Application{app}
+			})
+
+			It("shows roles, usage against the space quota and the apps", func() {
+				runCommand("--full", "whose-space-is-it-anyway")
+
+				Expect(userRepo.ListUsersSpaceGuid).To(Equal("whose-space-is-it-anyway-guid"))
+				Expect(usageRepo.GetSpaceUsageArgsForCall(0)).To(Equal("whose-space-is-it-anyway-guid"))
+				Expect(appSummaryRepo.GetSummariesInSpaceGuid).To(Equal("whose-space-is-it-anyway-guid"))
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Space Quota", "runaway"},
+					[]string{"Managers", "alice"},
+					[]string{"Developers", "bob, carol"},
+					[]string{"Auditors"},
+					[]string{"Usage", "512M/100G memory", "3/222 services", "4/111 routes"},
+					[]string{"name", "requested state", "instances", "services"},
+					[]string{"app1", "started", "1/2", "service1, service2"},
+				))
+			})
+
+			It("shows usage on its own when the space has no quota", func() {
+				requirementsFactory.Space.SpaceQuotaGuid = ""
+				runCommand("--full", "whose-space-is-it-anyway")
+
+				Expect(ui.Outputs).To(ContainSubstrings(
+					[]string{"Usage", "512M memory", "3 services", "4 routes"},
+				))
+			})
+		})
+
+		It("does not show roles, usage or apps without the full flag", func() {
+			runCommand("whose-space-is-it-anyway")
+
+			Expect(usageRepo.GetSpaceUsageCallCount()).To(Equal(0))
+			Expect(ui.Outputs).ToNot(ContainSubstrings([]string{"Managers"}))
+		})
+
 		Context("when the space does not have a space quota", func() {
 			It("shows information without a space quota", func() {
 				requirementsFactory.Space.SpaceQuotaGuid = ""
@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/cloudfoundry/cli/cf/command_metadata"
+	"github.com/cloudfoundry/cli/cf/flag_helpers"
+	. "github.com/cloudfoundry/cli/cf/i18n"
+	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/cf/requirements"
+	"github.com/cloudfoundry/cli/cf/terminal"
+	"github.com/codegangsta/cli"
+)
+
+type ValidateManifest struct {
+	ui           terminal.UI
+	manifestRepo manifest.ManifestRepository
+}
+
+func NewValidateManifest(ui terminal.UI, manifestRepo manifest.ManifestRepository) (cmd ValidateManifest) {
+	cmd.ui = ui
+	cmd.manifestRepo = manifestRepo
+	return
+}
+
+func (cmd ValidateManifest) Metadata() command_metadata.CommandMetadata {
+	return command_metadata.CommandMetadata{
+		Name:        "validate-manifest",
+		Description: T("Check a manifest for unknown properties and invalid values"),
+		Usage:       T("CF_NAME validate-manifest [-f MANIFEST_PATH]"),
+		Flags: []cli.Flag{
+			flag_helpers.NewStringFlag("f", T("Path to manifest")),
+		},
+	}
+}
+
+func (cmd ValidateManifest) GetRequirements(requirementsFactory requirements.Factory, c *cli.Context) (reqs []requirements.Requirement, err error) {
+	if len(c.Args()) != 0 {
+		cmd.ui.FailWithUsage(c)
+	}
+	return
+}
+
+func (cmd ValidateManifest) Run(c *cli.Context) {
+	path := c.String("f")
+	if path == "" {
+		var err error
+		path, err = os.Getwd()
+		if err != nil {
+			cmd.ui.Failed(T("Could not determine the current working directory!"), err)
+		}
+	}
+
+	cmd.ui.Say(T("Validating manifest {{.Path}}...", map[string]interface{}{"Path": terminal.EntityNameColor(path)}))
+
+	validationErrs, err := cmd.manifestRepo.ValidateManifest(path)
+	if err != nil {
+		cmd.ui.Failed(T("Error reading manifest file:\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
+	}
+
+	if len(validationErrs) > 0 {
+		for _, validationErr := range validationErrs {
+			cmd.ui.Say(validationErr.Error())
+		}
+		cmd.ui.Failed(T("Found {{.Count}} problem(s) in manifest", map[string]interface{}{"Count": len(validationErrs)}))
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Manifest is valid"))
+}
@@ -0,0 +1,82 @@
+package commands_test
+
+import (
+	"errors"
+
+	. "github.com/cloudfoundry/cli/cf/commands"
+	"github.com/cloudfoundry/cli/cf/manifest"
+	testcmd "github.com/cloudfoundry/cli/testhelpers/commands"
+	testmanifest "github.com/cloudfoundry/cli/testhelpers/manifest"
+	testreq "github.com/cloudfoundry/cli/testhelpers/requirements"
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+)
+
+var _ = Describe("validate-manifest command", func() {
+	var (
+		ui                  *testterm.FakeUI
+		manifestRepo        *testmanifest.FakeManifestRepository
+		requirementsFactory *testreq.FakeReqFactory
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		manifestRepo = &testmanifest.FakeManifestRepository{}
+		requirementsFactory = &testreq.FakeReqFactory{}
+	})
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCommand(NewValidateManifest(ui, manifestRepo), args, requirementsFactory)
+	}
+
+	It("fails with usage when given arguments", func() {
+		runCommand("some-app")
+		Expect(ui.FailedWithUsage).To(BeTrue())
+	})
+
+	It("does not require a login or a targeted space", func() {
+		Expect(runCommand()).To(BeTrue())
+	})
+
+	It("validates the manifest at the path given with -f", func() {
+		runCommand("-f", "path/to/manifest.yml")
+
+		Expect(manifestRepo.ValidateManifestArgs.Path).To(Equal("path/to/manifest.yml"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Validating manifest", "path/to/manifest.yml"},
+			[]string{"OK"},
+			[]string{"Manifest is valid"},
+		))
+	})
+
+	It("prints each problem with its file and line and fails", func() {
+		manifestRepo.ValidateManifestReturns.ValidationErrors = []manifest.ValidationError{
+			{File: "manifest.yml", Line: 4, Message: "Unknown property 'instnaces', did you mean 'instances'?"},
+			{File: "manifest.yml", Line: 7, Message: "Expected no-route to be a boolean."},
+		}
+
+		runCommand("-f", "manifest.yml")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"manifest.yml:4: Unknown property 'instnaces', did you mean 'instances'?"},
+			[]string{"manifest.yml:7: Expected no-route to be a boolean."},
+			[]string{"FAILED"},
+			[]string{"Found 2 problem(s) in manifest"},
+		))
+	})
+
+	It("fails when the manifest cannot be read", func() {
+		manifestRepo.ValidateManifestReturns.Error = errors.New("no such file")
+
+		runCommand("-f", "missing.yml")
+
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"FAILED"},
+			[]string{"Error reading manifest file"},
+			[]string{"no such file"},
+		))
+	})
+})
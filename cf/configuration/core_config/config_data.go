@@ -34,6 +34,7 @@ type Data struct {
 	Trace                 string
 	ColorEnabled          string
 	Locale                string
+	ProtectedTargets      []string
 }
 
 func NewData() (data *Data) {
@@ -39,7 +39,8 @@ var exampleJSON = `
 	"AsyncTimeout": 1000,
 	"Trace": "path/to/some/file",
 	"ColorEnabled": "true",
-	"Locale": "fr_FR"
+	"Locale": "fr_FR",
+	"ProtectedTargets": ["prod", "staging/db-*"]
 }`
 
 var exampleData = &Data{
@@ -58,11 +59,12 @@ var exampleData = &Data{
 		Guid: "the-space-guid",
 		Name: "the-space",
 	},
-	SSLDisabled:  true,
-	Trace:        "path/to/some/file",
-	AsyncTimeout: 1000,
-	ColorEnabled: "true",
-	Locale:       "fr_FR",
+	SSLDisabled:      true,
+	Trace:            "path/to/some/file",
+	AsyncTimeout:     1000,
+	ColorEnabled:     "true",
+	Locale:           "fr_FR",
+	ProtectedTargets: []string{"prod", "staging/db-*"},
 }
 
 var _ = Describe("V3 Config files", func() {
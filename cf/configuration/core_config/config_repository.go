@@ -58,6 +58,8 @@ type Reader interface {
 	ColorEnabled() string
 
 	Locale() string
+
+	ProtectedTargets() []string
 }
 
 type ReadWriter interface {
@@ -77,6 +79,7 @@ type ReadWriter interface {
 	SetTrace(string)
 	SetColorEnabled(string)
 	SetLocale(string)
+	SetProtectedTargets([]string)
 }
 
 type Repository interface {
@@ -273,6 +276,13 @@ func (c *ConfigRepository) Locale() (locale string) {
 	return
 }
 
+func (c *ConfigRepository) ProtectedTargets() (patterns []string) {
+	c.read(func() {
+		patterns = c.data.ProtectedTargets
+	})
+	return
+}
+
 // SETTERS
 
 func (c *ConfigRepository) ClearSession() {
@@ -367,3 +377,9 @@ func (c *ConfigRepository) SetLocale(locale string) {
 		c.data.Locale = locale
 	})
 }
+
+func (c *ConfigRepository) SetProtectedTargets(patterns []string) {
+	c.write(func() {
+		c.data.ProtectedTargets = patterns
+	})
+}
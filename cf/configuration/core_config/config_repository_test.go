@@ -86,6 +86,9 @@ var _ = Describe("Configuration Repository", func() {
 
 		config.SetLocale("en_US")
 		Expect(config.Locale()).To(Equal("en_US"))
+
+		config.SetProtectedTargets([]string{"prod", "staging/db-*"})
+		Expect(config.ProtectedTargets()).To(Equal([]string{"prod", "staging/db-*"}))
 	})
 
 	Describe("HasAPIEndpoint", func() {
@@ -0,0 +1,74 @@
+package core_config
+
+import (
+	"os"
+	"path"
+	"strings"
+)
+
+// Setting this environment variable lets destructive commands act on
+// protected targets with -f.
+const ProtectedTargetsOverrideEnvVar = "CF_ALLOW_PROTECTED_DELETE"
+
+// IsValidProtectedTarget reports whether pattern is either ORG, which protects
+// the org and every space in it, or ORG/SPACE, which protects a single space.
+// Both parts may use the wildcards accepted by path.Match. Names are matched
+// regardless of case, as the cloud controller finds orgs and spaces by name.
+func IsValidProtectedTarget(pattern string) bool {
+	parts := strings.Split(pattern, "/")
+	if len(parts) > 2 {
+		return false
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return false
+		}
+		if _, err := path.Match(part, ""); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// IsProtectedOrg reports whether deleting the org would touch a protected
+// target, that is whether the org itself or any space pattern in it matches.
+func IsProtectedOrg(patterns []string, orgName string) bool {
+	for _, pattern := range patterns {
+		orgPattern, _ := splitProtectedTarget(pattern)
+		if matchesName(orgPattern, orgName) {
+			return true
+		}
+	}
+	return false
+}
+
+func IsProtectedSpace(patterns []string, orgName, spaceName string) bool {
+	for _, pattern := range patterns {
+		orgPattern, spacePattern := splitProtectedTarget(pattern)
+		if !matchesName(orgPattern, orgName) {
+			continue
+		}
+		if spacePattern == "" || matchesName(spacePattern, spaceName) {
+			return true
+		}
+	}
+	return false
+}
+
+func ProtectedTargetsOverridden() bool {
+	return os.Getenv(ProtectedTargetsOverrideEnvVar) == "true"
+}
+
+func splitProtectedTarget(pattern string) (orgPattern, spacePattern string) {
+	parts := strings.SplitN(pattern, "/", 2)
+	if len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return parts[0], ""
+}
+
+func matchesName(pattern, name string) bool {
+	matched, err := path.Match(strings.ToLower(pattern), strings.ToLower(name))
+	return err == nil && matched
+}
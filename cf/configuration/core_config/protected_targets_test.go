@@ -0,0 +1,73 @@
+package core_config_test
+
+import (
+	"os"
+
+	. "github.com/cloudfoundry/cli/cf/configuration/core_config"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Protected targets", func() {
+	patterns := []string{"prod", "staging/db-*"}
+
+	Describe("IsValidProtectedTarget", func() {
+		It("accepts org and org/space patterns", func() {
+			Expect(IsValidProtectedTarget("prod")).To(BeTrue())
+			Expect(IsValidProtectedTarget("prod-*/db")).To(BeTrue())
+		})
+
+		It("rejects empty parts, extra parts and malformed wildcards", func() {
+			Expect(IsValidProtectedTarget("")).To(BeFalse())
+			Expect(IsValidProtectedTarget("prod/")).To(BeFalse())
+			Expect(IsValidProtectedTarget("prod/db/more")).To(BeFalse())
+			Expect(IsValidProtectedTarget("prod-[")).To(BeFalse())
+		})
+	})
+
+	Describe("IsProtectedSpace", func() {
+		It("protects every space of a protected org", func() {
+			Expect(IsProtectedSpace(patterns, "prod", "anything")).To(BeTrue())
+		})
+
+		It("protects spaces matching an org/space pattern", func() {
+			Expect(IsProtectedSpace(patterns, "staging", "db-main")).To(BeTrue())
+			Expect(IsProtectedSpace(patterns, "staging", "web")).To(BeFalse())
+		})
+
+		It("matches names regardless of case", func() {
+			Expect(IsProtectedSpace(patterns, "PROD", "anything")).To(BeTrue())
+			Expect(IsProtectedSpace(patterns, "Staging", "DB-Main")).To(BeTrue())
+			Expect(IsProtectedSpace([]string{"Prod/DB"}, "prod", "db")).To(BeTrue())
+		})
+
+		It("does not protect spaces of other orgs", func() {
+			Expect(IsProtectedSpace(patterns, "dev", "db-main")).To(BeFalse())
+		})
+	})
+
+	Describe("IsProtectedOrg", func() {
+		It("protects orgs that are protected or hold a protected space", func() {
+			Expect(IsProtectedOrg(patterns, "prod")).To(BeTrue())
+			Expect(IsProtectedOrg(patterns, "staging")).To(BeTrue())
+			Expect(IsProtectedOrg(patterns, "dev")).To(BeFalse())
+			Expect(IsProtectedOrg(patterns, "PROD")).To(BeTrue())
+		})
+	})
+
+	Describe("ProtectedTargetsOverridden", func() {
+		AfterEach(func() {
+			os.Setenv(ProtectedTargetsOverrideEnvVar, "")
+		})
+
+		It("is only true when the override variable is set to true", func() {
+			Expect(ProtectedTargetsOverridden()).To(BeFalse())
+
+			os.Setenv(ProtectedTargetsOverrideEnvVar, "1")
+			Expect(ProtectedTargetsOverridden()).To(BeFalse())
+
+			os.Setenv(ProtectedTargetsOverrideEnvVar, "true")
+			Expect(ProtectedTargetsOverridden()).To(BeTrue())
+		})
+	})
+})
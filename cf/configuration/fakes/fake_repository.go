@@ -135,6 +135,12 @@ type FakeRepository struct {
 	localeReturns     struct {
 		result1 string
 	}
+	ProtectedTargetsStub        func() []string
+	protectedTargetsMutex       sync.RWMutex
+	protectedTargetsArgsForCall []struct{}
+	protectedTargetsReturns     struct {
+		result1 []string
+	}
 	PluginsStub        func() map[string]string
 	pluginsMutex       sync.RWMutex
 	pluginsArgsForCall []struct{}
@@ -220,6 +226,11 @@ type FakeRepository struct {
 	setLocaleArgsForCall []struct {
 		arg1 string
 	}
+	SetProtectedTargetsStub        func([]string)
+	setProtectedTargetsMutex       sync.RWMutex
+	setProtectedTargetsArgsForCall []struct {
+		arg1 []string
+	}
 	SetPluginStub        func(string, string)
 	setPluginMutex       sync.RWMutex
 	setPluginArgsForCall []struct {
@@ -714,6 +725,29 @@ func (fake *FakeRepository) LocaleReturns(result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRepository) ProtectedTargets() []string {
+	fake.protectedTargetsMutex.Lock()
+	defer fake.protectedTargetsMutex.Unlock()
+	fake.protectedTargetsArgsForCall = append(fake.protectedTargetsArgsForCall, struct{}{})
+	if fake.ProtectedTargetsStub != nil {
+		return fake.ProtectedTargetsStub()
+	} else {
+		return fake.protectedTargetsReturns.result1
+	}
+}
+
+func (fake *FakeRepository) ProtectedTargetsCallCount() int {
+	fake.protectedTargetsMutex.RLock()
+	defer fake.protectedTargetsMutex.RUnlock()
+	return len(fake.protectedTargetsArgsForCall)
+}
+
+func (fake *FakeRepository) ProtectedTargetsReturns(result1 []string) {
+	fake.protectedTargetsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
 func (fake *FakeRepository) Plugins() map[string]string {
 	fake.pluginsMutex.Lock()
 	defer fake.pluginsMutex.Unlock()
@@ -1097,6 +1131,29 @@ func (fake *FakeRepository) SetLocaleArgsForCall(i int) string {
 	return fake.setLocaleArgsForCall[i].arg1
 }
 
+func (fake *FakeRepository) SetProtectedTargets(arg1 []string) {
+	fake.setProtectedTargetsMutex.Lock()
+	defer fake.setProtectedTargetsMutex.Unlock()
+	fake.setProtectedTargetsArgsForCall = append(fake.setProtectedTargetsArgsForCall, struct {
+		arg1 []string
+	}{arg1})
+	if fake.SetProtectedTargetsStub != nil {
+		fake.SetProtectedTargetsStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetProtectedTargetsCallCount() int {
+	fake.setProtectedTargetsMutex.RLock()
+	defer fake.setProtectedTargetsMutex.RUnlock()
+	return len(fake.setProtectedTargetsArgsForCall)
+}
+
+func (fake *FakeRepository) SetProtectedTargetsArgsForCall(i int) []string {
+	fake.setProtectedTargetsMutex.RLock()
+	defer fake.setProtectedTargetsMutex.RUnlock()
+	return fake.setProtectedTargetsArgsForCall[i].arg1
+}
+
 func (fake *FakeRepository) SetPlugin(arg1 string, arg2 string) {
 	fake.setPluginMutex.Lock()
 	defer fake.setPluginMutex.Unlock()
@@ -15,4 +15,5 @@ const (
 	BUILDPACK_EXISTS            = "290001"
 	SECURITY_GROUP_EXISTS       = "300005"
 	APP_ALREADY_BOUND           = "90003"
+	SERVICE_KEY_NAME_TAKEN      = "360001"
 )
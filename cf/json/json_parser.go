@@ -0,0 +1,35 @@
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+// ParseJsonFromFileOrString reads a JSON object either from the file at
+// fileOrJson or, when no such file exists, from fileOrJson itself. An empty
+// argument yields a nil map.
+func ParseJsonFromFileOrString(fileOrJson string) (map[string]interface{}, error) {
+	if fileOrJson == "" {
+		return nil, nil
+	}
+
+	jsonBytes := []byte(fileOrJson)
+	if info, err := os.Stat(fileOrJson); err == nil && !info.IsDir() {
+		jsonBytes, err = ioutil.ReadFile(fileOrJson)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var jsonMap map[string]interface{}
+	err := json.Unmarshal(jsonBytes, &jsonMap)
+	if err != nil || jsonMap == nil {
+		return nil, errors.New(T("Invalid configuration provided for -c flag. Please provide a valid JSON object or path to a file containing a valid JSON object."))
+	}
+
+	return jsonMap, nil
+}
@@ -0,0 +1,67 @@
+package json_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	. "github.com/cloudfoundry/cli/cf/json"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseJsonFromFileOrString", func() {
+	It("returns nil when given an empty string", func() {
+		jsonMap, err := ParseJsonFromFileOrString("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jsonMap).To(BeNil())
+	})
+
+	It("parses an inline JSON object", func() {
+		jsonMap, err := ParseJsonFromFileOrString(`{"db_version":"9.4","nodes":3}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(jsonMap).To(Equal(map[string]interface{}{"db_version": "9.4", "nodes": float64(3)}))
+	})
+
+	Context("when given the path to a file", func() {
+		var path string
+
+		BeforeEach(func() {
+			file, err := ioutil.TempFile("", "params")
+			Expect(err).NotTo(HaveOccurred())
+			path = file.Name()
+		})
+
+		AfterEach(func() {
+			os.Remove(path)
+		})
+
+		It("parses the contents of the file", func() {
+			ioutil.WriteFile(path, []byte(`{"cluster_size":"large"}`), 0600)
+
+			jsonMap, err := ParseJsonFromFileOrString(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jsonMap).To(Equal(map[string]interface{}{"cluster_size": "large"}))
+		})
+
+		It("returns an error when the file does not contain a JSON object", func() {
+			ioutil.WriteFile(path, []byte(`cluster_size: large`), 0600)
+
+			_, err := ParseJsonFromFileOrString(path)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid configuration provided for -c flag"))
+		})
+	})
+
+	It("returns an error for invalid JSON", func() {
+		_, err := ParseJsonFromFileOrString(`{"db_version":`)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("returns an error for JSON that is not an object", func() {
+		_, err := ParseJsonFromFileOrString(`["a", "b"]`)
+		Expect(err).To(HaveOccurred())
+
+		_, err = ParseJsonFromFileOrString(`null`)
+		Expect(err).To(HaveOccurred())
+	})
+})
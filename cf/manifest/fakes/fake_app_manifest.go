@@ -15,18 +15,42 @@ type FakeAppManifest struct {
 		arg1 string
 		arg2 int64
 	}
+	BuildpackUrlStub        func(string, string)
+	buildpackUrlMutex       sync.RWMutex
+	buildpackUrlArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	StartCommandStub        func(string, string)
+	startCommandMutex       sync.RWMutex
+	startCommandArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	DiskQuotaStub        func(string, int64)
+	diskQuotaMutex       sync.RWMutex
+	diskQuotaArgsForCall []struct {
+		arg1 string
+		arg2 int64
+	}
+	StackStub        func(string, string)
+	stackMutex       sync.RWMutex
+	stackArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
 	ServiceStub        func(string, string)
 	serviceMutex       sync.RWMutex
 	serviceArgsForCall []struct {
 		arg1 string
 		arg2 string
 	}
-	EnvironmentVarsStub        func(string, string, string)
+	EnvironmentVarsStub        func(string, string, interface{})
 	environmentVarsMutex       sync.RWMutex
 	environmentVarsArgsForCall []struct {
 		arg1 string
 		arg2 string
-		arg3 string
+		arg3 interface{}
 	}
 	HealthCheckTimeoutStub        func(string, int)
 	healthCheckTimeoutMutex       sync.RWMutex
@@ -96,6 +120,102 @@ func (fake *FakeAppManifest) MemoryArgsForCall(i int) (string, int64) {
 	return fake.memoryArgsForCall[i].arg1, fake.memoryArgsForCall[i].arg2
 }
 
+func (fake *FakeAppManifest) BuildpackUrl(arg1 string, arg2 string) {
+	fake.buildpackUrlMutex.Lock()
+	defer fake.buildpackUrlMutex.Unlock()
+	fake.buildpackUrlArgsForCall = append(fake.buildpackUrlArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	if fake.BuildpackUrlStub != nil {
+		fake.BuildpackUrlStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAppManifest) BuildpackUrlCallCount() int {
+	fake.buildpackUrlMutex.RLock()
+	defer fake.buildpackUrlMutex.RUnlock()
+	return len(fake.buildpackUrlArgsForCall)
+}
+
+func (fake *FakeAppManifest) BuildpackUrlArgsForCall(i int) (string, string) {
+	fake.buildpackUrlMutex.RLock()
+	defer fake.buildpackUrlMutex.RUnlock()
+	return fake.buildpackUrlArgsForCall[i].arg1, fake.buildpackUrlArgsForCall[i].arg2
+}
+
+func (fake *FakeAppManifest) StartCommand(arg1 string, arg2 string) {
+	fake.startCommandMutex.Lock()
+	defer fake.startCommandMutex.Unlock()
+	fake.startCommandArgsForCall = append(fake.startCommandArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	if fake.StartCommandStub != nil {
+		fake.StartCommandStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAppManifest) StartCommandCallCount() int {
+	fake.startCommandMutex.RLock()
+	defer fake.startCommandMutex.RUnlock()
+	return len(fake.startCommandArgsForCall)
+}
+
+func (fake *FakeAppManifest) StartCommandArgsForCall(i int) (string, string) {
+	fake.startCommandMutex.RLock()
+	defer fake.startCommandMutex.RUnlock()
+	return fake.startCommandArgsForCall[i].arg1, fake.startCommandArgsForCall[i].arg2
+}
+
+func (fake *FakeAppManifest) DiskQuota(arg1 string, arg2 int64) {
+	fake.diskQuotaMutex.Lock()
+	defer fake.diskQuotaMutex.Unlock()
+	fake.diskQuotaArgsForCall = append(fake.diskQuotaArgsForCall, struct {
+		arg1 string
+		arg2 int64
+	}{arg1, arg2})
+	if fake.DiskQuotaStub != nil {
+		fake.DiskQuotaStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAppManifest) DiskQuotaCallCount() int {
+	fake.diskQuotaMutex.RLock()
+	defer fake.diskQuotaMutex.RUnlock()
+	return len(fake.diskQuotaArgsForCall)
+}
+
+func (fake *FakeAppManifest) DiskQuotaArgsForCall(i int) (string, int64) {
+	fake.diskQuotaMutex.RLock()
+	defer fake.diskQuotaMutex.RUnlock()
+	return fake.diskQuotaArgsForCall[i].arg1, fake.diskQuotaArgsForCall[i].arg2
+}
+
+func (fake *FakeAppManifest) Stack(arg1 string, arg2 string) {
+	fake.stackMutex.Lock()
+	defer fake.stackMutex.Unlock()
+	fake.stackArgsForCall = append(fake.stackArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	if fake.StackStub != nil {
+		fake.StackStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAppManifest) StackCallCount() int {
+	fake.stackMutex.RLock()
+	defer fake.stackMutex.RUnlock()
+	return len(fake.stackArgsForCall)
+}
+
+func (fake *FakeAppManifest) StackArgsForCall(i int) (string, string) {
+	fake.stackMutex.RLock()
+	defer fake.stackMutex.RUnlock()
+	return fake.stackArgsForCall[i].arg1, fake.stackArgsForCall[i].arg2
+}
+
 func (fake *FakeAppManifest) Service(arg1 string, arg2 string) {
 	fake.serviceMutex.Lock()
 	defer fake.serviceMutex.Unlock()
@@ -120,13 +240,13 @@ func (fake *FakeAppManifest) ServiceArgsForCall(i int) (string, string) {
 	return fake.serviceArgsForCall[i].arg1, fake.serviceArgsForCall[i].arg2
 }
 
-func (fake *FakeAppManifest) EnvironmentVars(arg1 string, arg2 string, arg3 string) {
+func (fake *FakeAppManifest) EnvironmentVars(arg1 string, arg2 string, arg3 interface{}) {
 	fake.environmentVarsMutex.Lock()
 	defer fake.environmentVarsMutex.Unlock()
 	fake.environmentVarsArgsForCall = append(fake.environmentVarsArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 string
+		arg3 interface{}
 	}{arg1, arg2, arg3})
 	if fake.EnvironmentVarsStub != nil {
 		fake.EnvironmentVarsStub(arg1, arg2, arg3)
@@ -139,7 +259,7 @@ func (fake *FakeAppManifest) EnvironmentVarsCallCount() int {
 	return len(fake.environmentVarsArgsForCall)
 }
 
-func (fake *FakeAppManifest) EnvironmentVarsArgsForCall(i int) (string, string, string) {
+func (fake *FakeAppManifest) EnvironmentVarsArgsForCall(i int) (string, string, interface{}) {
 	fake.environmentVarsMutex.RLock()
 	defer fake.environmentVarsMutex.RUnlock()
 	return fake.environmentVarsArgsForCall[i].arg1, fake.environmentVarsArgsForCall[i].arg2, fake.environmentVarsArgsForCall[i].arg3
@@ -1,16 +1,24 @@
 package manifest
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/cloudfoundry/cli/cf/models"
 )
 
 type AppManifest interface {
 	Memory(string, int64)
+	BuildpackUrl(string, string)
+	StartCommand(string, string)
+	DiskQuota(string, int64)
+	Stack(string, string)
 	Service(string, string)
-	EnvironmentVars(string, string, string)
+	EnvironmentVars(string, string, interface{})
 	HealthCheckTimeout(string, int)
 	Instances(string, int)
 	Domain(string, string, string)
@@ -29,6 +37,45 @@ func NewGenerator() AppManifest {
 	return &appManifest{}
 }
 
+// AddApplication records the current settings of app, as returned by the app
+// summary, in m. The stack is only recorded when stackName is given.
+func AddApplication(m AppManifest, app models.Application, stackName string) {
+	m.Memory(app.Name, app.Memory)
+	m.Instances(app.Name, app.InstanceCount)
+
+	if app.DiskQuota > 0 {
+		m.DiskQuota(app.Name, app.DiskQuota)
+	}
+
+	if app.BuildpackUrl != "" {
+		m.BuildpackUrl(app.Name, app.BuildpackUrl)
+	}
+
+	if app.Command != "" {
+		m.StartCommand(app.Name, app.Command)
+	}
+
+	if stackName != "" {
+		m.Stack(app.Name, stackName)
+	}
+
+	for _, service := range app.Services {
+		m.Service(app.Name, service.Name)
+	}
+
+	if app.HealthCheckTimeout > 0 {
+		m.HealthCheckTimeout(app.Name, app.HealthCheckTimeout)
+	}
+
+	for k, v := range app.EnvironmentVars {
+		m.EnvironmentVars(app.Name, k, v)
+	}
+
+	for _, route := range app.Routes {
+		m.Domain(app.Name, route.Host, route.Domain.Name)
+	}
+}
+
 func (m *appManifest) FileSavePath(savePath string) {
 	m.savePath = savePath
 }
@@ -52,6 +99,16 @@ func (m *appManifest) StartCommand(appName string, cmd string) {
 	m.contents[i].Command = cmd
 }
 
+func (m *appManifest) DiskQuota(appName string, diskQuota int64) {
+	i := m.findOrCreateApplication(appName)
+	m.contents[i].DiskQuota = diskQuota
+}
+
+func (m *appManifest) Stack(appName string, stackName string) {
+	i := m.findOrCreateApplication(appName)
+	m.contents[i].Stack = &models.Stack{Name: stackName}
+}
+
 func (m *appManifest) HealthCheckTimeout(appName string, timeout int) {
 	i := m.findOrCreateApplication(appName)
 	m.contents[i].HealthCheckTimeout = timeout
@@ -80,7 +137,7 @@ func (m *appManifest) Domain(appName string, host string, domain string) {
 	})
 }
 
-func (m *appManifest) EnvironmentVars(appName string, key, value string) {
+func (m *appManifest) EnvironmentVars(appName string, key string, value interface{}) {
 	i := m.findOrCreateApplication(appName)
 	m.contents[i].EnvironmentVars[key] = value
 }
@@ -99,7 +156,7 @@ func (m *appManifest) Save() error {
 	_, err = f.Write([]byte("---\napplications:\n"))
 
 	for _, app := range m.contents {
-		if _, err := f.Write([]byte("- name: " + app.Name + "\n")); err != nil {
+		if _, err := f.Write([]byte("- name: " + yamlScalar(app.Name) + "\n")); err != nil {
 			return err
 		}
 
@@ -111,37 +168,40 @@ func (m *appManifest) Save() error {
 			return err
 		}
 
-		if app.BuildpackUrl != "" {
-			if _, err := f.Write([]byte(fmt.Sprintf("  buildpack: %s\n", app.BuildpackUrl))); err != nil {
+		if app.DiskQuota > 0 {
+			if _, err := f.Write([]byte(fmt.Sprintf("  disk_quota: %dM\n", app.DiskQuota))); err != nil {
 				return err
 			}
 		}
 
-		if app.HealthCheckTimeout > 0 {
-			if _, err := f.Write([]byte(fmt.Sprintf("  timeout: %d\n", app.HealthCheckTimeout))); err != nil {
+		if app.BuildpackUrl != "" {
+			if _, err := f.Write([]byte(fmt.Sprintf("  buildpack: %s\n", yamlScalar(app.BuildpackUrl)))); err != nil {
 				return err
 			}
 		}
 
-		if app.Command != "" {
-			if _, err := f.Write([]byte(fmt.Sprintf("  command: %s\n", app.Command))); err != nil {
+		if app.Stack != nil {
+			if _, err := f.Write([]byte(fmt.Sprintf("  stack: %s\n", yamlScalar(app.Stack.Name)))); err != nil {
 				return err
 			}
 		}
 
-		if len(app.Routes) > 0 {
-			if _, err := f.Write([]byte(fmt.Sprintf("  host: %s\n", app.Routes[0].Host))); err != nil {
-				return err
-			}
-			if _, err := f.Write([]byte(fmt.Sprintf("  domain: %s\n", app.Routes[0].Domain.Name))); err != nil {
+		if app.HealthCheckTimeout > 0 {
+			if _, err := f.Write([]byte(fmt.Sprintf("  timeout: %d\n", app.HealthCheckTimeout))); err != nil {
 				return err
 			}
-		} else {
-			if _, err := f.Write([]byte(fmt.Sprintf("  no-route: true\n"))); err != nil {
+		}
+
+		if app.Command != "" {
+			if _, err := f.Write([]byte(fmt.Sprintf("  command: %s\n", yamlScalar(app.Command)))); err != nil {
 				return err
 			}
 		}
 
+		if err := writeRoutesToFile(f, app.Routes); err != nil {
+			return err
+		}
+
 		if len(app.Services) > 0 {
 			if err := writeServicesToFile(f, app.Services); err != nil {
 				return err
@@ -183,7 +243,7 @@ func writeServicesToFile(f *os.File, entries []models.ServicePlanSummary) error
 		return err
 	}
 	for _, service := range entries {
-		_, err = f.Write([]byte(fmt.Sprintf("  - %s\n", service.Name)))
+		_, err = f.Write([]byte(fmt.Sprintf("  - %s\n", yamlScalar(service.Name))))
 		if err != nil {
 			return err
 		}
@@ -197,8 +257,19 @@ func writeEnvironmentVarToFile(f *os.File, envVars map[string]interface{}) error
 	if err != nil {
 		return err
 	}
-	for k, v := range envVars {
-		_, err = f.Write([]byte(fmt.Sprintf("    %s: %s\n", k, v)))
+	keys := make([]string, 0, len(envVars))
+	for k := range envVars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value, err := yamlValue(envVars[k])
+		if err != nil {
+			return err
+		}
+
+		_, err = f.Write([]byte(fmt.Sprintf("    %s: %s\n", yamlScalar(k), value)))
 		if err != nil {
 			return err
 		}
@@ -206,3 +277,57 @@ func writeEnvironmentVarToFile(f *os.File, envVars map[string]interface{}) error
 
 	return nil
 }
+
+// writeRoutesToFile uses host and domain for a single route with a hostname,
+// and a list of routes otherwise, so that cf push can bind them back exactly.
+func writeRoutesToFile(f *os.File, routes []models.RouteSummary) error {
+	if len(routes) == 0 {
+		_, err := f.Write([]byte("  no-route: true\n"))
+		return err
+	}
+
+	if len(routes) == 1 && routes[0].Host != "" {
+		if _, err := f.Write([]byte(fmt.Sprintf("  host: %s\n", yamlScalar(routes[0].Host)))); err != nil {
+			return err
+		}
+		_, err := f.Write([]byte(fmt.Sprintf("  domain: %s\n", yamlScalar(routes[0].Domain.Name))))
+		return err
+	}
+
+	if _, err := f.Write([]byte("  routes:\n")); err != nil {
+		return err
+	}
+	for _, route := range routes {
+		if _, err := f.Write([]byte(fmt.Sprintf("  - %s\n", yamlScalar(route.URL())))); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var plainYAMLScalarRegex = regexp.MustCompile(`^[A-Za-z_/][\w./-]*$`)
+
+// yamlScalar returns s unchanged when YAML would read it back as the same
+// string, and as a double-quoted string otherwise.
+func yamlScalar(s string) string {
+	if plainYAMLScalarRegex.MatchString(s) {
+		switch strings.ToLower(s) {
+		case "y", "n", "yes", "no", "on", "off", "true", "false", "null":
+		default:
+			return s
+		}
+	}
+
+	quoted, _ := json.Marshal(s)
+	return string(quoted)
+}
+
+func yamlValue(value interface{}) (string, error) {
+	if s, ok := value.(string); ok {
+		return yamlScalar(s), nil
+	}
+
+	encoded, err := json.Marshal(value)
+	return string(encoded), err
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"strings"
 
+	"github.com/cloudfoundry/cli/cf/models"
+
 	. "github.com/cloudfoundry/cli/cf/manifest"
 	. "github.com/cloudfoundry/cli/testhelpers/matchers"
 	. "github.com/onsi/ginkgo"
@@ -109,6 +111,114 @@ var _ = Describe("generate_manifest", func() {
 		))
 	})
 
+	It("writes every route when an app has several", func() {
+		m.Memory("app1", 128)
+		m.Domain("app1", "foo", "example.com")
+		m.Domain("app1", "", "example.org")
+		err := m.Save()
+		Ω(err).NotTo(HaveOccurred())
+
+		contents := getYamlContent("./output.yml")
+		Ω(contents).To(ContainSubstrings(
+			[]string{"  routes:"},
+			[]string{"  - foo.example.com"},
+			[]string{"  - example.org"},
+		))
+		Ω(contents).NotTo(ContainSubstrings([]string{"host:"}))
+	})
+
+	It("marks apps without routes with no-route", func() {
+		m.Memory("app1", 128)
+		err := m.Save()
+		Ω(err).NotTo(HaveOccurred())
+
+		Ω(getYamlContent("./output.yml")).To(ContainSubstrings([]string{"  no-route: true"}))
+	})
+
+	It("writes the disk quota, stack, buildpack and command", func() {
+		m.Memory("app1", 128)
+		m.DiskQuota("app1", 2048)
+		m.Stack("app1", "cflinuxfs2")
+		m.BuildpackUrl("app1", "https://github.com/cloudfoundry/ruby-buildpack.git")
+		m.StartCommand("app1", "bundle exec rackup -p $PORT")
+		err := m.Save()
+		Ω(err).NotTo(HaveOccurred())
+
+		Ω(getYamlContent("./output.yml")).To(ContainSubstrings(
+			[]string{"  disk_quota: 2048M"},
+			[]string{`  buildpack: "https://github.com/cloudfoundry/ruby-buildpack.git"`},
+			[]string{"  stack: cflinuxfs2"},
+			[]string{`  command: "bundle exec rackup -p $PORT"`},
+		))
+	})
+
+	It("writes a manifest that parses back to the same settings", func() {
+		m.Memory("app1", 128)
+		m.Instances("app1", 2)
+		m.DiskQuota("app1", 512)
+		m.Stack("app1", "cflinuxfs2")
+		m.StartCommand("app1", `JAVA_OPTS="-Xss995K" ./bin/start.sh: run`)
+		m.Domain("app1", "foo", "example.com")
+		m.Domain("app1", "bar", "example.com")
+		m.Service("app1", "my-db")
+		m.EnvironmentVars("app1", "PORT_OFFSET", "8080")
+		m.EnvironmentVars("app1", "DEBUG", "true")
+		m.EnvironmentVars("app1", "GREETING", "hello: world")
+		m.Memory("app2", 64)
+		Ω(m.Save()).NotTo(HaveOccurred())
+
+		readManifest, err := NewManifestDiskRepository().ReadManifest("./output.yml")
+		Ω(err).NotTo(HaveOccurred())
+
+		apps, err := readManifest.Applications()
+		Ω(err).NotTo(HaveOccurred())
+		Ω(apps).To(HaveLen(2))
+
+		app := apps[0]
+		Ω(*app.Name).To(Equal("app1"))
+		Ω(*app.Memory).To(Equal(int64(128)))
+		Ω(*app.InstanceCount).To(Equal(2))
+		Ω(*app.DiskQuota).To(Equal(int64(512)))
+		Ω(*app.StackName).To(Equal("cflinuxfs2"))
+		Ω(*app.Command).To(Equal(`JAVA_OPTS="-Xss995K" ./bin/start.sh: run`))
+		Ω(*app.Routes).To(Equal([]string{"foo.example.com", "bar.example.com"}))
+		Ω(*app.ServicesToBind).To(Equal([]string{"my-db"}))
+		Ω(*app.EnvironmentVars).To(Equal(map[string]interface{}{
+			"PORT_OFFSET": "8080",
+			"DEBUG":       "true",
+			"GREETING":    "hello: world",
+		}))
+
+		Ω(*apps[1].Name).To(Equal("app2"))
+		Ω(apps[1].NoRoute).To(BeTrue())
+	})
+
+	It("adds an application from its summary", func() {
+		app := models.Application{}
+		app.Name = "app1"
+		app.Memory = 256
+		app.InstanceCount = 2
+		app.Command = "run.sh"
+		app.EnvironmentVars = map[string]interface{}{"DEBUG": "true"}
+		app.Services = []models.ServicePlanSummary{{Name: "my-db"}}
+		app.Routes = []models.RouteSummary{{Host: "foo", Domain: models.DomainFields{Name: "example.com"}}}
+
+		AddApplication(m, app, "cflinuxfs2")
+		Ω(m.Save()).NotTo(HaveOccurred())
+
+		Ω(getYamlContent("./output.yml")).To(ContainSubstrings(
+			[]string{"- name: app1"},
+			[]string{"  memory: 256M"},
+			[]string{"  instances: 2"},
+			[]string{"  stack: cflinuxfs2"},
+			[]string{"  command: run.sh"},
+			[]string{"  host: foo"},
+			[]string{"  domain: example.com"},
+			[]string{"  - my-db"},
+			[]string{"    DEBUG: "},
+		))
+	})
+
 })
 
 func getYamlContent(path string) []string {
@@ -1,7 +1,9 @@
 package manifest
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"path/filepath"
 	"regexp"
 	"strconv"
@@ -136,7 +138,9 @@ func mapToAppParams(basePath string, yamlMap generic.Map) (appParams models.AppP
 	appParams.BuildpackUrl = stringValOrDefault(yamlMap, "buildpack", &errs)
 	appParams.DiskQuota = bytesVal(yamlMap, "disk_quota", &errs)
 	appParams.Domain = stringVal(yamlMap, "domain", &errs)
+	appParams.Domains = sliceOrNilVal(yamlMap, "domains", &errs)
 	appParams.Host = stringVal(yamlMap, "host", &errs)
+	appParams.Hosts = sliceOrNilVal(yamlMap, "hosts", &errs)
 	appParams.Name = stringVal(yamlMap, "name", &errs)
 	appParams.Path = stringVal(yamlMap, "path", &errs)
 	appParams.StackName = stringVal(yamlMap, "stack", &errs)
@@ -144,24 +148,34 @@ func mapToAppParams(basePath string, yamlMap generic.Map) (appParams models.AppP
 	appParams.Memory = bytesVal(yamlMap, "memory", &errs)
 	appParams.InstanceCount = intVal(yamlMap, "instances", &errs)
 	appParams.HealthCheckTimeout = intVal(yamlMap, "timeout", &errs)
+	appParams.HealthCheckType = healthCheckTypeVal(yamlMap, "health-check-type", &errs)
+	appParams.HealthCheckEndpoint = stringVal(yamlMap, "health-check-http-endpoint", &errs)
 	appParams.NoRoute = boolVal(yamlMap, "no-route", &errs)
 	appParams.UseRandomHostname = boolVal(yamlMap, "random-route", &errs)
-	appParams.ServicesToBind = sliceOrEmptyVal(yamlMap, "services", &errs)
+	appParams.Routes = sliceOrNilVal(yamlMap, "routes", &errs)
+	appParams.PruneRoutes = boolVal(yamlMap, "prune-routes", &errs)
+	appParams.ServicesToBind, appParams.ServicesToCreate = servicesVal(yamlMap, "services", &errs)
 	appParams.EnvironmentVars = envVarOrEmptyMap(yamlMap, &errs)
 
+	if envFile := stringVal(yamlMap, "env-file", &errs); envFile != nil && appParams.EnvironmentVars != nil {
+		mergeEnvFile(resolvePath(basePath, *envFile), *appParams.EnvironmentVars, &errs)
+	}
+
 	if appParams.Path != nil {
-		path := *appParams.Path
-		if filepath.IsAbs(path) {
-			path = filepath.Clean(path)
-		} else {
-			path = filepath.Join(basePath, path)
-		}
+		path := resolvePath(basePath, *appParams.Path)
 		appParams.Path = &path
 	}
 
 	return
 }
 
+func resolvePath(basePath, path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(basePath, path)
+}
+
 func checkForNulls(yamlMap generic.Map) (errs []error) {
 	generic.Each(yamlMap, func(key interface{}, value interface{}) {
 		if key == "command" || key == "buildpack" {
@@ -237,6 +251,10 @@ func intVal(yamlMap generic.Map, key string, errs *[]error) *int {
 	switch val := yamlMap.Get(key).(type) {
 	case string:
 		intVal, err = strconv.Atoi(val)
+		if err != nil {
+			err = errors.NewWithFmt(T("Expected {{.PropertyName}} to be a number, but it was a {{.PropertyType}}.",
+				map[string]interface{}{"PropertyName": key, "PropertyType": fmt.Sprintf("%T", val)}))
+		}
 	case int:
 		intVal = val
 	case int64:
@@ -245,7 +263,7 @@ func intVal(yamlMap generic.Map, key string, errs *[]error) *int {
 		return nil
 	default:
 		err = errors.NewWithFmt(T("Expected {{.PropertyName}} to be a number, but it was a {{.PropertyType}}.",
-			map[string]interface{}{"PropertyName": key, "PropertyType": val}))
+			map[string]interface{}{"PropertyName": key, "PropertyType": fmt.Sprintf("%T", val)}))
 	}
 
 	if err != nil {
@@ -309,6 +327,13 @@ func sliceOrEmptyVal(yamlMap generic.Map, key string, errs *[]error) *[]string {
 	return &stringSlice
 }
 
+func sliceOrNilVal(yamlMap generic.Map, key string, errs *[]error) *[]string {
+	if !yamlMap.Has(key) {
+		return nil
+	}
+	return sliceOrEmptyVal(yamlMap, key, errs)
+}
+
 func envVarOrEmptyMap(yamlMap generic.Map, errs *[]error) *map[string]interface{} {
 	key := "env"
 	switch envVars := yamlMap.Get(key).(type) {
@@ -341,6 +366,201 @@ func envVarOrEmptyMap(yamlMap generic.Map, errs *[]error) *map[string]interface{
 	}
 }
 
+// mergeEnvFile reads KEY=VALUE lines from the file at path into envVars.
+// Variables already set in the manifest's env block take precedence.
+func mergeEnvFile(path string, envVars map[string]interface{}, errs *[]error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		*errs = append(*errs, errors.NewWithFmt(T("Error reading env file {{.Path}}: {{.Err}}",
+			map[string]interface{}{"Path": path, "Err": err.Error()})))
+		return
+	}
+
+	for lineNumber, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimPrefix(line, "export "), "=", 2)
+		name := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || name == "" {
+			*errs = append(*errs, errors.NewWithFmt(T("Invalid line {{.Line}} in env file {{.Path}}: expected KEY=VALUE",
+				map[string]interface{}{"Line": lineNumber + 1, "Path": path})))
+			continue
+		}
+
+		if _, ok := envVars[name]; !ok {
+			envVars[name] = unquote(strings.TrimSpace(parts[1]))
+		}
+	}
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+var healthCheckTypes = []string{"none", "port", "process", "http"}
+
+func healthCheckTypeVal(yamlMap generic.Map, key string, errs *[]error) *string {
+	value := stringVal(yamlMap, key, errs)
+	if value == nil {
+		return nil
+	}
+
+	for _, healthCheckType := range healthCheckTypes {
+		if *value == healthCheckType {
+			return value
+		}
+	}
+
+	*errs = append(*errs, errors.NewWithFmt(T("Invalid value for '{{.PropertyName}}': {{.Value}}\nExpected one of: {{.Types}}",
+		map[string]interface{}{
+			"PropertyName": key,
+			"Value":        *value,
+			"Types":        strings.Join(healthCheckTypes, ", "),
+		})))
+	return nil
+}
+
+// servicesVal reads a list whose entries are either the name of a service
+// instance to bind, or a map with name, service, plan and optional parameters
+// describing an instance to create if it does not exist yet.
+func servicesVal(yamlMap generic.Map, key string, errs *[]error) (toBind *[]string, toCreate *[]models.ServiceParams) {
+	if !yamlMap.Has(key) {
+		return new([]string), nil
+	}
+
+	entries, ok := yamlMap.Get(key).([]interface{})
+	if !ok {
+		*errs = append(*errs, servicesListError(key))
+		return nil, nil
+	}
+
+	names := []string{}
+	definitions := []models.ServiceParams{}
+	for _, entry := range entries {
+		switch entry := entry.(type) {
+		case string:
+			names = append(names, entry)
+		default:
+			if !generic.IsMappable(entry) {
+				*errs = append(*errs, servicesListError(key))
+				return nil, nil
+			}
+
+			params, entryErrs := serviceParamsVal(generic.NewMap(entry))
+			if len(entryErrs) > 0 {
+				*errs = append(*errs, entryErrs...)
+				continue
+			}
+
+			names = append(names, params.Name)
+			if params.Label != "" {
+				definitions = append(definitions, params)
+			}
+		}
+	}
+
+	toBind = &names
+	if len(definitions) > 0 {
+		toCreate = &definitions
+	}
+	return
+}
+
+func servicesListError(key string) error {
+	return errors.NewWithFmt(T("Expected {{.PropertyName}} to be a list of service names or service definitions.",
+		map[string]interface{}{"PropertyName": key}))
+}
+
+func serviceParamsVal(entry generic.Map) (params models.ServiceParams, errs []error) {
+	generic.Each(entry, func(key, _ interface{}) {
+		switch key {
+		case "name", "service", "plan", "parameters":
+		default:
+			errs = append(errs, errors.NewWithFmt(T("Unknown service property '{{.PropertyName}}'",
+				map[string]interface{}{"PropertyName": key})))
+		}
+	})
+
+	name := stringVal(entry, "name", &errs)
+	label := stringVal(entry, "service", &errs)
+	plan := stringVal(entry, "plan", &errs)
+
+	if name == nil || *name == "" {
+		errs = append(errs, errors.New(T("Service definitions must have a name")))
+		return
+	}
+	params.Name = *name
+
+	if (label == nil) != (plan == nil) {
+		errs = append(errs, errors.NewWithFmt(T("Service {{.ServiceName}} must specify both a service and a plan",
+			map[string]interface{}{"ServiceName": params.Name})))
+		return
+	}
+	if label != nil {
+		params.Label = *label
+		params.Plan = *plan
+	}
+
+	if !entry.Has("parameters") {
+		return
+	}
+	if label == nil {
+		errs = append(errs, errors.NewWithFmt(T("Service {{.ServiceName}} has parameters but no service and plan to create it from",
+			map[string]interface{}{"ServiceName": params.Name})))
+		return
+	}
+
+	parameters, err := serviceParametersVal(entry.Get("parameters"))
+	if err != nil {
+		errs = append(errs, errors.NewWithFmt(T("Invalid parameters for service {{.ServiceName}}: {{.Err}}",
+			map[string]interface{}{"ServiceName": params.Name, "Err": err.Error()})))
+		return
+	}
+	params.Parameters = parameters
+	return
+}
+
+func serviceParametersVal(value interface{}) (map[string]interface{}, error) {
+	if jsonString, ok := value.(string); ok {
+		parameters := map[string]interface{}{}
+		err := json.Unmarshal([]byte(jsonString), &parameters)
+		return parameters, err
+	}
+
+	if !generic.IsMappable(value) {
+		return nil, errors.New(T("expected a map or a JSON object"))
+	}
+
+	return toJSONValue(value).(map[string]interface{}), nil
+}
+
+// toJSONValue converts the maps produced by the YAML parser, which are keyed
+// by interface{}, into values that encoding/json can marshal.
+func toJSONValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case []interface{}:
+		result := make([]interface{}, len(value))
+		for i, item := range value {
+			result[i] = toJSONValue(item)
+		}
+		return result
+	case map[string]interface{}, map[interface{}]interface{}, generic.Map:
+		result := map[string]interface{}{}
+		generic.Each(generic.NewMap(value), func(key, item interface{}) {
+			result[coerceToString(key)] = toJSONValue(item)
+		})
+		return result
+	default:
+		return value
+	}
+}
+
 func validateEnvVars(input generic.Map) (errs []error) {
 	generic.Each(input, func(key, value interface{}) {
 		if value == nil {
@@ -1,17 +1,20 @@
 package manifest
 
 import (
+	"bytes"
 	"github.com/cloudfoundry-incubator/candiedyaml"
 	"github.com/cloudfoundry/cli/cf/errors"
 	. "github.com/cloudfoundry/cli/cf/i18n"
 	"github.com/cloudfoundry/cli/generic"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 )
 
 type ManifestRepository interface {
 	ReadManifest(string) (*Manifest, error)
+	ValidateManifest(string) ([]ValidationError, error)
 }
 
 type ManifestDiskRepository struct{}
@@ -76,6 +79,42 @@ func (repo ManifestDiskRepository) readAllYAMLFiles(path string) (mergedMap gene
 	return
 }
 
+func (repo ManifestDiskRepository) ValidateManifest(inputPath string) ([]ValidationError, error) {
+	manifestPath, err := repo.manifestPath(inputPath)
+	if err != nil {
+		return nil, errors.NewWithError(T("Error finding manifest"), err)
+	}
+
+	return repo.validateAllYAMLFiles(manifestPath)
+}
+
+func (repo ManifestDiskRepository) validateAllYAMLFiles(path string) (validationErrs []ValidationError, err error) {
+	contents, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return
+	}
+
+	mapp, err := parseManifest(bytes.NewReader(contents))
+	if err != nil {
+		return
+	}
+
+	validationErrs = validateManifestData(path, mapp, indexYAMLLines(contents))
+
+	inheritedPath, ok := mapp.Get("inherit").(string)
+	if !ok {
+		return
+	}
+
+	if !filepath.IsAbs(inheritedPath) {
+		inheritedPath = filepath.Join(filepath.Dir(path), inheritedPath)
+	}
+
+	inheritedErrs, err := repo.validateAllYAMLFiles(inheritedPath)
+	validationErrs = append(validationErrs, inheritedErrs...)
+	return
+}
+
 func parseManifest(file io.Reader) (yamlMap generic.Map, err error) {
 	decoder := candiedyaml.NewDecoder(file)
 	yamlMap = generic.NewMap()
@@ -153,4 +153,41 @@ var _ = Describe("ManifestDiskRepository", func() {
 		services := *applications[1].ServicesToBind
 		Expect(services).To(Equal([]string{"base-service", "foo-service"}))
 	})
+
+	Describe("ValidateManifest", func() {
+		It("returns no validation errors for a valid manifest", func() {
+			validationErrs, err := repo.ValidateManifest("../../fixtures/manifests/inherited-manifest.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(validationErrs).To(BeEmpty())
+		})
+
+		It("returns an error when the manifest cannot be found", func() {
+			_, err := repo.ValidateManifest("some/path/that/doesnt/exist/manifest.yml")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("reports unknown keys, type mismatches and invalid units with their file and line", func() {
+			manifestPath := filepath.Clean("../../fixtures/manifests/invalid/manifest.yml")
+			basePath := filepath.Join(filepath.Dir(manifestPath), "base.yml")
+
+			validationErrs, err := repo.ValidateManifest(filepath.Dir(manifestPath))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(validationErrs).To(HaveLen(6))
+			Expect(validationErrs[0]).To(Equal(ValidationError{File: manifestPath, Line: 6, Message: "Unknown property 'instnaces', did you mean 'instances'?"}))
+			Expect(validationErrs[1].File).To(Equal(manifestPath))
+			Expect(validationErrs[1].Line).To(Equal(7))
+			Expect(validationErrs[1].Message).To(ContainSubstring("Invalid value for 'disk_quota': 1024"))
+			Expect(validationErrs[2].Line).To(Equal(11))
+			Expect(validationErrs[2].Message).To(ContainSubstring("instances"))
+			Expect(validationErrs[3]).To(Equal(ValidationError{File: manifestPath, Line: 12, Message: "Expected random-route to be a boolean."}))
+			Expect(validationErrs[4]).To(Equal(ValidationError{File: manifestPath, Line: 13, Message: "Expected services to be a list of service names or service definitions."}))
+			Expect(validationErrs[5]).To(Equal(ValidationError{File: basePath, Line: 3, Message: "Unknown property 'stack_name'"}))
+		})
+
+		It("formats validation errors with the file and line number", func() {
+			validationErr := ValidationError{File: "manifest.yml", Line: 4, Message: "Unknown property 'foo'"}
+			Expect(validationErr.Error()).To(Equal("manifest.yml:4: Unknown property 'foo'"))
+		})
+	})
 })
@@ -1,10 +1,12 @@
 package manifest_test
 
 import (
+	"path/filepath"
 	"runtime"
 	"strings"
 
 	"github.com/cloudfoundry/cli/cf/manifest"
+	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/generic"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -86,6 +88,36 @@ var _ = Describe("Manifests", func() {
 		Expect(err.Error()).To(ContainSubstring("Invalid value for 'memory': 128"))
 	})
 
+	It("names the type of a value that is not a number", func() {
+		m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				map[interface{}]interface{}{
+					"name":      "bitcoin-miner",
+					"instances": "two",
+				},
+			},
+		}))
+
+		_, err := m.Applications()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("Expected instances to be a number, but it was a string.\n"))
+	})
+
+	It("names the type of a value of an unexpected kind", func() {
+		m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+			"applications": []interface{}{
+				map[interface{}]interface{}{
+					"name":      "bitcoin-miner",
+					"instances": true,
+				},
+			},
+		}))
+
+		_, err := m.Applications()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(Equal("Expected instances to be a number, but it was a bool.\n"))
+	})
+
 	It("sets applications' health check timeouts", func() {
 		m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
 			"applications": []interface{}{
@@ -255,6 +287,55 @@ var _ = Describe("Manifests", func() {
 		Expect(apps[0].UseRandomHostname).To(BeTrue())
 	})
 
+	Describe("multiple routes", func() {
+		It("parses lists of hosts, domains and routes", func() {
+			m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+				"applications": []interface{}{
+					map[interface{}]interface{}{
+						"name":         "my-app-name",
+						"hosts":        []interface{}{"api", "www"},
+						"domains":      []interface{}{"example.com", "example.org"},
+						"routes":       []interface{}{"status.example.net"},
+						"prune-routes": true,
+					},
+				},
+			}))
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(*apps[0].Hosts).To(Equal([]string{"api", "www"}))
+			Expect(*apps[0].Domains).To(Equal([]string{"example.com", "example.org"}))
+			Expect(*apps[0].Routes).To(Equal([]string{"status.example.net"}))
+			Expect(apps[0].PruneRoutes).To(BeTrue())
+		})
+
+		It("leaves the lists unset when they are not in the manifest", func() {
+			m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+				"name": "my-app-name",
+			}))
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(apps[0].Hosts).To(BeNil())
+			Expect(apps[0].Domains).To(BeNil())
+			Expect(apps[0].Routes).To(BeNil())
+			Expect(apps[0].PruneRoutes).To(BeFalse())
+		})
+
+		It("returns an error when a route list is not a list of strings", func() {
+			m := NewManifest("/some/path", generic.NewMap(map[interface{}]interface{}{
+				"name":  "my-app-name",
+				"hosts": "api",
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Expected hosts to be a list of strings."))
+		})
+	})
+
 	Describe("old-style property syntax", func() {
 		It("returns an error when the manifest contains non-whitelist properties", func() {
 			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
@@ -408,6 +489,160 @@ var _ = Describe("Manifests", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(*app[0].ServicesToBind).To(Equal([]string{"service-1", "service-2"}))
+			Expect(app[0].ServicesToCreate).To(BeNil())
+		})
+
+		It("reads service definitions with a plan and parameters", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"services": []interface{}{
+					"service-1",
+					map[interface{}]interface{}{
+						"name":    "my-db",
+						"service": "cleardb",
+						"plan":    "spark",
+						"parameters": map[interface{}]interface{}{
+							"storage": 10,
+							"backups": map[interface{}]interface{}{"enabled": true},
+						},
+					},
+					map[interface{}]interface{}{
+						"name":       "my-cache",
+						"service":    "redis",
+						"plan":       "small",
+						"parameters": `{"maxmemory": "64mb"}`,
+					},
+				},
+			}))
+
+			app, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(*app[0].ServicesToBind).To(Equal([]string{"service-1", "my-db", "my-cache"}))
+			Expect(*app[0].ServicesToCreate).To(Equal([]models.ServiceParams{
+				{
+					Name:  "my-db",
+					Label: "cleardb",
+					Plan:  "spark",
+					Parameters: map[string]interface{}{
+						"storage": 10,
+						"backups": map[string]interface{}{"enabled": true},
+					},
+				},
+				{
+					Name:       "my-cache",
+					Label:      "redis",
+					Plan:       "small",
+					Parameters: map[string]interface{}{"maxmemory": "64mb"},
+				},
+			}))
+		})
+
+		It("only binds service definitions without a service and plan", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"services": []interface{}{
+					map[interface{}]interface{}{"name": "my-db"},
+				},
+			}))
+
+			app, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(*app[0].ServicesToBind).To(Equal([]string{"my-db"}))
+			Expect(app[0].ServicesToCreate).To(BeNil())
+		})
+
+		It("returns an error when a service definition has a plan but no service", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"services": []interface{}{
+					map[interface{}]interface{}{"name": "my-db", "plan": "spark"},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Service my-db must specify both a service and a plan"))
+		})
+
+		It("returns an error when the parameters are not valid JSON", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"services": []interface{}{
+					map[interface{}]interface{}{
+						"name":       "my-db",
+						"service":    "cleardb",
+						"plan":       "spark",
+						"parameters": "{not json",
+					},
+				},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid parameters for service my-db"))
+		})
+
+		It("returns an error when an entry is neither a name nor a definition", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"services": []interface{}{42},
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Expected services to be a list of service names or service definitions."))
+		})
+	})
+
+	Describe("health checks", func() {
+		It("parses the health check type and endpoint", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"health-check-type":          "http",
+				"health-check-http-endpoint": "/health",
+			}))
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(*apps[0].HealthCheckType).To(Equal("http"))
+			Expect(*apps[0].HealthCheckEndpoint).To(Equal("/health"))
+		})
+
+		It("returns an error for an unknown health check type", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"health-check-type": "tcp",
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Invalid value for 'health-check-type': tcp"))
+		})
+	})
+
+	Describe("env files", func() {
+		It("reads variables from the file, relative to the manifest", func() {
+			m := NewManifest(filepath.Join("..", "..", "fixtures", "manifests", "env-file", "manifest.yml"), generic.NewMap(map[interface{}]interface{}{
+				"env-file": "app.env",
+				"env": map[interface{}]interface{}{
+					"LOG_LEVEL": "info",
+				},
+			}))
+
+			apps, err := m.Applications()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(*apps[0].EnvironmentVars).To(Equal(map[string]interface{}{
+				"DATABASE_POOL": "5",
+				"GREETING":      "hello world",
+				"LOG_LEVEL":     "info",
+			}))
+		})
+
+		It("returns an error when the file cannot be read", func() {
+			m := NewManifest("/some/path/manifest.yml", generic.NewMap(map[interface{}]interface{}{
+				"env-file": "missing.env",
+			}))
+
+			_, err := m.Applications()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Error reading env file"))
 		})
 	})
 })
@@ -0,0 +1,343 @@
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+
+	"github.com/cloudfoundry/cli/generic"
+)
+
+type ValidationError struct {
+	File    string
+	Line    int
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Message)
+	}
+	return fmt.Sprintf("%s: %s", e.File, e.Message)
+}
+
+type propertyKind int
+
+const (
+	stringProperty propertyKind = iota
+	stringOrNullProperty
+	intProperty
+	bytesProperty
+	boolProperty
+	stringSliceProperty
+	envProperty
+	healthCheckTypeProperty
+	servicesProperty
+)
+
+var appPropertyKinds = map[string]propertyKind{
+	"buildpack":                  stringOrNullProperty,
+	"command":                    stringOrNullProperty,
+	"disk_quota":                 bytesProperty,
+	"domain":                     stringProperty,
+	"domains":                    stringSliceProperty,
+	"env":                        envProperty,
+	"env-file":                   stringProperty,
+	"health-check-http-endpoint": stringProperty,
+	"health-check-type":          healthCheckTypeProperty,
+	"host":                       stringProperty,
+	"hosts":                      stringSliceProperty,
+	"instances":                  intProperty,
+	"memory":                     bytesProperty,
+	"name":                       stringProperty,
+	"no-route":                   boolProperty,
+	"path":                       stringProperty,
+	"prune-routes":               boolProperty,
+	"random-route":               boolProperty,
+	"routes":                     stringSliceProperty,
+	"services":                   servicesProperty,
+	"stack":                      stringProperty,
+	"timeout":                    intProperty,
+}
+
+type manifestValidator struct {
+	path  string
+	lines yamlLineIndex
+	errs  []ValidationError
+}
+
+func validateManifestData(path string, data generic.Map, lines yamlLineIndex) []ValidationError {
+	v := &manifestValidator{path: path, lines: lines}
+
+	generic.Each(data, func(key, value interface{}) {
+		switch key {
+		case "applications":
+			v.validateApplications(value)
+		case "inherit":
+			if _, ok := value.(string); !ok {
+				v.addError("inherit", T("invalid inherit path in manifest"))
+			}
+		default:
+			v.validateProperty("", key, value)
+		}
+	})
+
+	sort.Sort(validationErrorsByLine(v.errs))
+	return v.errs
+}
+
+func (v *manifestValidator) validateApplications(value interface{}) {
+	appMaps, ok := value.([]interface{})
+	if !ok {
+		v.addError("applications", T("Expected applications to be a list"))
+		return
+	}
+
+	for index, appData := range appMaps {
+		appPath := fmt.Sprintf("applications[%d]", index)
+		if !generic.IsMappable(appData) {
+			v.addError(appPath, T("Expected application to be a list of key/value pairs\nError occurred in manifest near:\n'{{.YmlSnippet}}'",
+				map[string]interface{}{"YmlSnippet": appData}))
+			continue
+		}
+
+		generic.Each(generic.NewMap(appData), func(key, value interface{}) {
+			v.validateProperty(appPath, key, value)
+		})
+	}
+}
+
+func (v *manifestValidator) validateProperty(prefix string, key, value interface{}) {
+	name, ok := key.(string)
+	if !ok {
+		name = coerceToString(key)
+	}
+	path := joinYAMLPath(prefix, name)
+
+	kind, known := appPropertyKinds[name]
+	if !known {
+		v.addError(path, unknownPropertyMessage(name))
+		return
+	}
+
+	if value == nil && kind != stringOrNullProperty {
+		v.addError(path, T("{{.PropertyName}} should not be null", map[string]interface{}{"PropertyName": name}))
+		return
+	}
+
+	errs := []error{}
+	yamlMap := generic.NewMap(map[interface{}]interface{}{name: value})
+
+	switch kind {
+	case stringProperty:
+		stringVal(yamlMap, name, &errs)
+	case stringOrNullProperty:
+		stringValOrDefault(yamlMap, name, &errs)
+	case intProperty:
+		intVal(yamlMap, name, &errs)
+	case bytesProperty:
+		bytesVal(yamlMap, name, &errs)
+	case boolProperty:
+		boolVal(yamlMap, name, &errs)
+	case stringSliceProperty:
+		sliceOrEmptyVal(yamlMap, name, &errs)
+	case envProperty:
+		envVarOrEmptyMap(yamlMap, &errs)
+	case healthCheckTypeProperty:
+		healthCheckTypeVal(yamlMap, name, &errs)
+	case servicesProperty:
+		servicesVal(yamlMap, name, &errs)
+	}
+
+	for _, err := range errs {
+		v.addError(path, err.Error())
+	}
+}
+
+func (v *manifestValidator) addError(path string, message string) {
+	v.errs = append(v.errs, ValidationError{
+		File:    v.path,
+		Line:    v.lines.lineFor(path),
+		Message: message,
+	})
+}
+
+func unknownPropertyMessage(name string) string {
+	suggestion := closestPropertyName(name)
+	if suggestion == "" {
+		return T("Unknown property '{{.PropertyName}}'", map[string]interface{}{"PropertyName": name})
+	}
+
+	return T("Unknown property '{{.PropertyName}}', did you mean '{{.Suggestion}}'?",
+		map[string]interface{}{"PropertyName": name, "Suggestion": suggestion})
+}
+
+func closestPropertyName(name string) (closest string) {
+	bestDistance := len(name)/2 + 1
+	if bestDistance > 3 {
+		bestDistance = 3
+	}
+
+	candidates := []string{"applications", "inherit"}
+	for property := range appPropertyKinds {
+		candidates = append(candidates, property)
+	}
+	sort.Strings(candidates)
+
+	for _, candidate := range candidates {
+		distance := editDistance(strings.ToLower(name), candidate)
+		if distance < bestDistance {
+			bestDistance = distance
+			closest = candidate
+		}
+	}
+	return
+}
+
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+type validationErrorsByLine []ValidationError
+
+func (errs validationErrorsByLine) Len() int      { return len(errs) }
+func (errs validationErrorsByLine) Swap(i, j int) { errs[i], errs[j] = errs[j], errs[i] }
+func (errs validationErrorsByLine) Less(i, j int) bool {
+	if errs[i].Line != errs[j].Line {
+		return errs[i].Line < errs[j].Line
+	}
+	return errs[i].Message < errs[j].Message
+}
+
+// yamlLineIndex maps the path of each key in a block-style YAML document,
+// e.g. "applications[0].memory", to the line it was declared on.
+type yamlLineIndex map[string]int
+
+func (index yamlLineIndex) lineFor(path string) int {
+	for {
+		if line, ok := index[path]; ok {
+			return line
+		}
+
+		i := strings.LastIndexAny(path, ".[")
+		if i < 0 {
+			return 0
+		}
+		path = path[:i]
+	}
+}
+
+type yamlNode struct {
+	indent int
+	path   string
+	isItem bool
+	items  int
+}
+
+var yamlKeyRegex = regexp.MustCompile(`^("[^"]*"|'[^']*'|[^\s#'"][^:#]*?)\s*:(?:\s+(.*))?$`)
+
+func indexYAMLLines(raw []byte) yamlLineIndex {
+	index := yamlLineIndex{}
+	stack := []*yamlNode{{indent: -1}}
+	blockScalarIndent := -1
+
+	for lineNumber, line := range strings.Split(string(raw), "\n") {
+		line = strings.TrimRight(line, "\r")
+		trimmed := strings.TrimSpace(line)
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+
+		if blockScalarIndent >= 0 {
+			if trimmed == "" || indent > blockScalarIndent {
+				continue
+			}
+			blockScalarIndent = -1
+		}
+
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(line, "---") || strings.HasPrefix(line, "...") {
+			continue
+		}
+
+		content := line[indent:]
+		for content == "-" || strings.HasPrefix(content, "- ") {
+			for len(stack) > 1 {
+				top := stack[len(stack)-1]
+				if top.indent < indent || (top.indent == indent && !top.isItem) {
+					break
+				}
+				stack = stack[:len(stack)-1]
+			}
+
+			parent := stack[len(stack)-1]
+			item := &yamlNode{indent: indent, path: fmt.Sprintf("%s[%d]", parent.path, parent.items), isItem: true}
+			parent.items++
+			index.record(item.path, lineNumber+1)
+			stack = append(stack, item)
+
+			rest := strings.TrimLeft(content[1:], " ")
+			indent += len(content) - len(rest)
+			content = rest
+		}
+
+		match := yamlKeyRegex.FindStringSubmatch(content)
+		if match == nil {
+			continue
+		}
+
+		for len(stack) > 1 && stack[len(stack)-1].indent >= indent {
+			stack = stack[:len(stack)-1]
+		}
+
+		key := strings.Trim(match[1], `"'`)
+		node := &yamlNode{indent: indent, path: joinYAMLPath(stack[len(stack)-1].path, key)}
+		index.record(node.path, lineNumber+1)
+		stack = append(stack, node)
+
+		value := strings.TrimSpace(match[2])
+		if strings.HasPrefix(value, "|") || strings.HasPrefix(value, ">") {
+			blockScalarIndent = indent
+		}
+	}
+
+	return index
+}
+
+func (index yamlLineIndex) record(path string, line int) {
+	if _, exists := index[path]; !exists {
+		index[path] = line
+	}
+}
+
+func joinYAMLPath(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
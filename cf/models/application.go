@@ -56,31 +56,40 @@ type ApplicationFields struct {
 	Memory               int64 // in Megabytes
 	RunningInstances     int
 	HealthCheckTimeout   int
+	HealthCheckType      string
+	HealthCheckEndpoint  string
 	State                string
 	SpaceGuid            string
 	PackageUpdatedAt     *time.Time
 }
 
 type AppParams struct {
-	BuildpackUrl       *string
-	Command            *string
-	DiskQuota          *int64
-	Domain             *string
-	EnvironmentVars    *map[string]interface{}
-	Guid               *string
-	HealthCheckTimeout *int
-	Host               *string
-	InstanceCount      *int
-	Memory             *int64
-	Name               *string
-	NoRoute            bool
-	UseRandomHostname  bool
-	Path               *string
-	ServicesToBind     *[]string
-	SpaceGuid          *string
-	StackGuid          *string
-	StackName          *string
-	State              *string
+	BuildpackUrl        *string
+	Command             *string
+	DiskQuota           *int64
+	Domain              *string
+	Domains             *[]string
+	EnvironmentVars     *map[string]interface{}
+	Guid                *string
+	HealthCheckTimeout  *int
+	HealthCheckType     *string
+	HealthCheckEndpoint *string
+	Host                *string
+	Hosts               *[]string
+	InstanceCount       *int
+	Memory              *int64
+	Name                *string
+	NoRoute             bool
+	UseRandomHostname   bool
+	Path                *string
+	PruneRoutes         bool
+	Routes              *[]string
+	ServicesToBind      *[]string
+	ServicesToCreate    *[]ServiceParams
+	SpaceGuid           *string
+	StackGuid           *string
+	StackName           *string
+	State               *string
 }
 
 func (app *AppParams) Merge(other *AppParams) {
@@ -96,6 +105,9 @@ func (app *AppParams) Merge(other *AppParams) {
 	if other.Domain != nil {
 		app.Domain = other.Domain
 	}
+	if other.Domains != nil {
+		app.Domains = other.Domains
+	}
 	if other.EnvironmentVars != nil {
 		app.EnvironmentVars = other.EnvironmentVars
 	}
@@ -105,9 +117,18 @@ func (app *AppParams) Merge(other *AppParams) {
 	if other.HealthCheckTimeout != nil {
 		app.HealthCheckTimeout = other.HealthCheckTimeout
 	}
+	if other.HealthCheckType != nil {
+		app.HealthCheckType = other.HealthCheckType
+	}
+	if other.HealthCheckEndpoint != nil {
+		app.HealthCheckEndpoint = other.HealthCheckEndpoint
+	}
 	if other.Host != nil {
 		app.Host = other.Host
 	}
+	if other.Hosts != nil {
+		app.Hosts = other.Hosts
+	}
 	if other.InstanceCount != nil {
 		app.InstanceCount = other.InstanceCount
 	}
@@ -123,9 +144,15 @@ func (app *AppParams) Merge(other *AppParams) {
 	if other.Path != nil {
 		app.Path = other.Path
 	}
+	if other.Routes != nil {
+		app.Routes = other.Routes
+	}
 	if other.ServicesToBind != nil {
 		app.ServicesToBind = other.ServicesToBind
 	}
+	if other.ServicesToCreate != nil {
+		app.ServicesToCreate = other.ServicesToCreate
+	}
 	if other.SpaceGuid != nil {
 		app.SpaceGuid = other.SpaceGuid
 	}
@@ -141,6 +168,16 @@ func (app *AppParams) Merge(other *AppParams) {
 
 	app.NoRoute = app.NoRoute || other.NoRoute
 	app.UseRandomHostname = app.UseRandomHostname || other.UseRandomHostname
+	app.PruneRoutes = app.PruneRoutes || other.PruneRoutes
+}
+
+// ServiceParams describes a service instance listed in a manifest that should
+// be created from the given offering and plan if it does not exist yet.
+type ServiceParams struct {
+	Name       string
+	Label      string
+	Plan       string
+	Parameters map[string]interface{}
 }
 
 func (app *AppParams) IsEmpty() bool {
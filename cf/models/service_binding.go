@@ -4,4 +4,5 @@ type ServiceBindingFields struct {
 	Guid    string
 	Url     string
 	AppGuid string
+	AppName string
 }
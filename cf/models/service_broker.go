@@ -1,10 +1,11 @@
 package models
 
 type ServiceBroker struct {
-	Guid     string
-	Name     string
-	Username string
-	Password string
-	Url      string
-	Services []ServiceOffering
+	Guid      string
+	Name      string
+	Username  string
+	Password  string
+	Url       string
+	SpaceGuid string
+	Services  []ServiceOffering
 }
@@ -1,5 +1,21 @@
 package models
 
+import "time"
+
+const (
+	LastOperationInProgress = "in progress"
+	LastOperationSucceeded  = "succeeded"
+	LastOperationFailed     = "failed"
+)
+
+type LastOperationFields struct {
+	Type        string
+	State       string
+	Description string
+	CreatedAt   string
+	UpdatedAt   string
+}
+
 type ServiceInstanceFields struct {
 	Guid             string
 	Name             string
@@ -7,6 +23,8 @@ type ServiceInstanceFields struct {
 	ApplicationNames []string
 	Params           map[string]interface{}
 	DashboardUrl     string
+	Tags             []string
+	LastOperation    LastOperationFields
 }
 
 type ServiceInstance struct {
@@ -14,8 +32,22 @@ type ServiceInstance struct {
 	ServiceBindings []ServiceBindingFields
 	ServicePlan     ServicePlanFields
 	ServiceOffering ServiceOfferingFields
+	Space           SpaceFields
+	Organization    OrganizationFields
+}
+
+// ServicePlanChange records a service instance being moved to another plan.
+type ServicePlanChange struct {
+	Timestamp time.Time
+	ActorName string
+	PlanGuid  string
+	PlanName  string
 }
 
 func (inst ServiceInstance) IsUserProvided() bool {
 	return inst.ServicePlan.Guid == ""
 }
+
+func (inst ServiceInstance) IsOperationInProgress() bool {
+	return inst.LastOperation.State == LastOperationInProgress
+}
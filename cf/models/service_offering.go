@@ -8,6 +8,7 @@ type ServiceOfferingFields struct {
 	Version          string
 	Description      string
 	DocumentationUrl string
+	Tags             []string
 }
 
 type ServiceOffering struct {
@@ -9,6 +9,18 @@ type ServicePlanFields struct {
 	Active              bool
 	ServiceOfferingGuid string
 	OrgNames            []string
+	Extra               ServicePlanExtra
+}
+
+type ServicePlanExtra struct {
+	DisplayName string
+	Bullets     []string
+	Costs       []ServicePlanCost
+}
+
+type ServicePlanCost struct {
+	Amount map[string]float64
+	Unit   string
 }
 
 type ServicePlan struct {
@@ -0,0 +1,10 @@
+package models
+
+type SpaceUsage struct {
+	Space            SpaceFields
+	SpaceQuotaGuid   string
+	Memory           int64 // in Megabytes, instances × memory of started apps
+	RunningInstances int
+	ServiceInstances int
+	Routes           int
+}
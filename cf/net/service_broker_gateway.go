@@ -0,0 +1,19 @@
+package net
+
+import (
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
+	"github.com/cloudfoundry/cli/cf/errors"
+	"github.com/cloudfoundry/cli/cf/terminal"
+)
+
+// Service brokers are third party servers, so their errors are reported as
+// they were received and never taken as a sign that the access token expired.
+// The gateway is never given a token refresher, so the user's token is not
+// sent to a broker.
+func serviceBrokerErrorHandler(statusCode int, body []byte) error {
+	return errors.NewHttpError(statusCode, "", string(body))
+}
+
+func NewServiceBrokerGateway(config core_config.Reader, ui terminal.UI) Gateway {
+	return newGateway(serviceBrokerErrorHandler, config, ui)
+}
@@ -0,0 +1,47 @@
+package terminal
+
+import (
+	"strconv"
+
+	. "github.com/cloudfoundry/cli/cf/i18n"
+)
+
+// MaxChoices is the longest list PromptForChoice will number. Longer lists
+// ask for the name to be typed instead.
+const MaxChoices = 50
+
+// PromptForChoice shows a numbered list of names and asks for one of them,
+// by number or by name. An empty answer returns an empty string.
+func PromptForChoice(ui UI, names []string, listPrompt, itemPrompt string) string {
+	nameIndex := 0
+	var nameString string
+	for nameIndex < 1 || nameIndex > len(names) {
+		var err error
+
+		// list header
+		ui.Say(listPrompt)
+
+		// only display list if it is shorter than MaxChoices
+		if len(names) < MaxChoices {
+			for i, name := range names {
+				ui.Say("%d. %s", i+1, name)
+			}
+		} else {
+			ui.Say(T("There are too many options to display, please type in the name."))
+		}
+
+		nameString = ui.Ask("%s", itemPrompt)
+		if nameString == "" {
+			return ""
+		}
+
+		nameIndex, err = strconv.Atoi(nameString)
+
+		if err != nil {
+			nameIndex = 1
+			return nameString
+		}
+	}
+
+	return names[nameIndex-1]
+}
@@ -0,0 +1,65 @@
+package terminal_test
+
+import (
+	"fmt"
+
+	testterm "github.com/cloudfoundry/cli/testhelpers/terminal"
+
+	. "github.com/cloudfoundry/cli/cf/terminal"
+	. "github.com/cloudfoundry/cli/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PromptForChoice", func() {
+	var (
+		ui    *testterm.FakeUI
+		names []string
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		names = []string{"apple", "banana", "cherry"}
+	})
+
+	It("lists the names and returns the one picked by number", func() {
+		ui.Inputs = []string{"2"}
+
+		Expect(PromptForChoice(ui, names, "Select a fruit:", "Fruit")).To(Equal("banana"))
+		Expect(ui.Outputs).To(ContainSubstrings(
+			[]string{"Select a fruit:"},
+			[]string{"1. apple"},
+			[]string{"2. banana"},
+			[]string{"3. cherry"},
+		))
+		Expect(ui.Prompts).To(ContainSubstrings([]string{"Fruit"}))
+	})
+
+	It("returns a typed name as is", func() {
+		ui.Inputs = []string{"durian"}
+		Expect(PromptForChoice(ui, names, "Select a fruit:", "Fruit")).To(Equal("durian"))
+	})
+
+	It("asks again when the number is out of range", func() {
+		ui.Inputs = []string{"4", "1"}
+		Expect(PromptForChoice(ui, names, "Select a fruit:", "Fruit")).To(Equal("apple"))
+		Expect(ui.Prompts).To(HaveLen(2))
+	})
+
+	It("returns an empty string when nothing is entered", func() {
+		ui.Inputs = []string{""}
+		Expect(PromptForChoice(ui, names, "Select a fruit:", "Fruit")).To(BeEmpty())
+	})
+
+	It("asks for the name when there are too many choices to list", func() {
+		names = []string{}
+		for i := 0; i < MaxChoices; i++ {
+			names = append(names, fmt.Sprintf("fruit-%d", i))
+		}
+		ui.Inputs = []string{"fruit-7"}
+
+		Expect(PromptForChoice(ui, names, "Select a fruit:", "Fruit")).To(Equal("fruit-7"))
+		Expect(ui.Outputs).To(ContainSubstrings([]string{"There are too many options to display, please type in the name."}))
+		Expect(ui.Outputs).NotTo(ContainSubstrings([]string{"1. fruit-0"}))
+	})
+})
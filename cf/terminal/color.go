@@ -135,3 +135,7 @@ func LogSysHeaderColor(message string) string {
 func isTerminal() bool {
 	return terminal.IsTerminal(1)
 }
+
+func isTerminalFile(file *os.File) bool {
+	return terminal.IsTerminal(int(file.Fd()))
+}
@@ -32,6 +32,7 @@ type UI interface {
 	Confirm(message string, args ...interface{}) bool
 	ConfirmDelete(modelType, modelName string) bool
 	ConfirmDeleteWithAssociations(modelType, modelName string) bool
+	ConfirmDeleteProtected(modelType, modelName string) bool
 	Ok()
 	Failed(message string, args ...interface{})
 	FailWithUsage(context *cli.Context)
@@ -40,6 +41,7 @@ type UI interface {
 	LoadingIndication()
 	Wait(duration time.Duration)
 	Table(headers []string) Table
+	IsInteractive() bool
 }
 
 type terminalUI struct {
@@ -103,6 +105,22 @@ func (c *terminalUI) ConfirmDelete(modelType, modelName string) bool {
 		}))
 }
 
+// ConfirmDeleteProtected only accepts the name of the resource typed back, so
+// that a reflexive "y" cannot delete from a protected target.
+func (c *terminalUI) ConfirmDeleteProtected(modelType, modelName string) bool {
+	response := c.Ask(T("This is a protected target. Type the name of the {{.ModelType}} ({{.ModelName}}) to confirm",
+		map[string]interface{}{
+			"ModelType": modelType,
+			"ModelName": EntityNameColor(modelName),
+		}))
+
+	if response != modelName {
+		c.Warn(T("Delete cancelled"))
+		return false
+	}
+	return true
+}
+
 func (c *terminalUI) confirmDelete(message string) bool {
 	result := c.Confirm(message)
 
@@ -122,6 +140,12 @@ func (c *terminalUI) Confirm(message string, args ...interface{}) bool {
 	return false
 }
 
+// IsInteractive reports whether a user is at the terminal to answer prompts.
+func (c *terminalUI) IsInteractive() bool {
+	stdin, ok := c.stdin.(*os.File)
+	return ok && isTerminalFile(stdin) && isTerminal()
+}
+
 func (c *terminalUI) Ask(prompt string, args ...interface{}) (answer string) {
 	fmt.Println("")
 	fmt.Printf(prompt+PromptColor(">")+" ", args...)
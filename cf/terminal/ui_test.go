@@ -187,6 +187,30 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("Confirming deletion of a protected resource", func() {
+		It("accepts the name of the resource", func() {
+			io_helpers.SimulateStdin("bizzbump\n", func(reader io.Reader) {
+				out := io_helpers.CaptureOutput(func() {
+					ui := NewUI(reader, NewTeePrinter())
+					Expect(ui.ConfirmDeleteProtected("fizzbuzz", "bizzbump")).To(BeTrue())
+				})
+
+				Expect(out).To(ContainSubstrings([]string{"protected target", "Type the name of the fizzbuzz", "bizzbump"}))
+			})
+		})
+
+		It("treats 'y' as a negative confirmation", func() {
+			io_helpers.SimulateStdin("y\n", func(reader io.Reader) {
+				out := io_helpers.CaptureOutput(func() {
+					ui := NewUI(reader, NewTeePrinter())
+					Expect(ui.ConfirmDeleteProtected("modelType", "modelName")).To(BeFalse())
+				})
+
+				Expect(out).To(ContainSubstrings([]string{"Delete cancelled"}))
+			})
+		})
+	})
+
 	Context("when user is not logged in", func() {
 		var config core_config.Reader
 
@@ -6,6 +6,7 @@ import (
 
 	. "github.com/cloudfoundry/cli/cf/i18n"
 
+	"github.com/cloudfoundry/cli/cf/configuration/core_config"
 	"github.com/cloudfoundry/cli/cf/models"
 	"github.com/cloudfoundry/cli/cf/terminal"
 )
@@ -69,3 +70,26 @@ func ColoredInstanceState(instance models.AppInstanceFields) (colored string) {
 
 	return
 }
+
+// ConfirmProtectedDelete guards a destructive command acting on a protected
+// target. The user must type the resource's name back, and -f is refused
+// unless the override environment variable is set.
+func ConfirmProtectedDelete(ui terminal.UI, modelType, modelName string, force bool) bool {
+	if !force {
+		return ui.ConfirmDeleteProtected(modelType, modelName)
+	}
+
+	if !core_config.ProtectedTargetsOverridden() {
+		ui.Failed(T("Refusing to use -f on the {{.ModelType}} {{.ModelName}} in a protected target. Set {{.EnvVar}}=true to override.",
+			map[string]interface{}{
+				"ModelType": modelType,
+				"ModelName": modelName,
+				"EnvVar":    core_config.ProtectedTargetsOverrideEnvVar,
+			}))
+		return false
+	}
+
+	ui.Warn(T("{{.EnvVar}} is set, deleting from a protected target without confirmation",
+		map[string]interface{}{"EnvVar": core_config.ProtectedTargetsOverrideEnvVar}))
+	return true
+}
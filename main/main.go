@@ -73,6 +73,7 @@ func setupDependencies() (deps *cliDependencies) {
 	deps.gateways = map[string]net.Gateway{
 		"auth":             net.NewUAAGateway(deps.configRepo, deps.termUI),
 		"cloud-controller": net.NewCloudControllerGateway(deps.configRepo, time.Now, deps.termUI),
+		"service-broker":   net.NewServiceBrokerGateway(deps.configRepo, deps.termUI),
 		"uaa":              net.NewUAAGateway(deps.configRepo, deps.termUI),
 	}
 	deps.apiRepoLocator = api.NewRepositoryLocator(deps.configRepo, deps.gateways)
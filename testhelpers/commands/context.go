@@ -58,6 +58,7 @@ func findCommand(cmdName string) (cmd cli.Command) {
 	apiRepoLocator := api.NewRepositoryLocator(configRepo, map[string]net.Gateway{
 		"auth":             net.NewUAAGateway(configRepo, fakeUI),
 		"cloud-controller": net.NewCloudControllerGateway(configRepo, time.Now, fakeUI),
+		"service-broker":   net.NewServiceBrokerGateway(configRepo, fakeUI),
 		"uaa":              net.NewUAAGateway(configRepo, fakeUI),
 	})
 
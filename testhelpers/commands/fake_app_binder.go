@@ -5,15 +5,17 @@ import "github.com/cloudfoundry/cli/cf/models"
 type FakeAppBinder struct {
 	AppsToBind        []models.Application
 	InstancesToBindTo []models.ServiceInstance
+	Params            []map[string]interface{}
 
 	BindApplicationReturns struct {
 		Error error
 	}
 }
 
-func (binder *FakeAppBinder) BindApplication(app models.Application, service models.ServiceInstance) error {
+func (binder *FakeAppBinder) BindApplication(app models.Application, service models.ServiceInstance, params map[string]interface{}) error {
 	binder.AppsToBind = append(binder.AppsToBind, app)
 	binder.InstancesToBindTo = append(binder.InstancesToBindTo, service)
+	binder.Params = append(binder.Params, params)
 
 	return binder.BindApplicationReturns.Error
 }
@@ -0,0 +1,25 @@
+package commands
+
+import "github.com/cloudfoundry/cli/cf/models"
+
+type FakeApplicationRestager struct {
+	RestagedApps []models.Application
+	OrgName      string
+	SpaceName    string
+
+	ApplicationRestageReturns struct {
+		Error error
+	}
+	ApplicationRestageErrorsByName map[string]error
+}
+
+func (restager *FakeApplicationRestager) ApplicationRestage(app models.Application, orgName, spaceName string) (models.Application, error) {
+	restager.RestagedApps = append(restager.RestagedApps, app)
+	restager.OrgName = orgName
+	restager.SpaceName = spaceName
+
+	if err, ok := restager.ApplicationRestageErrorsByName[app.Name]; ok {
+		return app, err
+	}
+	return app, restager.ApplicationRestageReturns.Error
+}
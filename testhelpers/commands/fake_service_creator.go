@@ -0,0 +1,31 @@
+package commands
+
+import "github.com/cloudfoundry/cli/cf/models"
+
+type FakeServiceCreator struct {
+	CreateServiceArgs []models.ServiceParams
+	CreateServiceTags [][]string
+
+	CreateServiceReturns struct {
+		Plan  models.ServicePlanFields
+		Error error
+	}
+
+	// CreateServiceHook runs on every call, e.g. to make the instance visible
+	CreateServiceHook func(serviceInstanceName string)
+}
+
+func (creator *FakeServiceCreator) CreateService(serviceName string, planName string, serviceInstanceName string, params map[string]interface{}, tags []string) (models.ServicePlanFields, error) {
+	creator.CreateServiceTags = append(creator.CreateServiceTags, tags)
+	creator.CreateServiceArgs = append(creator.CreateServiceArgs, models.ServiceParams{
+		Name:       serviceInstanceName,
+		Label:      serviceName,
+		Plan:       planName,
+		Parameters: params,
+	})
+	if creator.CreateServiceHook != nil {
+		creator.CreateServiceHook(serviceInstanceName)
+	}
+
+	return creator.CreateServiceReturns.Plan, creator.CreateServiceReturns.Error
+}
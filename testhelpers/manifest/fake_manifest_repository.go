@@ -12,6 +12,14 @@ type FakeManifestRepository struct {
 		Manifest *manifest.Manifest
 		Error    error
 	}
+
+	ValidateManifestArgs struct {
+		Path string
+	}
+	ValidateManifestReturns struct {
+		ValidationErrors []manifest.ValidationError
+		Error            error
+	}
 }
 
 func (repo *FakeManifestRepository) ReadManifest(inputPath string) (m *manifest.Manifest, err error) {
@@ -25,3 +33,8 @@ func (repo *FakeManifestRepository) ReadManifest(inputPath string) (m *manifest.
 	err = repo.ReadManifestReturns.Error
 	return
 }
+
+func (repo *FakeManifestRepository) ValidateManifest(inputPath string) ([]manifest.ValidationError, error) {
+	repo.ValidateManifestArgs.Path = inputPath
+	return repo.ValidateManifestReturns.ValidationErrors, repo.ValidateManifestReturns.Error
+}
@@ -24,6 +24,7 @@ type FakeUI struct {
 	FailedWithUsageCommandName string
 	PanickedQuietly            bool
 	ShowConfigurationCalled    bool
+	Interactive                bool
 
 	sayMutex sync.Mutex
 }
@@ -64,6 +65,10 @@ func (ui *FakeUI) Warn(message string, args ...interface{}) {
 	return
 }
 
+func (ui *FakeUI) IsInteractive() bool {
+	return ui.Interactive
+}
+
 func (ui *FakeUI) Ask(prompt string, args ...interface{}) (answer string) {
 	ui.Prompts = append(ui.Prompts, fmt.Sprintf(prompt, args...))
 	if len(ui.Inputs) == 0 {
@@ -87,6 +92,15 @@ func (ui *FakeUI) ConfirmDeleteWithAssociations(modelType, modelName string) boo
 	return ui.ConfirmDelete(modelType, modelName)
 }
 
+func (ui *FakeUI) ConfirmDeleteProtected(modelType, modelName string) bool {
+	response := ui.Ask(
+		"This is a protected target. Type the name of the %s (%s) to confirm%s",
+		modelType,
+		term.EntityNameColor(modelName),
+		term.PromptColor(">"))
+	return response == modelName
+}
+
 func (ui *FakeUI) Confirm(prompt string, args ...interface{}) bool {
 	response := ui.Ask(prompt, args...)
 	switch strings.ToLower(response) {